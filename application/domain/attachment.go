@@ -0,0 +1,106 @@
+//go:generate mockgen -source=attachment.go -destination=attachment_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidAttachment  = errors.New("attachment fields are invalid")
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrBlobNotFound       = errors.New("blob not found")
+)
+
+var AttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"application/pdf",
+}
+
+type AttachmentUsecase interface {
+	Upload(ctx context.Context, attachment Attachment, content []byte, user User) (Attachment, error)
+	ListByTask(ctx context.Context, taskID int64, user User) ([]Attachment, error)
+	Download(ctx context.Context, taskID, id int64, user User) (Attachment, []byte, error)
+	Remove(ctx context.Context, taskID, id int64, user User) error
+}
+
+type AttachmentCreator interface {
+	Add(ctx context.Context, attachment Attachment) (int64, error)
+}
+
+type AttachmentRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64) ([]Attachment, error)
+	ListByID(ctx context.Context, id int64) (Attachment, error)
+}
+
+type AttachmentRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type BlobStorage interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Attachment struct {
+	ID          int64
+	TaskID      int64
+	UserID      int64
+	Name        string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+func NewAttachment(taskID, userID int64, name, contentType string, size int64) (Attachment, error) {
+	var err []string
+
+	if taskID == 0 {
+		err = append(err, "task ID must not be 0")
+	}
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if name == "" {
+		err = append(err, "name must not be empty")
+	}
+
+	if len(name) > 255 {
+		err = append(err, "name size is too big")
+	}
+
+	if size <= 0 {
+		err = append(err, "file must not be empty")
+	}
+
+	if !IsAllowedAttachmentType(contentType) {
+		err = append(err, fmt.Sprintf("content type %q is not allowed", contentType))
+	}
+
+	if len(err) > 0 {
+		return Attachment{}, fmt.Errorf("%w: %s", ErrInvalidAttachment, strings.Join(err, "; "))
+	}
+
+	return Attachment{TaskID: taskID, UserID: userID, Name: name, ContentType: contentType, Size: size}, nil
+}
+
+func IsAllowedAttachmentType(contentType string) bool {
+	for _, t := range AttachmentContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachment.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAttachmentUsecase is a mock of AttachmentUsecase interface.
+type MockAttachmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentUsecaseMockRecorder
+}
+
+// MockAttachmentUsecaseMockRecorder is the mock recorder for MockAttachmentUsecase.
+type MockAttachmentUsecaseMockRecorder struct {
+	mock *MockAttachmentUsecase
+}
+
+// NewMockAttachmentUsecase creates a new mock instance.
+func NewMockAttachmentUsecase(ctrl *gomock.Controller) *MockAttachmentUsecase {
+	mock := &MockAttachmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockAttachmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentUsecase) EXPECT() *MockAttachmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockAttachmentUsecase) Download(ctx context.Context, taskID, id int64, user User) (Attachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, taskID, id, user)
+	ret0, _ := ret[0].(Attachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAttachmentUsecaseMockRecorder) Download(ctx, taskID, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAttachmentUsecase)(nil).Download), ctx, taskID, id, user)
+}
+
+// ListByTask mocks base method.
+func (m *MockAttachmentUsecase) ListByTask(ctx context.Context, taskID int64, user User) ([]Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockAttachmentUsecaseMockRecorder) ListByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockAttachmentUsecase)(nil).ListByTask), ctx, taskID, user)
+}
+
+// Remove mocks base method.
+func (m *MockAttachmentUsecase) Remove(ctx context.Context, taskID, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, taskID, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAttachmentUsecaseMockRecorder) Remove(ctx, taskID, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAttachmentUsecase)(nil).Remove), ctx, taskID, id, user)
+}
+
+// Upload mocks base method.
+func (m *MockAttachmentUsecase) Upload(ctx context.Context, attachment Attachment, content []byte, user User) (Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, attachment, content, user)
+	ret0, _ := ret[0].(Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAttachmentUsecaseMockRecorder) Upload(ctx, attachment, content, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAttachmentUsecase)(nil).Upload), ctx, attachment, content, user)
+}
+
+// MockAttachmentCreator is a mock of AttachmentCreator interface.
+type MockAttachmentCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentCreatorMockRecorder
+}
+
+// MockAttachmentCreatorMockRecorder is the mock recorder for MockAttachmentCreator.
+type MockAttachmentCreatorMockRecorder struct {
+	mock *MockAttachmentCreator
+}
+
+// NewMockAttachmentCreator creates a new mock instance.
+func NewMockAttachmentCreator(ctrl *gomock.Controller) *MockAttachmentCreator {
+	mock := &MockAttachmentCreator{ctrl: ctrl}
+	mock.recorder = &MockAttachmentCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentCreator) EXPECT() *MockAttachmentCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockAttachmentCreator) Add(ctx context.Context, attachment Attachment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, attachment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockAttachmentCreatorMockRecorder) Add(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAttachmentCreator)(nil).Add), ctx, attachment)
+}
+
+// MockAttachmentRetriever is a mock of AttachmentRetriever interface.
+type MockAttachmentRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRetrieverMockRecorder
+}
+
+// MockAttachmentRetrieverMockRecorder is the mock recorder for MockAttachmentRetriever.
+type MockAttachmentRetrieverMockRecorder struct {
+	mock *MockAttachmentRetriever
+}
+
+// NewMockAttachmentRetriever creates a new mock instance.
+func NewMockAttachmentRetriever(ctrl *gomock.Controller) *MockAttachmentRetriever {
+	mock := &MockAttachmentRetriever{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRetriever) EXPECT() *MockAttachmentRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByID mocks base method.
+func (m *MockAttachmentRetriever) ListByID(ctx context.Context, id int64) (Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockAttachmentRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockAttachmentRetriever)(nil).ListByID), ctx, id)
+}
+
+// ListByTaskID mocks base method.
+func (m *MockAttachmentRetriever) ListByTaskID(ctx context.Context, taskID int64) ([]Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockAttachmentRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockAttachmentRetriever)(nil).ListByTaskID), ctx, taskID)
+}
+
+// MockAttachmentRemover is a mock of AttachmentRemover interface.
+type MockAttachmentRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentRemoverMockRecorder
+}
+
+// MockAttachmentRemoverMockRecorder is the mock recorder for MockAttachmentRemover.
+type MockAttachmentRemoverMockRecorder struct {
+	mock *MockAttachmentRemover
+}
+
+// NewMockAttachmentRemover creates a new mock instance.
+func NewMockAttachmentRemover(ctrl *gomock.Controller) *MockAttachmentRemover {
+	mock := &MockAttachmentRemover{ctrl: ctrl}
+	mock.recorder = &MockAttachmentRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentRemover) EXPECT() *MockAttachmentRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockAttachmentRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAttachmentRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAttachmentRemover)(nil).Remove), ctx, id)
+}
+
+// MockBlobStorage is a mock of BlobStorage interface.
+type MockBlobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStorageMockRecorder
+}
+
+// MockBlobStorageMockRecorder is the mock recorder for MockBlobStorage.
+type MockBlobStorageMockRecorder struct {
+	mock *MockBlobStorage
+}
+
+// NewMockBlobStorage creates a new mock instance.
+func NewMockBlobStorage(ctrl *gomock.Controller) *MockBlobStorage {
+	mock := &MockBlobStorage{ctrl: ctrl}
+	mock.recorder = &MockBlobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStorage) EXPECT() *MockBlobStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStorageMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockBlobStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStorageMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStorage)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStorage) Put(ctx context.Context, key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStorageMockRecorder) Put(ctx, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStorage)(nil).Put), ctx, key, data)
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewAttachment(t *testing.T) {
+	type args struct {
+		taskID      int64
+		userID      int64
+		name        string
+		contentType string
+		size        int64
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Attachment
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when task is missing",
+			args:    args{userID: 2, name: "before.jpg", contentType: "image/jpeg", size: 10},
+			want:    Attachment{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when file is empty",
+			args:    args{taskID: 1, userID: 2, name: "before.jpg", contentType: "image/jpeg"},
+			want:    Attachment{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when name is too big",
+			args:    args{taskID: 1, userID: 2, name: strings.Repeat("a", 256), contentType: "image/jpeg", size: 10},
+			want:    Attachment{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when content type is not allowed",
+			args:    args{taskID: 1, userID: 2, name: "script.sh", contentType: "text/plain", size: 10},
+			want:    Attachment{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect success",
+			args:    args{taskID: 1, userID: 2, name: "form.pdf", contentType: "application/pdf", size: 10},
+			want:    Attachment{TaskID: 1, UserID: 2, Name: "form.pdf", ContentType: "application/pdf", Size: 10},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAttachment(tt.args.taskID, tt.args.userID, tt.args.name, tt.args.contentType, tt.args.size)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidAttachment))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//go:generate mockgen -source=calendar.go -destination=calendar_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+type CalendarUsecase interface {
+	Feed(ctx context.Context, token string) (CalendarFeed, error)
+	IssueToken(ctx context.Context, user User) (string, error)
+	RevokeToken(ctx context.Context, user User) error
+}
+
+type CalendarTokenCreator interface {
+	Add(ctx context.Context, token CalendarToken) error
+}
+
+type CalendarTokenRetriever interface {
+	ListByHash(ctx context.Context, hash string) (CalendarToken, error)
+}
+
+type CalendarTokenRemover interface {
+	Remove(ctx context.Context, userID int64) error
+}
+
+type CalendarEventRetriever interface {
+	// ListCalendarEvents returns the tasks of the user scheduled from since on,
+	// including the ones removed after since so they can be cancelled.
+	ListCalendarEvents(ctx context.Context, userID int64, since time.Time) ([]CalendarEvent, error)
+}
+
+// CalendarToken grants read access to the calendar feed of a user. Only the
+// SHA-256 Hash of the token is stored; a user has at most one token, so adding
+// a new one revokes the previous.
+type CalendarToken struct {
+	UserID    int64
+	Hash      string
+	CreatedAt time.Time
+}
+
+type CalendarFeed struct {
+	User   User
+	Events []CalendarEvent
+}
+
+// CalendarEvent is a scheduled task as seen by a calendar. Cancelled is set
+// when the task was removed.
+type CalendarEvent struct {
+	Task      Task
+	Cancelled bool
+	UpdatedAt time.Time
+}
+
+// Sequence is the RFC 5545 revision of the event. Every change to a task,
+// removal included, bumps its version, so calendars pick the change up.
+func (e CalendarEvent) Sequence() int64 {
+	if e.Task.Version < InitialTaskVersion {
+		return 0
+	}
+
+	return e.Task.Version - InitialTaskVersion
+}
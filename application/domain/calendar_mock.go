@@ -0,0 +1,230 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendar.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCalendarUsecase is a mock of CalendarUsecase interface.
+type MockCalendarUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarUsecaseMockRecorder
+}
+
+// MockCalendarUsecaseMockRecorder is the mock recorder for MockCalendarUsecase.
+type MockCalendarUsecaseMockRecorder struct {
+	mock *MockCalendarUsecase
+}
+
+// NewMockCalendarUsecase creates a new mock instance.
+func NewMockCalendarUsecase(ctrl *gomock.Controller) *MockCalendarUsecase {
+	mock := &MockCalendarUsecase{ctrl: ctrl}
+	mock.recorder = &MockCalendarUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarUsecase) EXPECT() *MockCalendarUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Feed mocks base method.
+func (m *MockCalendarUsecase) Feed(ctx context.Context, token string) (CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, token)
+	ret0, _ := ret[0].(CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockCalendarUsecaseMockRecorder) Feed(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockCalendarUsecase)(nil).Feed), ctx, token)
+}
+
+// IssueToken mocks base method.
+func (m *MockCalendarUsecase) IssueToken(ctx context.Context, user User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", ctx, user)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MockCalendarUsecaseMockRecorder) IssueToken(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockCalendarUsecase)(nil).IssueToken), ctx, user)
+}
+
+// RevokeToken mocks base method.
+func (m *MockCalendarUsecase) RevokeToken(ctx context.Context, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockCalendarUsecaseMockRecorder) RevokeToken(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockCalendarUsecase)(nil).RevokeToken), ctx, user)
+}
+
+// MockCalendarTokenCreator is a mock of CalendarTokenCreator interface.
+type MockCalendarTokenCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarTokenCreatorMockRecorder
+}
+
+// MockCalendarTokenCreatorMockRecorder is the mock recorder for MockCalendarTokenCreator.
+type MockCalendarTokenCreatorMockRecorder struct {
+	mock *MockCalendarTokenCreator
+}
+
+// NewMockCalendarTokenCreator creates a new mock instance.
+func NewMockCalendarTokenCreator(ctrl *gomock.Controller) *MockCalendarTokenCreator {
+	mock := &MockCalendarTokenCreator{ctrl: ctrl}
+	mock.recorder = &MockCalendarTokenCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarTokenCreator) EXPECT() *MockCalendarTokenCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCalendarTokenCreator) Add(ctx context.Context, token CalendarToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCalendarTokenCreatorMockRecorder) Add(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCalendarTokenCreator)(nil).Add), ctx, token)
+}
+
+// MockCalendarTokenRetriever is a mock of CalendarTokenRetriever interface.
+type MockCalendarTokenRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarTokenRetrieverMockRecorder
+}
+
+// MockCalendarTokenRetrieverMockRecorder is the mock recorder for MockCalendarTokenRetriever.
+type MockCalendarTokenRetrieverMockRecorder struct {
+	mock *MockCalendarTokenRetriever
+}
+
+// NewMockCalendarTokenRetriever creates a new mock instance.
+func NewMockCalendarTokenRetriever(ctrl *gomock.Controller) *MockCalendarTokenRetriever {
+	mock := &MockCalendarTokenRetriever{ctrl: ctrl}
+	mock.recorder = &MockCalendarTokenRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarTokenRetriever) EXPECT() *MockCalendarTokenRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByHash mocks base method.
+func (m *MockCalendarTokenRetriever) ListByHash(ctx context.Context, hash string) (CalendarToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByHash", ctx, hash)
+	ret0, _ := ret[0].(CalendarToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByHash indicates an expected call of ListByHash.
+func (mr *MockCalendarTokenRetrieverMockRecorder) ListByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByHash", reflect.TypeOf((*MockCalendarTokenRetriever)(nil).ListByHash), ctx, hash)
+}
+
+// MockCalendarTokenRemover is a mock of CalendarTokenRemover interface.
+type MockCalendarTokenRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarTokenRemoverMockRecorder
+}
+
+// MockCalendarTokenRemoverMockRecorder is the mock recorder for MockCalendarTokenRemover.
+type MockCalendarTokenRemoverMockRecorder struct {
+	mock *MockCalendarTokenRemover
+}
+
+// NewMockCalendarTokenRemover creates a new mock instance.
+func NewMockCalendarTokenRemover(ctrl *gomock.Controller) *MockCalendarTokenRemover {
+	mock := &MockCalendarTokenRemover{ctrl: ctrl}
+	mock.recorder = &MockCalendarTokenRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarTokenRemover) EXPECT() *MockCalendarTokenRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockCalendarTokenRemover) Remove(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCalendarTokenRemoverMockRecorder) Remove(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCalendarTokenRemover)(nil).Remove), ctx, userID)
+}
+
+// MockCalendarEventRetriever is a mock of CalendarEventRetriever interface.
+type MockCalendarEventRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarEventRetrieverMockRecorder
+}
+
+// MockCalendarEventRetrieverMockRecorder is the mock recorder for MockCalendarEventRetriever.
+type MockCalendarEventRetrieverMockRecorder struct {
+	mock *MockCalendarEventRetriever
+}
+
+// NewMockCalendarEventRetriever creates a new mock instance.
+func NewMockCalendarEventRetriever(ctrl *gomock.Controller) *MockCalendarEventRetriever {
+	mock := &MockCalendarEventRetriever{ctrl: ctrl}
+	mock.recorder = &MockCalendarEventRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarEventRetriever) EXPECT() *MockCalendarEventRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListCalendarEvents mocks base method.
+func (m *MockCalendarEventRetriever) ListCalendarEvents(ctx context.Context, userID int64, since time.Time) ([]CalendarEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendarEvents", ctx, userID, since)
+	ret0, _ := ret[0].([]CalendarEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendarEvents indicates an expected call of ListCalendarEvents.
+func (mr *MockCalendarEventRetrieverMockRecorder) ListCalendarEvents(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendarEvents", reflect.TypeOf((*MockCalendarEventRetriever)(nil).ListCalendarEvents), ctx, userID, since)
+}
@@ -0,0 +1,27 @@
+//go:build unit
+
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCalendarEvent_Sequence(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int64
+		want    int64
+	}{
+		{name: "Expect new task to be the first revision", version: InitialTaskVersion, want: 0},
+		{name: "Expect each change to bump the revision", version: 4, want: 3},
+		{name: "Expect task without version to be the first revision", version: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := CalendarEvent{Task: Task{Version: tt.version}}
+
+			assert.Equal(t, tt.want, event.Sequence())
+		})
+	}
+}
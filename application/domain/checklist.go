@@ -0,0 +1,150 @@
+//go:generate mockgen -source=checklist.go -destination=checklist_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidChecklist          = errors.New("checklist fields are invalid")
+	ErrChecklistTemplateNotFound = errors.New("checklist template not found")
+	ErrChecklistItemNotFound     = errors.New("checklist item not found")
+	ErrChecklistIncomplete       = errors.New("required checklist items are unchecked")
+)
+
+type ChecklistTemplateUsecase interface {
+	Add(ctx context.Context, template ChecklistTemplate, user User) (ChecklistTemplate, error)
+	List(ctx context.Context, user User) ([]ChecklistTemplate, error)
+	Update(ctx context.Context, template ChecklistTemplate, user User) (ChecklistTemplate, error)
+	Remove(ctx context.Context, id int64, user User) error
+}
+
+type ChecklistTemplateCreator interface {
+	Add(ctx context.Context, template ChecklistTemplate) (int64, error)
+}
+
+type ChecklistTemplateRetriever interface {
+	List(ctx context.Context) ([]ChecklistTemplate, error)
+	ListByID(ctx context.Context, id int64) (ChecklistTemplate, error)
+}
+
+type ChecklistTemplateUpdater interface {
+	Update(ctx context.Context, template ChecklistTemplate) error
+}
+
+type ChecklistTemplateRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type ChecklistUsecase interface {
+	Attach(ctx context.Context, taskID, templateID int64, user User) ([]ChecklistItem, error)
+	ListByTask(ctx context.Context, taskID int64, user User) ([]ChecklistItem, error)
+	Check(ctx context.Context, taskID, itemID int64, checked bool, user User) (ChecklistItem, error)
+}
+
+type ChecklistItemCreator interface {
+	Add(ctx context.Context, taskID int64, items []ChecklistItem) error
+}
+
+type ChecklistItemRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64) ([]ChecklistItem, error)
+	ListByID(ctx context.Context, id int64) (ChecklistItem, error)
+}
+
+type ChecklistItemUpdater interface {
+	Update(ctx context.Context, item ChecklistItem) error
+}
+
+type ChecklistTemplate struct {
+	ID    int64
+	Name  string
+	Items []ChecklistTemplateItem
+}
+
+type ChecklistTemplateItem struct {
+	Label    string
+	Required bool
+}
+
+type ChecklistItem struct {
+	ID         int64
+	TaskID     int64
+	TemplateID int64
+	Position   int
+	Label      string
+	Required   bool
+	CheckedBy  int64
+	CheckedAt  *time.Time
+}
+
+func NewChecklistTemplate(name string, items []ChecklistTemplateItem) (ChecklistTemplate, error) {
+	var err []string
+
+	if name == "" {
+		err = append(err, "name must not be empty")
+	}
+
+	if len(name) > 255 {
+		err = append(err, "name size is too big")
+	}
+
+	if len(items) == 0 {
+		err = append(err, "items must not be empty")
+	}
+
+	for i, item := range items {
+		if item.Label == "" {
+			err = append(err, fmt.Sprintf("item %d label must not be empty", i+1))
+		}
+
+		if len(item.Label) > 255 {
+			err = append(err, fmt.Sprintf("item %d label size is too big", i+1))
+		}
+	}
+
+	if len(err) > 0 {
+		return ChecklistTemplate{}, fmt.Errorf("%w: %s", ErrInvalidChecklist, strings.Join(err, "; "))
+	}
+
+	return ChecklistTemplate{Name: name, Items: items}, nil
+}
+
+func (t ChecklistTemplate) ItemsFor(taskID int64) []ChecklistItem {
+	items := make([]ChecklistItem, len(t.Items))
+	for i, item := range t.Items {
+		items[i] = ChecklistItem{
+			TaskID:     taskID,
+			TemplateID: t.ID,
+			Position:   i + 1,
+			Label:      item.Label,
+			Required:   item.Required,
+		}
+	}
+
+	return items
+}
+
+func (i *ChecklistItem) IsChecked() bool {
+	return i.CheckedAt != nil
+}
+
+func (t *Task) Complete(checklist []ChecklistItem) error {
+	var unchecked []string
+
+	for _, item := range checklist {
+		if item.Required && !item.IsChecked() {
+			unchecked = append(unchecked, item.Label)
+		}
+	}
+
+	if len(unchecked) > 0 {
+		return fmt.Errorf("%w: %s", ErrChecklistIncomplete, strings.Join(unchecked, ", "))
+	}
+
+	return t.TransitionTo(TaskStatusCompleted)
+}
@@ -0,0 +1,454 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checklist.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockChecklistTemplateUsecase is a mock of ChecklistTemplateUsecase interface.
+type MockChecklistTemplateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistTemplateUsecaseMockRecorder
+}
+
+// MockChecklistTemplateUsecaseMockRecorder is the mock recorder for MockChecklistTemplateUsecase.
+type MockChecklistTemplateUsecaseMockRecorder struct {
+	mock *MockChecklistTemplateUsecase
+}
+
+// NewMockChecklistTemplateUsecase creates a new mock instance.
+func NewMockChecklistTemplateUsecase(ctrl *gomock.Controller) *MockChecklistTemplateUsecase {
+	mock := &MockChecklistTemplateUsecase{ctrl: ctrl}
+	mock.recorder = &MockChecklistTemplateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistTemplateUsecase) EXPECT() *MockChecklistTemplateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockChecklistTemplateUsecase) Add(ctx context.Context, template ChecklistTemplate, user User) (ChecklistTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, template, user)
+	ret0, _ := ret[0].(ChecklistTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockChecklistTemplateUsecaseMockRecorder) Add(ctx, template, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockChecklistTemplateUsecase)(nil).Add), ctx, template, user)
+}
+
+// List mocks base method.
+func (m *MockChecklistTemplateUsecase) List(ctx context.Context, user User) ([]ChecklistTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, user)
+	ret0, _ := ret[0].([]ChecklistTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockChecklistTemplateUsecaseMockRecorder) List(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChecklistTemplateUsecase)(nil).List), ctx, user)
+}
+
+// Remove mocks base method.
+func (m *MockChecklistTemplateUsecase) Remove(ctx context.Context, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockChecklistTemplateUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockChecklistTemplateUsecase)(nil).Remove), ctx, id, user)
+}
+
+// Update mocks base method.
+func (m *MockChecklistTemplateUsecase) Update(ctx context.Context, template ChecklistTemplate, user User) (ChecklistTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, template, user)
+	ret0, _ := ret[0].(ChecklistTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistTemplateUsecaseMockRecorder) Update(ctx, template, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistTemplateUsecase)(nil).Update), ctx, template, user)
+}
+
+// MockChecklistTemplateCreator is a mock of ChecklistTemplateCreator interface.
+type MockChecklistTemplateCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistTemplateCreatorMockRecorder
+}
+
+// MockChecklistTemplateCreatorMockRecorder is the mock recorder for MockChecklistTemplateCreator.
+type MockChecklistTemplateCreatorMockRecorder struct {
+	mock *MockChecklistTemplateCreator
+}
+
+// NewMockChecklistTemplateCreator creates a new mock instance.
+func NewMockChecklistTemplateCreator(ctrl *gomock.Controller) *MockChecklistTemplateCreator {
+	mock := &MockChecklistTemplateCreator{ctrl: ctrl}
+	mock.recorder = &MockChecklistTemplateCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistTemplateCreator) EXPECT() *MockChecklistTemplateCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockChecklistTemplateCreator) Add(ctx context.Context, template ChecklistTemplate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, template)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockChecklistTemplateCreatorMockRecorder) Add(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockChecklistTemplateCreator)(nil).Add), ctx, template)
+}
+
+// MockChecklistTemplateRetriever is a mock of ChecklistTemplateRetriever interface.
+type MockChecklistTemplateRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistTemplateRetrieverMockRecorder
+}
+
+// MockChecklistTemplateRetrieverMockRecorder is the mock recorder for MockChecklistTemplateRetriever.
+type MockChecklistTemplateRetrieverMockRecorder struct {
+	mock *MockChecklistTemplateRetriever
+}
+
+// NewMockChecklistTemplateRetriever creates a new mock instance.
+func NewMockChecklistTemplateRetriever(ctrl *gomock.Controller) *MockChecklistTemplateRetriever {
+	mock := &MockChecklistTemplateRetriever{ctrl: ctrl}
+	mock.recorder = &MockChecklistTemplateRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistTemplateRetriever) EXPECT() *MockChecklistTemplateRetrieverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockChecklistTemplateRetriever) List(ctx context.Context) ([]ChecklistTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]ChecklistTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockChecklistTemplateRetrieverMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChecklistTemplateRetriever)(nil).List), ctx)
+}
+
+// ListByID mocks base method.
+func (m *MockChecklistTemplateRetriever) ListByID(ctx context.Context, id int64) (ChecklistTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(ChecklistTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockChecklistTemplateRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockChecklistTemplateRetriever)(nil).ListByID), ctx, id)
+}
+
+// MockChecklistTemplateUpdater is a mock of ChecklistTemplateUpdater interface.
+type MockChecklistTemplateUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistTemplateUpdaterMockRecorder
+}
+
+// MockChecklistTemplateUpdaterMockRecorder is the mock recorder for MockChecklistTemplateUpdater.
+type MockChecklistTemplateUpdaterMockRecorder struct {
+	mock *MockChecklistTemplateUpdater
+}
+
+// NewMockChecklistTemplateUpdater creates a new mock instance.
+func NewMockChecklistTemplateUpdater(ctrl *gomock.Controller) *MockChecklistTemplateUpdater {
+	mock := &MockChecklistTemplateUpdater{ctrl: ctrl}
+	mock.recorder = &MockChecklistTemplateUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistTemplateUpdater) EXPECT() *MockChecklistTemplateUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockChecklistTemplateUpdater) Update(ctx context.Context, template ChecklistTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistTemplateUpdaterMockRecorder) Update(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistTemplateUpdater)(nil).Update), ctx, template)
+}
+
+// MockChecklistTemplateRemover is a mock of ChecklistTemplateRemover interface.
+type MockChecklistTemplateRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistTemplateRemoverMockRecorder
+}
+
+// MockChecklistTemplateRemoverMockRecorder is the mock recorder for MockChecklistTemplateRemover.
+type MockChecklistTemplateRemoverMockRecorder struct {
+	mock *MockChecklistTemplateRemover
+}
+
+// NewMockChecklistTemplateRemover creates a new mock instance.
+func NewMockChecklistTemplateRemover(ctrl *gomock.Controller) *MockChecklistTemplateRemover {
+	mock := &MockChecklistTemplateRemover{ctrl: ctrl}
+	mock.recorder = &MockChecklistTemplateRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistTemplateRemover) EXPECT() *MockChecklistTemplateRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockChecklistTemplateRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockChecklistTemplateRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockChecklistTemplateRemover)(nil).Remove), ctx, id)
+}
+
+// MockChecklistUsecase is a mock of ChecklistUsecase interface.
+type MockChecklistUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistUsecaseMockRecorder
+}
+
+// MockChecklistUsecaseMockRecorder is the mock recorder for MockChecklistUsecase.
+type MockChecklistUsecaseMockRecorder struct {
+	mock *MockChecklistUsecase
+}
+
+// NewMockChecklistUsecase creates a new mock instance.
+func NewMockChecklistUsecase(ctrl *gomock.Controller) *MockChecklistUsecase {
+	mock := &MockChecklistUsecase{ctrl: ctrl}
+	mock.recorder = &MockChecklistUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistUsecase) EXPECT() *MockChecklistUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockChecklistUsecase) Attach(ctx context.Context, taskID, templateID int64, user User) ([]ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, taskID, templateID, user)
+	ret0, _ := ret[0].([]ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockChecklistUsecaseMockRecorder) Attach(ctx, taskID, templateID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockChecklistUsecase)(nil).Attach), ctx, taskID, templateID, user)
+}
+
+// Check mocks base method.
+func (m *MockChecklistUsecase) Check(ctx context.Context, taskID, itemID int64, checked bool, user User) (ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, taskID, itemID, checked, user)
+	ret0, _ := ret[0].(ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockChecklistUsecaseMockRecorder) Check(ctx, taskID, itemID, checked, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockChecklistUsecase)(nil).Check), ctx, taskID, itemID, checked, user)
+}
+
+// ListByTask mocks base method.
+func (m *MockChecklistUsecase) ListByTask(ctx context.Context, taskID int64, user User) ([]ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockChecklistUsecaseMockRecorder) ListByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockChecklistUsecase)(nil).ListByTask), ctx, taskID, user)
+}
+
+// MockChecklistItemCreator is a mock of ChecklistItemCreator interface.
+type MockChecklistItemCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistItemCreatorMockRecorder
+}
+
+// MockChecklistItemCreatorMockRecorder is the mock recorder for MockChecklistItemCreator.
+type MockChecklistItemCreatorMockRecorder struct {
+	mock *MockChecklistItemCreator
+}
+
+// NewMockChecklistItemCreator creates a new mock instance.
+func NewMockChecklistItemCreator(ctrl *gomock.Controller) *MockChecklistItemCreator {
+	mock := &MockChecklistItemCreator{ctrl: ctrl}
+	mock.recorder = &MockChecklistItemCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistItemCreator) EXPECT() *MockChecklistItemCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockChecklistItemCreator) Add(ctx context.Context, taskID int64, items []ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, taskID, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockChecklistItemCreatorMockRecorder) Add(ctx, taskID, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockChecklistItemCreator)(nil).Add), ctx, taskID, items)
+}
+
+// MockChecklistItemRetriever is a mock of ChecklistItemRetriever interface.
+type MockChecklistItemRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistItemRetrieverMockRecorder
+}
+
+// MockChecklistItemRetrieverMockRecorder is the mock recorder for MockChecklistItemRetriever.
+type MockChecklistItemRetrieverMockRecorder struct {
+	mock *MockChecklistItemRetriever
+}
+
+// NewMockChecklistItemRetriever creates a new mock instance.
+func NewMockChecklistItemRetriever(ctrl *gomock.Controller) *MockChecklistItemRetriever {
+	mock := &MockChecklistItemRetriever{ctrl: ctrl}
+	mock.recorder = &MockChecklistItemRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistItemRetriever) EXPECT() *MockChecklistItemRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByID mocks base method.
+func (m *MockChecklistItemRetriever) ListByID(ctx context.Context, id int64) (ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockChecklistItemRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockChecklistItemRetriever)(nil).ListByID), ctx, id)
+}
+
+// ListByTaskID mocks base method.
+func (m *MockChecklistItemRetriever) ListByTaskID(ctx context.Context, taskID int64) ([]ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockChecklistItemRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockChecklistItemRetriever)(nil).ListByTaskID), ctx, taskID)
+}
+
+// MockChecklistItemUpdater is a mock of ChecklistItemUpdater interface.
+type MockChecklistItemUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistItemUpdaterMockRecorder
+}
+
+// MockChecklistItemUpdaterMockRecorder is the mock recorder for MockChecklistItemUpdater.
+type MockChecklistItemUpdaterMockRecorder struct {
+	mock *MockChecklistItemUpdater
+}
+
+// NewMockChecklistItemUpdater creates a new mock instance.
+func NewMockChecklistItemUpdater(ctrl *gomock.Controller) *MockChecklistItemUpdater {
+	mock := &MockChecklistItemUpdater{ctrl: ctrl}
+	mock.recorder = &MockChecklistItemUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistItemUpdater) EXPECT() *MockChecklistItemUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockChecklistItemUpdater) Update(ctx context.Context, item ChecklistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistItemUpdaterMockRecorder) Update(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistItemUpdater)(nil).Update), ctx, item)
+}
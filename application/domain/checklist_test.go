@@ -0,0 +1,123 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewChecklistTemplate(t *testing.T) {
+	items := []ChecklistTemplateItem{{Label: "Isolate power", Required: true}, {Label: "Take photos"}}
+
+	tests := []struct {
+		name     string
+		template string
+		items    []ChecklistTemplateItem
+		want     ChecklistTemplate
+		wantErr  bool
+	}{
+		{
+			name:    "Expect error when name is empty",
+			items:   items,
+			want:    ChecklistTemplate{},
+			wantErr: true,
+		},
+		{
+			name:     "Expect error when items are empty",
+			template: "Electrical safety",
+			want:     ChecklistTemplate{},
+			wantErr:  true,
+		},
+		{
+			name:     "Expect error when an item label is empty",
+			template: "Electrical safety",
+			items:    []ChecklistTemplateItem{{Label: "Isolate power"}, {Required: true}},
+			want:     ChecklistTemplate{},
+			wantErr:  true,
+		},
+		{
+			name:     "Expect error when an item label is too big",
+			template: "Electrical safety",
+			items:    []ChecklistTemplateItem{{Label: strings.Repeat("a", 256)}},
+			want:     ChecklistTemplate{},
+			wantErr:  true,
+		},
+		{
+			name:     "Expect success",
+			template: "Electrical safety",
+			items:    items,
+			want:     ChecklistTemplate{Name: "Electrical safety", Items: items},
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChecklistTemplate(tt.template, tt.items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklistTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidChecklist))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTask_Complete(t *testing.T) {
+	checkedAt := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    string
+		checklist []ChecklistItem
+		wantErr   error
+	}{
+		{
+			name:   "Expect error when a required item is unchecked",
+			status: TaskStatusInProgress,
+			checklist: []ChecklistItem{
+				{Label: "Isolate power", Required: true, CheckedAt: &checkedAt},
+				{Label: "Test RCD", Required: true},
+			},
+			wantErr: ErrChecklistIncomplete,
+		},
+		{
+			name:   "Expect optional items not to block completion",
+			status: TaskStatusInProgress,
+			checklist: []ChecklistItem{
+				{Label: "Isolate power", Required: true, CheckedAt: &checkedAt},
+				{Label: "Take photos"},
+			},
+		},
+		{
+			name:   "Expect success without checklist",
+			status: TaskStatusOpen,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := Task{ID: 1, Status: tt.status}
+
+			err := task.Complete(tt.checklist)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Complete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.status, task.Status)
+				return
+			}
+
+			assert.Equal(t, TaskStatusCompleted, task.Status)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//go:generate mockgen -source=comment.go -destination=comment_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidComment  = errors.New("comment fields are invalid")
+	ErrCommentNotFound = errors.New("comment not found")
+)
+
+const (
+	EventTaskCommented = "task.commented"
+
+	MaxCommentLength = 2500
+)
+
+type CommentUsecase interface {
+	Add(ctx context.Context, comment Comment, user User) (Comment, error)
+	ListByTask(ctx context.Context, taskID int64, user User) ([]Comment, error)
+	Update(ctx context.Context, comment Comment, user User) (Comment, error)
+	Remove(ctx context.Context, taskID, id int64, user User) error
+}
+
+type CommentCreator interface {
+	Add(ctx context.Context, comment Comment) (int64, error)
+}
+
+type CommentRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64, internal bool) ([]Comment, error)
+	ListByID(ctx context.Context, id int64) (Comment, error)
+}
+
+type CommentUpdater interface {
+	Update(ctx context.Context, comment Comment) error
+}
+
+type CommentRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type Comment struct {
+	ID        int64
+	TaskID    int64
+	UserID    int64
+	Body      string
+	Internal  bool
+	CreatedAt time.Time
+	EditedAt  *time.Time
+}
+
+func NewComment(taskID, userID int64, body string, internal bool) (Comment, error) {
+	var err []string
+
+	if taskID == 0 {
+		err = append(err, "task ID must not be 0")
+	}
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if e := validateCommentBody(body); e != "" {
+		err = append(err, e)
+	}
+
+	if len(err) > 0 {
+		return Comment{}, fmt.Errorf("%w: %s", ErrInvalidComment, strings.Join(err, "; "))
+	}
+
+	return Comment{TaskID: taskID, UserID: userID, Body: strings.TrimSpace(body), Internal: internal}, nil
+}
+
+func (c *Comment) Edit(body string, at time.Time) error {
+	if e := validateCommentBody(body); e != "" {
+		return fmt.Errorf("%w: %s", ErrInvalidComment, e)
+	}
+
+	c.Body = strings.TrimSpace(body)
+	c.EditedAt = &at
+
+	return nil
+}
+
+func validateCommentBody(body string) string {
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		return "body must not be empty"
+	}
+
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return "body size is too big"
+	}
+
+	return ""
+}
@@ -0,0 +1,259 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCommentUsecase is a mock of CommentUsecase interface.
+type MockCommentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentUsecaseMockRecorder
+}
+
+// MockCommentUsecaseMockRecorder is the mock recorder for MockCommentUsecase.
+type MockCommentUsecaseMockRecorder struct {
+	mock *MockCommentUsecase
+}
+
+// NewMockCommentUsecase creates a new mock instance.
+func NewMockCommentUsecase(ctrl *gomock.Controller) *MockCommentUsecase {
+	mock := &MockCommentUsecase{ctrl: ctrl}
+	mock.recorder = &MockCommentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentUsecase) EXPECT() *MockCommentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCommentUsecase) Add(ctx context.Context, comment Comment, user User) (Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, comment, user)
+	ret0, _ := ret[0].(Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCommentUsecaseMockRecorder) Add(ctx, comment, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCommentUsecase)(nil).Add), ctx, comment, user)
+}
+
+// ListByTask mocks base method.
+func (m *MockCommentUsecase) ListByTask(ctx context.Context, taskID int64, user User) ([]Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockCommentUsecaseMockRecorder) ListByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockCommentUsecase)(nil).ListByTask), ctx, taskID, user)
+}
+
+// Remove mocks base method.
+func (m *MockCommentUsecase) Remove(ctx context.Context, taskID, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, taskID, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCommentUsecaseMockRecorder) Remove(ctx, taskID, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCommentUsecase)(nil).Remove), ctx, taskID, id, user)
+}
+
+// Update mocks base method.
+func (m *MockCommentUsecase) Update(ctx context.Context, comment Comment, user User) (Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment, user)
+	ret0, _ := ret[0].(Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentUsecaseMockRecorder) Update(ctx, comment, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentUsecase)(nil).Update), ctx, comment, user)
+}
+
+// MockCommentCreator is a mock of CommentCreator interface.
+type MockCommentCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentCreatorMockRecorder
+}
+
+// MockCommentCreatorMockRecorder is the mock recorder for MockCommentCreator.
+type MockCommentCreatorMockRecorder struct {
+	mock *MockCommentCreator
+}
+
+// NewMockCommentCreator creates a new mock instance.
+func NewMockCommentCreator(ctrl *gomock.Controller) *MockCommentCreator {
+	mock := &MockCommentCreator{ctrl: ctrl}
+	mock.recorder = &MockCommentCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentCreator) EXPECT() *MockCommentCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCommentCreator) Add(ctx context.Context, comment Comment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, comment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCommentCreatorMockRecorder) Add(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCommentCreator)(nil).Add), ctx, comment)
+}
+
+// MockCommentRetriever is a mock of CommentRetriever interface.
+type MockCommentRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRetrieverMockRecorder
+}
+
+// MockCommentRetrieverMockRecorder is the mock recorder for MockCommentRetriever.
+type MockCommentRetrieverMockRecorder struct {
+	mock *MockCommentRetriever
+}
+
+// NewMockCommentRetriever creates a new mock instance.
+func NewMockCommentRetriever(ctrl *gomock.Controller) *MockCommentRetriever {
+	mock := &MockCommentRetriever{ctrl: ctrl}
+	mock.recorder = &MockCommentRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRetriever) EXPECT() *MockCommentRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByID mocks base method.
+func (m *MockCommentRetriever) ListByID(ctx context.Context, id int64) (Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockCommentRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockCommentRetriever)(nil).ListByID), ctx, id)
+}
+
+// ListByTaskID mocks base method.
+func (m *MockCommentRetriever) ListByTaskID(ctx context.Context, taskID int64, internal bool) ([]Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID, internal)
+	ret0, _ := ret[0].([]Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockCommentRetrieverMockRecorder) ListByTaskID(ctx, taskID, internal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockCommentRetriever)(nil).ListByTaskID), ctx, taskID, internal)
+}
+
+// MockCommentUpdater is a mock of CommentUpdater interface.
+type MockCommentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentUpdaterMockRecorder
+}
+
+// MockCommentUpdaterMockRecorder is the mock recorder for MockCommentUpdater.
+type MockCommentUpdaterMockRecorder struct {
+	mock *MockCommentUpdater
+}
+
+// NewMockCommentUpdater creates a new mock instance.
+func NewMockCommentUpdater(ctrl *gomock.Controller) *MockCommentUpdater {
+	mock := &MockCommentUpdater{ctrl: ctrl}
+	mock.recorder = &MockCommentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentUpdater) EXPECT() *MockCommentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockCommentUpdater) Update(ctx context.Context, comment Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentUpdaterMockRecorder) Update(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentUpdater)(nil).Update), ctx, comment)
+}
+
+// MockCommentRemover is a mock of CommentRemover interface.
+type MockCommentRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentRemoverMockRecorder
+}
+
+// MockCommentRemoverMockRecorder is the mock recorder for MockCommentRemover.
+type MockCommentRemoverMockRecorder struct {
+	mock *MockCommentRemover
+}
+
+// NewMockCommentRemover creates a new mock instance.
+func NewMockCommentRemover(ctrl *gomock.Controller) *MockCommentRemover {
+	mock := &MockCommentRemover{ctrl: ctrl}
+	mock.recorder = &MockCommentRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentRemover) EXPECT() *MockCommentRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockCommentRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCommentRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCommentRemover)(nil).Remove), ctx, id)
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewComment(t *testing.T) {
+	type args struct {
+		taskID   int64
+		userID   int64
+		body     string
+		internal bool
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Comment
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when task is missing",
+			args:    args{userID: 2, body: "Breaker replaced"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when author is missing",
+			args:    args{taskID: 1, body: "Breaker replaced"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when body is blank",
+			args:    args{taskID: 1, userID: 2, body: "   "},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when body is too big",
+			args:    args{taskID: 1, userID: 2, body: strings.Repeat("a", MaxCommentLength+1)},
+			wantErr: true,
+		},
+		{
+			name: "Expect multi-byte body at the limit to be accepted",
+			args: args{taskID: 1, userID: 2, body: strings.Repeat("é", MaxCommentLength)},
+			want: Comment{TaskID: 1, UserID: 2, Body: strings.Repeat("é", MaxCommentLength)},
+		},
+		{
+			name: "Expect success",
+			args: args{taskID: 1, userID: 1, body: " Customer asked for a quote ", internal: true},
+			want: Comment{TaskID: 1, UserID: 1, Body: "Customer asked for a quote", Internal: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewComment(tt.args.taskID, tt.args.userID, tt.args.body, tt.args.internal)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidComment))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComment_Edit(t *testing.T) {
+	at := time.Date(2023, 12, 3, 10, 0, 0, 0, time.UTC)
+
+	comment := Comment{ID: 1, Body: "Breaker replaced"}
+
+	err := comment.Edit("", at)
+	assert.True(t, errors.Is(err, ErrInvalidComment))
+	assert.Equal(t, "Breaker replaced", comment.Body)
+	assert.Nil(t, comment.EditedAt)
+
+	err = comment.Edit(" Breaker and fuse replaced ", at)
+	assert.NoError(t, err)
+	assert.Equal(t, "Breaker and fuse replaced", comment.Body)
+	assert.Equal(t, &at, comment.EditedAt)
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	traceParentKey
+	transactionKey
+	commitHooksKey
+)
+
+type commitHooks struct {
+	hooks []func()
+}
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+
+	return id
+}
+
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return context.WithValue(ctx, traceParentKey, traceParent)
+}
+
+func TraceParentFrom(ctx context.Context) string {
+	traceParent, _ := ctx.Value(traceParentKey).(string)
+
+	return traceParent
+}
+
+// WithTransaction carries tx in ctx so repositories run their statements in
+// it. The returned function runs the hooks registered with AfterCommit and must
+// be called once tx commits.
+func WithTransaction(ctx context.Context, tx any) (context.Context, func()) {
+	hooks := &commitHooks{}
+
+	ctx = context.WithValue(ctx, transactionKey, tx)
+	ctx = context.WithValue(ctx, commitHooksKey, hooks)
+
+	return ctx, func() {
+		for _, hook := range hooks.hooks {
+			hook()
+		}
+	}
+}
+
+func TransactionFrom(ctx context.Context) any {
+	return ctx.Value(transactionKey)
+}
+
+// AfterCommit runs fn once the transaction carried by ctx commits, or right
+// away when ctx carries none. Hooks of a rolled back transaction never run.
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(commitHooksKey).(*commitHooks)
+	if !ok {
+		fn()
+		return
+	}
+
+	hooks.hooks = append(hooks.hooks, fn)
+}
+
+// Detach returns a context that keeps the values of ctx but not its
+// cancellation, deadline or transaction, for work that outlives the request.
+func Detach(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(detachedContext{parent: ctx}, timeout)
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (d detachedContext) Value(key any) any {
+	if key == transactionKey || key == commitHooksKey {
+		return nil
+	}
+
+	return d.parent.Value(key)
+}
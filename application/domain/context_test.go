@@ -0,0 +1,59 @@
+//go:build unit
+
+package domain
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDetach(t *testing.T) {
+	parent, cancel := context.WithCancel(WithRequestID(context.Background(), "req-1"))
+
+	ctx, stop := Detach(parent, time.Minute)
+	defer stop()
+
+	cancel()
+
+	assert.NoError(t, ctx.Err(), "cancelling the request must not cancel detached work")
+	assert.Equal(t, "req-1", RequestIDFrom(ctx))
+
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+	stop()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestRequestIDFrom(t *testing.T) {
+	assert.Empty(t, RequestIDFrom(context.Background()))
+	assert.Empty(t, TraceParentFrom(context.Background()))
+
+	ctx := WithTraceParent(WithRequestID(context.Background(), "req-1"), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.Equal(t, "req-1", RequestIDFrom(ctx))
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", TraceParentFrom(ctx))
+}
+
+func TestAfterCommit(t *testing.T) {
+	ran := 0
+
+	AfterCommit(context.Background(), func() { ran++ })
+	assert.Equal(t, 1, ran, "hooks outside a transaction must run right away")
+
+	ctx, committed := WithTransaction(context.Background(), "tx")
+	assert.Equal(t, "tx", TransactionFrom(ctx))
+
+	AfterCommit(ctx, func() { ran++ })
+	AfterCommit(ctx, func() { ran++ })
+	assert.Equal(t, 1, ran, "hooks must wait for the commit")
+
+	detached, cancel := Detach(ctx, time.Minute)
+	defer cancel()
+	assert.Nil(t, TransactionFrom(detached), "detached work must not run in the transaction")
+
+	committed()
+	assert.Equal(t, 3, ran)
+}
@@ -0,0 +1,166 @@
+//go:generate mockgen -source=customer.go -destination=customer_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrInvalidCustomer  = errors.New("customer fields are invalid")
+	ErrCustomerNotFound = errors.New("customer not found")
+	ErrInvalidSite      = errors.New("site fields are invalid")
+	ErrSiteNotFound     = errors.New("site not found")
+)
+
+const earthRadiusMeters = 6371000
+
+type CustomerUsecase interface {
+	Add(ctx context.Context, customer Customer, user User) (Customer, error)
+	List(ctx context.Context, user User) ([]Customer, error)
+	Update(ctx context.Context, customer Customer, user User) (Customer, error)
+	Remove(ctx context.Context, id int64, user User) error
+}
+
+type CustomerCreator interface {
+	Add(ctx context.Context, customer Customer) (int64, error)
+}
+
+type CustomerRetriever interface {
+	List(ctx context.Context) ([]Customer, error)
+	ListByID(ctx context.Context, id int64) (Customer, error)
+}
+
+type CustomerUpdater interface {
+	Update(ctx context.Context, customer Customer) error
+}
+
+type CustomerRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type SiteUsecase interface {
+	Add(ctx context.Context, site Site, user User) (Site, error)
+	List(ctx context.Context, user User, customerID int64) ([]Site, error)
+	ListByID(ctx context.Context, id int64, user User) (Site, error)
+	Update(ctx context.Context, site Site, user User) (Site, error)
+	Remove(ctx context.Context, id int64, user User) error
+}
+
+type SiteCreator interface {
+	Add(ctx context.Context, site Site) (int64, error)
+}
+
+type SiteRetriever interface {
+	List(ctx context.Context, customerID int64) ([]Site, error)
+	ListByID(ctx context.Context, id int64) (Site, error)
+}
+
+type SiteUpdater interface {
+	Update(ctx context.Context, site Site) error
+}
+
+type SiteRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type Customer struct {
+	ID    int64
+	Name  string
+	Email string
+	Phone string
+}
+
+type Site struct {
+	ID          int64
+	CustomerID  int64
+	Name        string
+	Address     string
+	Coordinates *Coordinates
+}
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func NewCustomer(name, email, phone string) (Customer, error) {
+	var err []string
+
+	if name == "" {
+		err = append(err, "name must not be empty")
+	}
+
+	if len(name) > 255 {
+		err = append(err, "name size is too big")
+	}
+
+	if len(email) > 255 {
+		err = append(err, "email size is too big")
+	}
+
+	if len(phone) > 32 {
+		err = append(err, "phone size is too big")
+	}
+
+	if len(err) > 0 {
+		return Customer{}, fmt.Errorf("%w: %s", ErrInvalidCustomer, strings.Join(err, "; "))
+	}
+
+	return Customer{Name: name, Email: email, Phone: phone}, nil
+}
+
+func NewSite(customerID int64, name, address string, coordinates *Coordinates) (Site, error) {
+	var err []string
+
+	if customerID == 0 {
+		err = append(err, "customer ID must not be 0")
+	}
+
+	if name == "" {
+		err = append(err, "name must not be empty")
+	}
+
+	if len(name) > 255 {
+		err = append(err, "name size is too big")
+	}
+
+	if address == "" {
+		err = append(err, "address must not be empty")
+	}
+
+	if len(address) > 500 {
+		err = append(err, "address size is too big")
+	}
+
+	if coordinates == nil {
+		err = append(err, "coordinates must not be empty")
+	} else if !coordinates.IsValid() {
+		err = append(err, "coordinates are out of range")
+	}
+
+	if len(err) > 0 {
+		return Site{}, fmt.Errorf("%w: %s", ErrInvalidSite, strings.Join(err, "; "))
+	}
+
+	return Site{CustomerID: customerID, Name: name, Address: address, Coordinates: coordinates}, nil
+}
+
+func (c Coordinates) IsValid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1 := c.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.Longitude - c.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
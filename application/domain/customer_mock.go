@@ -0,0 +1,521 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: customer.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCustomerUsecase is a mock of CustomerUsecase interface.
+type MockCustomerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerUsecaseMockRecorder
+}
+
+// MockCustomerUsecaseMockRecorder is the mock recorder for MockCustomerUsecase.
+type MockCustomerUsecaseMockRecorder struct {
+	mock *MockCustomerUsecase
+}
+
+// NewMockCustomerUsecase creates a new mock instance.
+func NewMockCustomerUsecase(ctrl *gomock.Controller) *MockCustomerUsecase {
+	mock := &MockCustomerUsecase{ctrl: ctrl}
+	mock.recorder = &MockCustomerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerUsecase) EXPECT() *MockCustomerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCustomerUsecase) Add(ctx context.Context, customer Customer, user User) (Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, customer, user)
+	ret0, _ := ret[0].(Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCustomerUsecaseMockRecorder) Add(ctx, customer, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCustomerUsecase)(nil).Add), ctx, customer, user)
+}
+
+// List mocks base method.
+func (m *MockCustomerUsecase) List(ctx context.Context, user User) ([]Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, user)
+	ret0, _ := ret[0].([]Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomerUsecaseMockRecorder) List(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomerUsecase)(nil).List), ctx, user)
+}
+
+// Remove mocks base method.
+func (m *MockCustomerUsecase) Remove(ctx context.Context, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCustomerUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCustomerUsecase)(nil).Remove), ctx, id, user)
+}
+
+// Update mocks base method.
+func (m *MockCustomerUsecase) Update(ctx context.Context, customer Customer, user User) (Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, customer, user)
+	ret0, _ := ret[0].(Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomerUsecaseMockRecorder) Update(ctx, customer, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomerUsecase)(nil).Update), ctx, customer, user)
+}
+
+// MockCustomerCreator is a mock of CustomerCreator interface.
+type MockCustomerCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerCreatorMockRecorder
+}
+
+// MockCustomerCreatorMockRecorder is the mock recorder for MockCustomerCreator.
+type MockCustomerCreatorMockRecorder struct {
+	mock *MockCustomerCreator
+}
+
+// NewMockCustomerCreator creates a new mock instance.
+func NewMockCustomerCreator(ctrl *gomock.Controller) *MockCustomerCreator {
+	mock := &MockCustomerCreator{ctrl: ctrl}
+	mock.recorder = &MockCustomerCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerCreator) EXPECT() *MockCustomerCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCustomerCreator) Add(ctx context.Context, customer Customer) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, customer)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCustomerCreatorMockRecorder) Add(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCustomerCreator)(nil).Add), ctx, customer)
+}
+
+// MockCustomerRetriever is a mock of CustomerRetriever interface.
+type MockCustomerRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerRetrieverMockRecorder
+}
+
+// MockCustomerRetrieverMockRecorder is the mock recorder for MockCustomerRetriever.
+type MockCustomerRetrieverMockRecorder struct {
+	mock *MockCustomerRetriever
+}
+
+// NewMockCustomerRetriever creates a new mock instance.
+func NewMockCustomerRetriever(ctrl *gomock.Controller) *MockCustomerRetriever {
+	mock := &MockCustomerRetriever{ctrl: ctrl}
+	mock.recorder = &MockCustomerRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerRetriever) EXPECT() *MockCustomerRetrieverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCustomerRetriever) List(ctx context.Context) ([]Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCustomerRetrieverMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCustomerRetriever)(nil).List), ctx)
+}
+
+// ListByID mocks base method.
+func (m *MockCustomerRetriever) ListByID(ctx context.Context, id int64) (Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockCustomerRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockCustomerRetriever)(nil).ListByID), ctx, id)
+}
+
+// MockCustomerUpdater is a mock of CustomerUpdater interface.
+type MockCustomerUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerUpdaterMockRecorder
+}
+
+// MockCustomerUpdaterMockRecorder is the mock recorder for MockCustomerUpdater.
+type MockCustomerUpdaterMockRecorder struct {
+	mock *MockCustomerUpdater
+}
+
+// NewMockCustomerUpdater creates a new mock instance.
+func NewMockCustomerUpdater(ctrl *gomock.Controller) *MockCustomerUpdater {
+	mock := &MockCustomerUpdater{ctrl: ctrl}
+	mock.recorder = &MockCustomerUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerUpdater) EXPECT() *MockCustomerUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockCustomerUpdater) Update(ctx context.Context, customer Customer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, customer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomerUpdaterMockRecorder) Update(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomerUpdater)(nil).Update), ctx, customer)
+}
+
+// MockCustomerRemover is a mock of CustomerRemover interface.
+type MockCustomerRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerRemoverMockRecorder
+}
+
+// MockCustomerRemoverMockRecorder is the mock recorder for MockCustomerRemover.
+type MockCustomerRemoverMockRecorder struct {
+	mock *MockCustomerRemover
+}
+
+// NewMockCustomerRemover creates a new mock instance.
+func NewMockCustomerRemover(ctrl *gomock.Controller) *MockCustomerRemover {
+	mock := &MockCustomerRemover{ctrl: ctrl}
+	mock.recorder = &MockCustomerRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerRemover) EXPECT() *MockCustomerRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockCustomerRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockCustomerRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockCustomerRemover)(nil).Remove), ctx, id)
+}
+
+// MockSiteUsecase is a mock of SiteUsecase interface.
+type MockSiteUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteUsecaseMockRecorder
+}
+
+// MockSiteUsecaseMockRecorder is the mock recorder for MockSiteUsecase.
+type MockSiteUsecaseMockRecorder struct {
+	mock *MockSiteUsecase
+}
+
+// NewMockSiteUsecase creates a new mock instance.
+func NewMockSiteUsecase(ctrl *gomock.Controller) *MockSiteUsecase {
+	mock := &MockSiteUsecase{ctrl: ctrl}
+	mock.recorder = &MockSiteUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteUsecase) EXPECT() *MockSiteUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockSiteUsecase) Add(ctx context.Context, site Site, user User) (Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, site, user)
+	ret0, _ := ret[0].(Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSiteUsecaseMockRecorder) Add(ctx, site, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSiteUsecase)(nil).Add), ctx, site, user)
+}
+
+// List mocks base method.
+func (m *MockSiteUsecase) List(ctx context.Context, user User, customerID int64) ([]Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, user, customerID)
+	ret0, _ := ret[0].([]Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSiteUsecaseMockRecorder) List(ctx, user, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSiteUsecase)(nil).List), ctx, user, customerID)
+}
+
+// ListByID mocks base method.
+func (m *MockSiteUsecase) ListByID(ctx context.Context, id int64, user User) (Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id, user)
+	ret0, _ := ret[0].(Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockSiteUsecaseMockRecorder) ListByID(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockSiteUsecase)(nil).ListByID), ctx, id, user)
+}
+
+// Remove mocks base method.
+func (m *MockSiteUsecase) Remove(ctx context.Context, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockSiteUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockSiteUsecase)(nil).Remove), ctx, id, user)
+}
+
+// Update mocks base method.
+func (m *MockSiteUsecase) Update(ctx context.Context, site Site, user User) (Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, site, user)
+	ret0, _ := ret[0].(Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSiteUsecaseMockRecorder) Update(ctx, site, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSiteUsecase)(nil).Update), ctx, site, user)
+}
+
+// MockSiteCreator is a mock of SiteCreator interface.
+type MockSiteCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteCreatorMockRecorder
+}
+
+// MockSiteCreatorMockRecorder is the mock recorder for MockSiteCreator.
+type MockSiteCreatorMockRecorder struct {
+	mock *MockSiteCreator
+}
+
+// NewMockSiteCreator creates a new mock instance.
+func NewMockSiteCreator(ctrl *gomock.Controller) *MockSiteCreator {
+	mock := &MockSiteCreator{ctrl: ctrl}
+	mock.recorder = &MockSiteCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteCreator) EXPECT() *MockSiteCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockSiteCreator) Add(ctx context.Context, site Site) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, site)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSiteCreatorMockRecorder) Add(ctx, site interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSiteCreator)(nil).Add), ctx, site)
+}
+
+// MockSiteRetriever is a mock of SiteRetriever interface.
+type MockSiteRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteRetrieverMockRecorder
+}
+
+// MockSiteRetrieverMockRecorder is the mock recorder for MockSiteRetriever.
+type MockSiteRetrieverMockRecorder struct {
+	mock *MockSiteRetriever
+}
+
+// NewMockSiteRetriever creates a new mock instance.
+func NewMockSiteRetriever(ctrl *gomock.Controller) *MockSiteRetriever {
+	mock := &MockSiteRetriever{ctrl: ctrl}
+	mock.recorder = &MockSiteRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteRetriever) EXPECT() *MockSiteRetrieverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockSiteRetriever) List(ctx context.Context, customerID int64) ([]Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, customerID)
+	ret0, _ := ret[0].([]Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSiteRetrieverMockRecorder) List(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSiteRetriever)(nil).List), ctx, customerID)
+}
+
+// ListByID mocks base method.
+func (m *MockSiteRetriever) ListByID(ctx context.Context, id int64) (Site, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Site)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockSiteRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockSiteRetriever)(nil).ListByID), ctx, id)
+}
+
+// MockSiteUpdater is a mock of SiteUpdater interface.
+type MockSiteUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteUpdaterMockRecorder
+}
+
+// MockSiteUpdaterMockRecorder is the mock recorder for MockSiteUpdater.
+type MockSiteUpdaterMockRecorder struct {
+	mock *MockSiteUpdater
+}
+
+// NewMockSiteUpdater creates a new mock instance.
+func NewMockSiteUpdater(ctrl *gomock.Controller) *MockSiteUpdater {
+	mock := &MockSiteUpdater{ctrl: ctrl}
+	mock.recorder = &MockSiteUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteUpdater) EXPECT() *MockSiteUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockSiteUpdater) Update(ctx context.Context, site Site) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, site)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSiteUpdaterMockRecorder) Update(ctx, site interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSiteUpdater)(nil).Update), ctx, site)
+}
+
+// MockSiteRemover is a mock of SiteRemover interface.
+type MockSiteRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockSiteRemoverMockRecorder
+}
+
+// MockSiteRemoverMockRecorder is the mock recorder for MockSiteRemover.
+type MockSiteRemoverMockRecorder struct {
+	mock *MockSiteRemover
+}
+
+// NewMockSiteRemover creates a new mock instance.
+func NewMockSiteRemover(ctrl *gomock.Controller) *MockSiteRemover {
+	mock := &MockSiteRemover{ctrl: ctrl}
+	mock.recorder = &MockSiteRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSiteRemover) EXPECT() *MockSiteRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockSiteRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockSiteRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockSiteRemover)(nil).Remove), ctx, id)
+}
@@ -0,0 +1,129 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewCustomer(t *testing.T) {
+	type args struct {
+		name  string
+		email string
+		phone string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Customer
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when name is empty",
+			args:    args{email: "contact@acme.com"},
+			want:    Customer{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when name is too big",
+			args:    args{name: strings.Repeat("a", 256)},
+			want:    Customer{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when phone is too big",
+			args:    args{name: "ACME", phone: strings.Repeat("9", 33)},
+			want:    Customer{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect success",
+			args:    args{name: "ACME", email: "contact@acme.com", phone: "+5511999999999"},
+			want:    Customer{Name: "ACME", Email: "contact@acme.com", Phone: "+5511999999999"},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCustomer(tt.args.name, tt.args.email, tt.args.phone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCustomer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidCustomer))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewSite(t *testing.T) {
+	type args struct {
+		customerID  int64
+		name        string
+		address     string
+		coordinates *Coordinates
+	}
+
+	coordinates := &Coordinates{Latitude: -23.5613, Longitude: -46.6565}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Site
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when no customer",
+			args:    args{name: "Headquarters", address: "Av. Paulista, 1000", coordinates: coordinates},
+			want:    Site{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when address is empty",
+			args:    args{customerID: 1, name: "Headquarters", coordinates: coordinates},
+			want:    Site{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when coordinates are missing",
+			args:    args{customerID: 1, name: "Headquarters", address: "Av. Paulista, 1000"},
+			want:    Site{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when coordinates are out of range",
+			args:    args{customerID: 1, name: "Headquarters", address: "Av. Paulista, 1000", coordinates: &Coordinates{Latitude: 91}},
+			want:    Site{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect success",
+			args:    args{customerID: 1, name: "Headquarters", address: "Av. Paulista, 1000", coordinates: coordinates},
+			want:    Site{CustomerID: 1, Name: "Headquarters", Address: "Av. Paulista, 1000", Coordinates: coordinates},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSite(tt.args.customerID, tt.args.name, tt.args.address, tt.args.coordinates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSite() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidSite))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+//go:generate mockgen -source=idempotency.go -destination=idempotency_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidIdempotencyKey    = errors.New("idempotency key is invalid")
+	ErrIdempotencyKeyNotFound   = errors.New("idempotency key not found")
+	ErrIdempotencyKeyExists     = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used for a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+const MaxIdempotencyKeyLength = 255
+
+type IdempotencyUsecase interface {
+	Reserve(ctx context.Context, key IdempotencyKey) (IdempotencyKey, bool, error)
+	Complete(ctx context.Context, key IdempotencyKey) error
+	Release(ctx context.Context, key IdempotencyKey) error
+	Purge(ctx context.Context) (int64, error)
+}
+
+type IdempotencyCreator interface {
+	Add(ctx context.Context, key IdempotencyKey) error
+}
+
+type IdempotencyRetriever interface {
+	ListByKey(ctx context.Context, userID int64, key string) (IdempotencyKey, error)
+}
+
+type IdempotencyUpdater interface {
+	Complete(ctx context.Context, key IdempotencyKey) error
+}
+
+type IdempotencyRemover interface {
+	Remove(ctx context.Context, userID int64, key string) error
+	RemoveExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// IdempotencyKey is a client supplied key reserved by one user for one request.
+// Fingerprint identifies the request the key was first used with, and the
+// response fields stay empty until that request completes.
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	ContentType string
+	Body        string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func NewIdempotencyKey(userID int64, key, fingerprint string) (IdempotencyKey, error) {
+	var err []string
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if key == "" {
+		err = append(err, "key must not be empty")
+	} else if len(key) > MaxIdempotencyKeyLength {
+		err = append(err, fmt.Sprintf("key must have at most %d characters", MaxIdempotencyKeyLength))
+	} else if !printable(key) {
+		err = append(err, "key must only contain printable ASCII characters")
+	}
+
+	if fingerprint == "" {
+		err = append(err, "fingerprint must not be empty")
+	}
+
+	if len(err) > 0 {
+		return IdempotencyKey{}, fmt.Errorf("%w: %s", ErrInvalidIdempotencyKey, strings.Join(err, "; "))
+	}
+
+	return IdempotencyKey{UserID: userID, Key: key, Fingerprint: fingerprint}, nil
+}
+
+func (k IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
+
+func (k IdempotencyKey) Expired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
+
+func printable(value string) bool {
+	for _, r := range value {
+		if r < ' ' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,259 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: idempotency.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIdempotencyUsecase is a mock of IdempotencyUsecase interface.
+type MockIdempotencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUsecaseMockRecorder
+}
+
+// MockIdempotencyUsecaseMockRecorder is the mock recorder for MockIdempotencyUsecase.
+type MockIdempotencyUsecaseMockRecorder struct {
+	mock *MockIdempotencyUsecase
+}
+
+// NewMockIdempotencyUsecase creates a new mock instance.
+func NewMockIdempotencyUsecase(ctrl *gomock.Controller) *MockIdempotencyUsecase {
+	mock := &MockIdempotencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUsecase) EXPECT() *MockIdempotencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUsecase) Complete(ctx context.Context, key IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUsecaseMockRecorder) Complete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Complete), ctx, key)
+}
+
+// Purge mocks base method.
+func (m *MockIdempotencyUsecase) Purge(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockIdempotencyUsecaseMockRecorder) Purge(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Purge), ctx)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyUsecase) Release(ctx context.Context, key IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyUsecaseMockRecorder) Release(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Release), ctx, key)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyUsecase) Reserve(ctx context.Context, key IdempotencyKey) (IdempotencyKey, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, key)
+	ret0, _ := ret[0].(IdempotencyKey)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyUsecaseMockRecorder) Reserve(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyUsecase)(nil).Reserve), ctx, key)
+}
+
+// MockIdempotencyCreator is a mock of IdempotencyCreator interface.
+type MockIdempotencyCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyCreatorMockRecorder
+}
+
+// MockIdempotencyCreatorMockRecorder is the mock recorder for MockIdempotencyCreator.
+type MockIdempotencyCreatorMockRecorder struct {
+	mock *MockIdempotencyCreator
+}
+
+// NewMockIdempotencyCreator creates a new mock instance.
+func NewMockIdempotencyCreator(ctrl *gomock.Controller) *MockIdempotencyCreator {
+	mock := &MockIdempotencyCreator{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyCreator) EXPECT() *MockIdempotencyCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIdempotencyCreator) Add(ctx context.Context, key IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIdempotencyCreatorMockRecorder) Add(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIdempotencyCreator)(nil).Add), ctx, key)
+}
+
+// MockIdempotencyRetriever is a mock of IdempotencyRetriever interface.
+type MockIdempotencyRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRetrieverMockRecorder
+}
+
+// MockIdempotencyRetrieverMockRecorder is the mock recorder for MockIdempotencyRetriever.
+type MockIdempotencyRetrieverMockRecorder struct {
+	mock *MockIdempotencyRetriever
+}
+
+// NewMockIdempotencyRetriever creates a new mock instance.
+func NewMockIdempotencyRetriever(ctrl *gomock.Controller) *MockIdempotencyRetriever {
+	mock := &MockIdempotencyRetriever{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRetriever) EXPECT() *MockIdempotencyRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByKey mocks base method.
+func (m *MockIdempotencyRetriever) ListByKey(ctx context.Context, userID int64, key string) (IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByKey", ctx, userID, key)
+	ret0, _ := ret[0].(IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByKey indicates an expected call of ListByKey.
+func (mr *MockIdempotencyRetrieverMockRecorder) ListByKey(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByKey", reflect.TypeOf((*MockIdempotencyRetriever)(nil).ListByKey), ctx, userID, key)
+}
+
+// MockIdempotencyUpdater is a mock of IdempotencyUpdater interface.
+type MockIdempotencyUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUpdaterMockRecorder
+}
+
+// MockIdempotencyUpdaterMockRecorder is the mock recorder for MockIdempotencyUpdater.
+type MockIdempotencyUpdaterMockRecorder struct {
+	mock *MockIdempotencyUpdater
+}
+
+// NewMockIdempotencyUpdater creates a new mock instance.
+func NewMockIdempotencyUpdater(ctrl *gomock.Controller) *MockIdempotencyUpdater {
+	mock := &MockIdempotencyUpdater{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUpdater) EXPECT() *MockIdempotencyUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUpdater) Complete(ctx context.Context, key IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUpdaterMockRecorder) Complete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUpdater)(nil).Complete), ctx, key)
+}
+
+// MockIdempotencyRemover is a mock of IdempotencyRemover interface.
+type MockIdempotencyRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRemoverMockRecorder
+}
+
+// MockIdempotencyRemoverMockRecorder is the mock recorder for MockIdempotencyRemover.
+type MockIdempotencyRemoverMockRecorder struct {
+	mock *MockIdempotencyRemover
+}
+
+// NewMockIdempotencyRemover creates a new mock instance.
+func NewMockIdempotencyRemover(ctrl *gomock.Controller) *MockIdempotencyRemover {
+	mock := &MockIdempotencyRemover{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRemover) EXPECT() *MockIdempotencyRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockIdempotencyRemover) Remove(ctx context.Context, userID int64, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockIdempotencyRemoverMockRecorder) Remove(ctx, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockIdempotencyRemover)(nil).Remove), ctx, userID, key)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockIdempotencyRemover) RemoveExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockIdempotencyRemoverMockRecorder) RemoveExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockIdempotencyRemover)(nil).RemoveExpired), ctx, before)
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	type args struct {
+		userID      int64
+		key         string
+		fingerprint string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    IdempotencyKey
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when user is missing",
+			args:    args{key: "retry-1", fingerprint: "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when key is empty",
+			args:    args{userID: 1, fingerprint: "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when key is too big",
+			args:    args{userID: 1, key: strings.Repeat("a", MaxIdempotencyKeyLength+1), fingerprint: "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when key has control characters",
+			args:    args{userID: 1, key: "retry\n1", fingerprint: "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when fingerprint is missing",
+			args:    args{userID: 1, key: "retry-1"},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{userID: 1, key: "6f1c0f4e-2b1a-4a57-9f5e-3c2d1b0a9e8f", fingerprint: "abc"},
+			want: IdempotencyKey{UserID: 1, Key: "6f1c0f4e-2b1a-4a57-9f5e-3c2d1b0a9e8f", Fingerprint: "abc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewIdempotencyKey(tt.args.userID, tt.args.key, tt.args.fingerprint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewIdempotencyKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidIdempotencyKey) {
+				t.Errorf("NewIdempotencyKey() error = %v, want %v", err, ErrInvalidIdempotencyKey)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIdempotencyKey_Expired(t *testing.T) {
+	now := time.Date(2023, 12, 5, 10, 0, 0, 0, time.UTC)
+
+	assert.False(t, IdempotencyKey{ExpiresAt: now.Add(time.Second)}.Expired(now))
+	assert.True(t, IdempotencyKey{ExpiresAt: now}.Expired(now))
+	assert.True(t, IdempotencyKey{ExpiresAt: now.Add(-time.Second)}.Expired(now))
+}
@@ -0,0 +1,114 @@
+//go:generate mockgen -source=notification.go -destination=notification_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidNotificationPreference = errors.New("notification preference fields are invalid")
+	ErrNotificationTemplateNotFound  = errors.New("notification template not found")
+	ErrNotificationChannelNotFound   = errors.New("notification channel not found")
+)
+
+const (
+	EventTaskAssigned    = "task.assigned"
+	EventTaskRescheduled = "task.rescheduled"
+
+	EmailChannel = "email"
+	SMSChannel   = "sms"
+
+	DefaultLocale = "en"
+)
+
+type NotificationUsecase interface {
+	UserNotifier
+	GetPreference(ctx context.Context, user User) (NotificationPreference, error)
+	UpdatePreference(ctx context.Context, preference NotificationPreference, user User) (NotificationPreference, error)
+}
+
+type UserNotifier interface {
+	Notify(ctx context.Context, event string, userID int64, data map[string]string) error
+}
+
+type NotificationSender interface {
+	Send(ctx context.Context, to string, message Message) error
+}
+
+type NotificationTemplater interface {
+	Render(event, locale string, data map[string]string) (Message, error)
+}
+
+type NotificationPreferenceRetriever interface {
+	ListByUserID(ctx context.Context, userID int64) (NotificationPreference, error)
+}
+
+type NotificationPreferenceUpdater interface {
+	Upsert(ctx context.Context, preference NotificationPreference) error
+}
+
+type NotificationPreference struct {
+	UserID   int64
+	Channels []string
+	Locale   string
+	Email    string
+	Phone    string
+	Timezone string
+}
+
+type Message struct {
+	Subject string
+	Body    string
+}
+
+func NewNotificationPreference(userID int64, channels []string, locale, phone string) (NotificationPreference, error) {
+	var err []string
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	for _, c := range channels {
+		if c != EmailChannel && c != SMSChannel {
+			err = append(err, fmt.Sprintf("channel %q is not supported", c))
+		}
+
+		if c == SMSChannel && phone == "" {
+			err = append(err, "phone must not be empty when sms channel is enabled")
+		}
+	}
+
+	if len(phone) > 32 {
+		err = append(err, "phone size is too big")
+	}
+
+	if locale == "" {
+		locale = DefaultLocale
+	}
+
+	if len(err) > 0 {
+		return NotificationPreference{}, fmt.Errorf("%w: %s", ErrInvalidNotificationPreference, strings.Join(err, "; "))
+	}
+
+	return NotificationPreference{UserID: userID, Channels: channels, Locale: locale, Phone: phone}, nil
+}
+
+func (p *NotificationPreference) Recipient(channel string) string {
+	switch channel {
+	case EmailChannel:
+		return p.Email
+	case SMSChannel:
+		return p.Phone
+	}
+
+	return ""
+}
+
+func (p *NotificationPreference) Location() *time.Location {
+	return LoadLocation(p.Timezone)
+}
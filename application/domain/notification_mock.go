@@ -0,0 +1,266 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationUsecase is a mock of NotificationUsecase interface.
+type MockNotificationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUsecaseMockRecorder
+}
+
+// MockNotificationUsecaseMockRecorder is the mock recorder for MockNotificationUsecase.
+type MockNotificationUsecaseMockRecorder struct {
+	mock *MockNotificationUsecase
+}
+
+// NewMockNotificationUsecase creates a new mock instance.
+func NewMockNotificationUsecase(ctrl *gomock.Controller) *MockNotificationUsecase {
+	mock := &MockNotificationUsecase{ctrl: ctrl}
+	mock.recorder = &MockNotificationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUsecase) EXPECT() *MockNotificationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetPreference mocks base method.
+func (m *MockNotificationUsecase) GetPreference(ctx context.Context, user User) (NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreference", ctx, user)
+	ret0, _ := ret[0].(NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreference indicates an expected call of GetPreference.
+func (mr *MockNotificationUsecaseMockRecorder) GetPreference(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreference", reflect.TypeOf((*MockNotificationUsecase)(nil).GetPreference), ctx, user)
+}
+
+// Notify mocks base method.
+func (m *MockNotificationUsecase) Notify(ctx context.Context, event string, userID int64, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, event, userID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationUsecaseMockRecorder) Notify(ctx, event, userID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationUsecase)(nil).Notify), ctx, event, userID, data)
+}
+
+// UpdatePreference mocks base method.
+func (m *MockNotificationUsecase) UpdatePreference(ctx context.Context, preference NotificationPreference, user User) (NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreference", ctx, preference, user)
+	ret0, _ := ret[0].(NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreference indicates an expected call of UpdatePreference.
+func (mr *MockNotificationUsecaseMockRecorder) UpdatePreference(ctx, preference, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreference", reflect.TypeOf((*MockNotificationUsecase)(nil).UpdatePreference), ctx, preference, user)
+}
+
+// MockUserNotifier is a mock of UserNotifier interface.
+type MockUserNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserNotifierMockRecorder
+}
+
+// MockUserNotifierMockRecorder is the mock recorder for MockUserNotifier.
+type MockUserNotifierMockRecorder struct {
+	mock *MockUserNotifier
+}
+
+// NewMockUserNotifier creates a new mock instance.
+func NewMockUserNotifier(ctrl *gomock.Controller) *MockUserNotifier {
+	mock := &MockUserNotifier{ctrl: ctrl}
+	mock.recorder = &MockUserNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserNotifier) EXPECT() *MockUserNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockUserNotifier) Notify(ctx context.Context, event string, userID int64, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, event, userID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockUserNotifierMockRecorder) Notify(ctx, event, userID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockUserNotifier)(nil).Notify), ctx, event, userID, data)
+}
+
+// MockNotificationSender is a mock of NotificationSender interface.
+type MockNotificationSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSenderMockRecorder
+}
+
+// MockNotificationSenderMockRecorder is the mock recorder for MockNotificationSender.
+type MockNotificationSenderMockRecorder struct {
+	mock *MockNotificationSender
+}
+
+// NewMockNotificationSender creates a new mock instance.
+func NewMockNotificationSender(ctrl *gomock.Controller) *MockNotificationSender {
+	mock := &MockNotificationSender{ctrl: ctrl}
+	mock.recorder = &MockNotificationSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationSender) EXPECT() *MockNotificationSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockNotificationSender) Send(ctx context.Context, to string, message Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, to, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockNotificationSenderMockRecorder) Send(ctx, to, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNotificationSender)(nil).Send), ctx, to, message)
+}
+
+// MockNotificationTemplater is a mock of NotificationTemplater interface.
+type MockNotificationTemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationTemplaterMockRecorder
+}
+
+// MockNotificationTemplaterMockRecorder is the mock recorder for MockNotificationTemplater.
+type MockNotificationTemplaterMockRecorder struct {
+	mock *MockNotificationTemplater
+}
+
+// NewMockNotificationTemplater creates a new mock instance.
+func NewMockNotificationTemplater(ctrl *gomock.Controller) *MockNotificationTemplater {
+	mock := &MockNotificationTemplater{ctrl: ctrl}
+	mock.recorder = &MockNotificationTemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationTemplater) EXPECT() *MockNotificationTemplaterMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockNotificationTemplater) Render(event, locale string, data map[string]string) (Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", event, locale, data)
+	ret0, _ := ret[0].(Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockNotificationTemplaterMockRecorder) Render(event, locale, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockNotificationTemplater)(nil).Render), event, locale, data)
+}
+
+// MockNotificationPreferenceRetriever is a mock of NotificationPreferenceRetriever interface.
+type MockNotificationPreferenceRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceRetrieverMockRecorder
+}
+
+// MockNotificationPreferenceRetrieverMockRecorder is the mock recorder for MockNotificationPreferenceRetriever.
+type MockNotificationPreferenceRetrieverMockRecorder struct {
+	mock *MockNotificationPreferenceRetriever
+}
+
+// NewMockNotificationPreferenceRetriever creates a new mock instance.
+func NewMockNotificationPreferenceRetriever(ctrl *gomock.Controller) *MockNotificationPreferenceRetriever {
+	mock := &MockNotificationPreferenceRetriever{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceRetriever) EXPECT() *MockNotificationPreferenceRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByUserID mocks base method.
+func (m *MockNotificationPreferenceRetriever) ListByUserID(ctx context.Context, userID int64) (NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].(NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockNotificationPreferenceRetrieverMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockNotificationPreferenceRetriever)(nil).ListByUserID), ctx, userID)
+}
+
+// MockNotificationPreferenceUpdater is a mock of NotificationPreferenceUpdater interface.
+type MockNotificationPreferenceUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceUpdaterMockRecorder
+}
+
+// MockNotificationPreferenceUpdaterMockRecorder is the mock recorder for MockNotificationPreferenceUpdater.
+type MockNotificationPreferenceUpdaterMockRecorder struct {
+	mock *MockNotificationPreferenceUpdater
+}
+
+// NewMockNotificationPreferenceUpdater creates a new mock instance.
+func NewMockNotificationPreferenceUpdater(ctrl *gomock.Controller) *MockNotificationPreferenceUpdater {
+	mock := &MockNotificationPreferenceUpdater{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceUpdater) EXPECT() *MockNotificationPreferenceUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockNotificationPreferenceUpdater) Upsert(ctx context.Context, preference NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNotificationPreferenceUpdaterMockRecorder) Upsert(ctx, preference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNotificationPreferenceUpdater)(nil).Upsert), ctx, preference)
+}
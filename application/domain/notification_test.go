@@ -0,0 +1,108 @@
+//go:build unit
+
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewNotificationPreference(t *testing.T) {
+	type args struct {
+		userID   int64
+		channels []string
+		locale   string
+		phone    string
+	}
+
+	const (
+		userID = 1
+		phone  = "+5511999999999"
+	)
+
+	tests := []struct {
+		name    string
+		args    args
+		want    NotificationPreference
+		wantErr bool
+	}{
+		{
+			name: "Expect error when no user",
+			args: args{
+				userID:   0,
+				channels: []string{EmailChannel},
+			},
+			want:    NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when channel is not supported",
+			args: args{
+				userID:   userID,
+				channels: []string{"pigeon"},
+			},
+			want:    NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when sms channel has no phone",
+			args: args{
+				userID:   userID,
+				channels: []string{SMSChannel},
+			},
+			want:    NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when phone is too big",
+			args: args{
+				userID:   userID,
+				channels: []string{SMSChannel},
+				phone:    strings.Repeat("9", 33),
+			},
+			want:    NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success with default locale",
+			args: args{
+				userID:   userID,
+				channels: []string{EmailChannel},
+			},
+			want: NotificationPreference{
+				UserID:   userID,
+				Channels: []string{EmailChannel},
+				Locale:   DefaultLocale,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				userID:   userID,
+				channels: []string{EmailChannel, SMSChannel},
+				locale:   "pt-BR",
+				phone:    phone,
+			},
+			want: NotificationPreference{
+				UserID:   userID,
+				Channels: []string{EmailChannel, SMSChannel},
+				Locale:   "pt-BR",
+				Phone:    phone,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNotificationPreference(tt.args.userID, tt.args.channels, tt.args.locale, tt.args.phone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNotificationPreference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+//go:generate mockgen -source=part.go -destination=part_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPart       = errors.New("part fields are invalid")
+	ErrPartNotFound      = errors.New("part not found")
+	ErrInvalidStock      = errors.New("stock fields are invalid")
+	ErrInsufficientStock = errors.New("stock is insufficient")
+	ErrInvalidPartUsage  = errors.New("part usage fields are invalid")
+)
+
+const (
+	EventStockLow = "stock.low"
+
+	DefaultPartUnit = "unit"
+)
+
+type PartUsecase interface {
+	Add(ctx context.Context, part Part, user User) (Part, error)
+	List(ctx context.Context, user User) ([]Part, error)
+	Update(ctx context.Context, part Part, user User) (Part, error)
+	Remove(ctx context.Context, id int64, user User) error
+}
+
+type PartCreator interface {
+	Add(ctx context.Context, part Part) (int64, error)
+}
+
+type PartRetriever interface {
+	List(ctx context.Context) ([]Part, error)
+	ListByID(ctx context.Context, id int64) (Part, error)
+}
+
+type PartUpdater interface {
+	Update(ctx context.Context, part Part) error
+}
+
+type PartRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type InventoryUsecase interface {
+	ListStock(ctx context.Context, userID int64, user User) ([]Stock, error)
+	SetStock(ctx context.Context, stock Stock, user User) (Stock, error)
+	Consume(ctx context.Context, usage PartUsage, user User) (PartUsage, error)
+	ListUsageByTask(ctx context.Context, taskID int64, user User) ([]PartUsage, error)
+	UsageReport(ctx context.Context, filter PartUsageFilter, user User) ([]PartUsageReport, error)
+}
+
+type StockRetriever interface {
+	ListByUserID(ctx context.Context, userID int64) ([]Stock, error)
+}
+
+type StockUpdater interface {
+	Upsert(ctx context.Context, stock Stock) error
+}
+
+type PartUsageCreator interface {
+	Consume(ctx context.Context, usage PartUsage) (int64, Stock, error)
+}
+
+type PartUsageRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64) ([]PartUsage, error)
+	Report(ctx context.Context, filter PartUsageFilter) ([]PartUsageReport, error)
+}
+
+type Part struct {
+	ID   int64
+	SKU  string
+	Name string
+	Unit string
+}
+
+type Stock struct {
+	UserID      int64
+	Part        Part
+	Quantity    int64
+	MinQuantity int64
+}
+
+type PartUsage struct {
+	ID        int64
+	TaskID    int64
+	PartID    int64
+	UserID    int64
+	Quantity  int64
+	CreatedAt time.Time
+	Stock     *Stock
+}
+
+type PartUsageFilter struct {
+	From       *time.Time
+	To         *time.Time
+	CustomerID int64
+}
+
+type PartUsageReport struct {
+	CustomerID   int64
+	CustomerName string
+	Part         Part
+	Quantity     int64
+}
+
+func NewPart(sku, name, unit string) (Part, error) {
+	var err []string
+
+	if unit == "" {
+		unit = DefaultPartUnit
+	}
+
+	if sku == "" {
+		err = append(err, "sku must not be empty")
+	}
+
+	if len(sku) > 64 {
+		err = append(err, "sku size is too big")
+	}
+
+	if name == "" {
+		err = append(err, "name must not be empty")
+	}
+
+	if len(name) > 255 {
+		err = append(err, "name size is too big")
+	}
+
+	if len(unit) > 16 {
+		err = append(err, "unit size is too big")
+	}
+
+	if len(err) > 0 {
+		return Part{}, fmt.Errorf("%w: %s", ErrInvalidPart, strings.Join(err, "; "))
+	}
+
+	return Part{SKU: sku, Name: name, Unit: unit}, nil
+}
+
+func NewStock(userID, partID, quantity, minQuantity int64) (Stock, error) {
+	var err []string
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if partID == 0 {
+		err = append(err, "part ID must not be 0")
+	}
+
+	if quantity < 0 {
+		err = append(err, "quantity must not be negative")
+	}
+
+	if minQuantity < 0 {
+		err = append(err, "min quantity must not be negative")
+	}
+
+	if len(err) > 0 {
+		return Stock{}, fmt.Errorf("%w: %s", ErrInvalidStock, strings.Join(err, "; "))
+	}
+
+	return Stock{UserID: userID, Part: Part{ID: partID}, Quantity: quantity, MinQuantity: minQuantity}, nil
+}
+
+func NewPartUsage(taskID, partID, userID, quantity int64) (PartUsage, error) {
+	var err []string
+
+	if taskID == 0 {
+		err = append(err, "task ID must not be 0")
+	}
+
+	if partID == 0 {
+		err = append(err, "part ID must not be 0")
+	}
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if quantity <= 0 {
+		err = append(err, "quantity must be positive")
+	}
+
+	if len(err) > 0 {
+		return PartUsage{}, fmt.Errorf("%w: %s", ErrInvalidPartUsage, strings.Join(err, "; "))
+	}
+
+	return PartUsage{TaskID: taskID, PartID: partID, UserID: userID, Quantity: quantity}, nil
+}
+
+func (s Stock) IsLow() bool {
+	return s.Quantity <= s.MinQuantity
+}
+
+func (s Stock) ReachedMinimum(consumed int64) bool {
+	return s.IsLow() && s.Quantity+consumed > s.MinQuantity
+}
@@ -0,0 +1,524 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: part.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPartUsecase is a mock of PartUsecase interface.
+type MockPartUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartUsecaseMockRecorder
+}
+
+// MockPartUsecaseMockRecorder is the mock recorder for MockPartUsecase.
+type MockPartUsecaseMockRecorder struct {
+	mock *MockPartUsecase
+}
+
+// NewMockPartUsecase creates a new mock instance.
+func NewMockPartUsecase(ctrl *gomock.Controller) *MockPartUsecase {
+	mock := &MockPartUsecase{ctrl: ctrl}
+	mock.recorder = &MockPartUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartUsecase) EXPECT() *MockPartUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockPartUsecase) Add(ctx context.Context, part Part, user User) (Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, part, user)
+	ret0, _ := ret[0].(Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockPartUsecaseMockRecorder) Add(ctx, part, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPartUsecase)(nil).Add), ctx, part, user)
+}
+
+// List mocks base method.
+func (m *MockPartUsecase) List(ctx context.Context, user User) ([]Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, user)
+	ret0, _ := ret[0].([]Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPartUsecaseMockRecorder) List(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPartUsecase)(nil).List), ctx, user)
+}
+
+// Remove mocks base method.
+func (m *MockPartUsecase) Remove(ctx context.Context, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockPartUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPartUsecase)(nil).Remove), ctx, id, user)
+}
+
+// Update mocks base method.
+func (m *MockPartUsecase) Update(ctx context.Context, part Part, user User) (Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, part, user)
+	ret0, _ := ret[0].(Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPartUsecaseMockRecorder) Update(ctx, part, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPartUsecase)(nil).Update), ctx, part, user)
+}
+
+// MockPartCreator is a mock of PartCreator interface.
+type MockPartCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartCreatorMockRecorder
+}
+
+// MockPartCreatorMockRecorder is the mock recorder for MockPartCreator.
+type MockPartCreatorMockRecorder struct {
+	mock *MockPartCreator
+}
+
+// NewMockPartCreator creates a new mock instance.
+func NewMockPartCreator(ctrl *gomock.Controller) *MockPartCreator {
+	mock := &MockPartCreator{ctrl: ctrl}
+	mock.recorder = &MockPartCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartCreator) EXPECT() *MockPartCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockPartCreator) Add(ctx context.Context, part Part) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, part)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockPartCreatorMockRecorder) Add(ctx, part interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPartCreator)(nil).Add), ctx, part)
+}
+
+// MockPartRetriever is a mock of PartRetriever interface.
+type MockPartRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartRetrieverMockRecorder
+}
+
+// MockPartRetrieverMockRecorder is the mock recorder for MockPartRetriever.
+type MockPartRetrieverMockRecorder struct {
+	mock *MockPartRetriever
+}
+
+// NewMockPartRetriever creates a new mock instance.
+func NewMockPartRetriever(ctrl *gomock.Controller) *MockPartRetriever {
+	mock := &MockPartRetriever{ctrl: ctrl}
+	mock.recorder = &MockPartRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartRetriever) EXPECT() *MockPartRetrieverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockPartRetriever) List(ctx context.Context) ([]Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPartRetrieverMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPartRetriever)(nil).List), ctx)
+}
+
+// ListByID mocks base method.
+func (m *MockPartRetriever) ListByID(ctx context.Context, id int64) (Part, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Part)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockPartRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockPartRetriever)(nil).ListByID), ctx, id)
+}
+
+// MockPartUpdater is a mock of PartUpdater interface.
+type MockPartUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartUpdaterMockRecorder
+}
+
+// MockPartUpdaterMockRecorder is the mock recorder for MockPartUpdater.
+type MockPartUpdaterMockRecorder struct {
+	mock *MockPartUpdater
+}
+
+// NewMockPartUpdater creates a new mock instance.
+func NewMockPartUpdater(ctrl *gomock.Controller) *MockPartUpdater {
+	mock := &MockPartUpdater{ctrl: ctrl}
+	mock.recorder = &MockPartUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartUpdater) EXPECT() *MockPartUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockPartUpdater) Update(ctx context.Context, part Part) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, part)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPartUpdaterMockRecorder) Update(ctx, part interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPartUpdater)(nil).Update), ctx, part)
+}
+
+// MockPartRemover is a mock of PartRemover interface.
+type MockPartRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartRemoverMockRecorder
+}
+
+// MockPartRemoverMockRecorder is the mock recorder for MockPartRemover.
+type MockPartRemoverMockRecorder struct {
+	mock *MockPartRemover
+}
+
+// NewMockPartRemover creates a new mock instance.
+func NewMockPartRemover(ctrl *gomock.Controller) *MockPartRemover {
+	mock := &MockPartRemover{ctrl: ctrl}
+	mock.recorder = &MockPartRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartRemover) EXPECT() *MockPartRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockPartRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockPartRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockPartRemover)(nil).Remove), ctx, id)
+}
+
+// MockInventoryUsecase is a mock of InventoryUsecase interface.
+type MockInventoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryUsecaseMockRecorder
+}
+
+// MockInventoryUsecaseMockRecorder is the mock recorder for MockInventoryUsecase.
+type MockInventoryUsecaseMockRecorder struct {
+	mock *MockInventoryUsecase
+}
+
+// NewMockInventoryUsecase creates a new mock instance.
+func NewMockInventoryUsecase(ctrl *gomock.Controller) *MockInventoryUsecase {
+	mock := &MockInventoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockInventoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryUsecase) EXPECT() *MockInventoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockInventoryUsecase) Consume(ctx context.Context, usage PartUsage, user User) (PartUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, usage, user)
+	ret0, _ := ret[0].(PartUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockInventoryUsecaseMockRecorder) Consume(ctx, usage, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockInventoryUsecase)(nil).Consume), ctx, usage, user)
+}
+
+// ListStock mocks base method.
+func (m *MockInventoryUsecase) ListStock(ctx context.Context, userID int64, user User) ([]Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStock", ctx, userID, user)
+	ret0, _ := ret[0].([]Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStock indicates an expected call of ListStock.
+func (mr *MockInventoryUsecaseMockRecorder) ListStock(ctx, userID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStock", reflect.TypeOf((*MockInventoryUsecase)(nil).ListStock), ctx, userID, user)
+}
+
+// ListUsageByTask mocks base method.
+func (m *MockInventoryUsecase) ListUsageByTask(ctx context.Context, taskID int64, user User) ([]PartUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]PartUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageByTask indicates an expected call of ListUsageByTask.
+func (mr *MockInventoryUsecaseMockRecorder) ListUsageByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageByTask", reflect.TypeOf((*MockInventoryUsecase)(nil).ListUsageByTask), ctx, taskID, user)
+}
+
+// SetStock mocks base method.
+func (m *MockInventoryUsecase) SetStock(ctx context.Context, stock Stock, user User) (Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", ctx, stock, user)
+	ret0, _ := ret[0].(Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockInventoryUsecaseMockRecorder) SetStock(ctx, stock, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockInventoryUsecase)(nil).SetStock), ctx, stock, user)
+}
+
+// UsageReport mocks base method.
+func (m *MockInventoryUsecase) UsageReport(ctx context.Context, filter PartUsageFilter, user User) ([]PartUsageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsageReport", ctx, filter, user)
+	ret0, _ := ret[0].([]PartUsageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsageReport indicates an expected call of UsageReport.
+func (mr *MockInventoryUsecaseMockRecorder) UsageReport(ctx, filter, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageReport", reflect.TypeOf((*MockInventoryUsecase)(nil).UsageReport), ctx, filter, user)
+}
+
+// MockStockRetriever is a mock of StockRetriever interface.
+type MockStockRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockRetrieverMockRecorder
+}
+
+// MockStockRetrieverMockRecorder is the mock recorder for MockStockRetriever.
+type MockStockRetrieverMockRecorder struct {
+	mock *MockStockRetriever
+}
+
+// NewMockStockRetriever creates a new mock instance.
+func NewMockStockRetriever(ctrl *gomock.Controller) *MockStockRetriever {
+	mock := &MockStockRetriever{ctrl: ctrl}
+	mock.recorder = &MockStockRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockRetriever) EXPECT() *MockStockRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByUserID mocks base method.
+func (m *MockStockRetriever) ListByUserID(ctx context.Context, userID int64) ([]Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]Stock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockStockRetrieverMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockStockRetriever)(nil).ListByUserID), ctx, userID)
+}
+
+// MockStockUpdater is a mock of StockUpdater interface.
+type MockStockUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockUpdaterMockRecorder
+}
+
+// MockStockUpdaterMockRecorder is the mock recorder for MockStockUpdater.
+type MockStockUpdaterMockRecorder struct {
+	mock *MockStockUpdater
+}
+
+// NewMockStockUpdater creates a new mock instance.
+func NewMockStockUpdater(ctrl *gomock.Controller) *MockStockUpdater {
+	mock := &MockStockUpdater{ctrl: ctrl}
+	mock.recorder = &MockStockUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockUpdater) EXPECT() *MockStockUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockStockUpdater) Upsert(ctx context.Context, stock Stock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockStockUpdaterMockRecorder) Upsert(ctx, stock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockStockUpdater)(nil).Upsert), ctx, stock)
+}
+
+// MockPartUsageCreator is a mock of PartUsageCreator interface.
+type MockPartUsageCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartUsageCreatorMockRecorder
+}
+
+// MockPartUsageCreatorMockRecorder is the mock recorder for MockPartUsageCreator.
+type MockPartUsageCreatorMockRecorder struct {
+	mock *MockPartUsageCreator
+}
+
+// NewMockPartUsageCreator creates a new mock instance.
+func NewMockPartUsageCreator(ctrl *gomock.Controller) *MockPartUsageCreator {
+	mock := &MockPartUsageCreator{ctrl: ctrl}
+	mock.recorder = &MockPartUsageCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartUsageCreator) EXPECT() *MockPartUsageCreatorMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockPartUsageCreator) Consume(ctx context.Context, usage PartUsage) (int64, Stock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, usage)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(Stock)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockPartUsageCreatorMockRecorder) Consume(ctx, usage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockPartUsageCreator)(nil).Consume), ctx, usage)
+}
+
+// MockPartUsageRetriever is a mock of PartUsageRetriever interface.
+type MockPartUsageRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartUsageRetrieverMockRecorder
+}
+
+// MockPartUsageRetrieverMockRecorder is the mock recorder for MockPartUsageRetriever.
+type MockPartUsageRetrieverMockRecorder struct {
+	mock *MockPartUsageRetriever
+}
+
+// NewMockPartUsageRetriever creates a new mock instance.
+func NewMockPartUsageRetriever(ctrl *gomock.Controller) *MockPartUsageRetriever {
+	mock := &MockPartUsageRetriever{ctrl: ctrl}
+	mock.recorder = &MockPartUsageRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartUsageRetriever) EXPECT() *MockPartUsageRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByTaskID mocks base method.
+func (m *MockPartUsageRetriever) ListByTaskID(ctx context.Context, taskID int64) ([]PartUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]PartUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockPartUsageRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockPartUsageRetriever)(nil).ListByTaskID), ctx, taskID)
+}
+
+// Report mocks base method.
+func (m *MockPartUsageRetriever) Report(ctx context.Context, filter PartUsageFilter) ([]PartUsageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, filter)
+	ret0, _ := ret[0].([]PartUsageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockPartUsageRetrieverMockRecorder) Report(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockPartUsageRetriever)(nil).Report), ctx, filter)
+}
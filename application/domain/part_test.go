@@ -0,0 +1,102 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewPart(t *testing.T) {
+	tests := []struct {
+		name    string
+		sku     string
+		part    string
+		unit    string
+		want    Part
+		wantErr bool
+	}{
+		{name: "Expect error when sku is empty", part: "Breaker 20A", wantErr: true},
+		{name: "Expect error when sku is too big", sku: strings.Repeat("a", 65), part: "Breaker 20A", wantErr: true},
+		{name: "Expect error when name is empty", sku: "BRK-20", wantErr: true},
+		{name: "Expect error when unit is too big", sku: "BRK-20", part: "Breaker 20A", unit: strings.Repeat("a", 17), wantErr: true},
+		{name: "Expect default unit", sku: "BRK-20", part: "Breaker 20A", want: Part{SKU: "BRK-20", Name: "Breaker 20A", Unit: DefaultPartUnit}},
+		{name: "Expect success", sku: "CBL-2", part: "Cable 2.5mm", unit: "m", want: Part{SKU: "CBL-2", Name: "Cable 2.5mm", Unit: "m"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPart(tt.sku, tt.part, tt.unit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPart() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidPart))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewStock(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      int64
+		partID      int64
+		quantity    int64
+		minQuantity int64
+		wantErr     bool
+	}{
+		{name: "Expect error when user is missing", partID: 1, quantity: 1, wantErr: true},
+		{name: "Expect error when part is missing", userID: 2, quantity: 1, wantErr: true},
+		{name: "Expect error when quantity is negative", userID: 2, partID: 1, quantity: -1, wantErr: true},
+		{name: "Expect error when min quantity is negative", userID: 2, partID: 1, minQuantity: -1, wantErr: true},
+		{name: "Expect success with empty stock", userID: 2, partID: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewStock(tt.userID, tt.partID, tt.quantity, tt.minQuantity)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewStock() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidStock))
+			}
+		})
+	}
+}
+
+func TestNewPartUsage(t *testing.T) {
+	_, err := NewPartUsage(1, 3, 2, 0)
+	assert.True(t, errors.Is(err, ErrInvalidPartUsage))
+
+	got, err := NewPartUsage(1, 3, 2, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, PartUsage{TaskID: 1, PartID: 3, UserID: 2, Quantity: 4}, got)
+}
+
+func TestStock_ReachedMinimum(t *testing.T) {
+	tests := []struct {
+		name     string
+		stock    Stock
+		consumed int64
+		want     bool
+	}{
+		{name: "Expect false while above minimum", stock: Stock{Quantity: 6, MinQuantity: 5}, consumed: 1, want: false},
+		{name: "Expect true when reaching minimum", stock: Stock{Quantity: 5, MinQuantity: 5}, consumed: 1, want: true},
+		{name: "Expect true when crossing minimum", stock: Stock{Quantity: 2, MinQuantity: 5}, consumed: 4, want: true},
+		{name: "Expect false when already below minimum", stock: Stock{Quantity: 3, MinQuantity: 5}, consumed: 1, want: false},
+		{name: "Expect true when running out without minimum", stock: Stock{Quantity: 0}, consumed: 2, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.stock.ReachedMinimum(tt.consumed))
+		})
+	}
+}
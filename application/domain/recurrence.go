@@ -0,0 +1,478 @@
+//go:generate mockgen -source=recurrence.go -destination=recurrence_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRRule        = errors.New("recurrence rule is invalid")
+	ErrInvalidTaskSeries   = errors.New("task series fields are invalid")
+	ErrTaskSeriesNotFound  = errors.New("task series not found")
+	ErrTaskNotInTaskSeries = errors.New("task does not belong to a series")
+)
+
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+
+	rruleUntilLayout = "20060102T150405Z"
+	rruleMaxPeriods  = 10000
+)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type TaskSeriesUsecase interface {
+	Add(ctx context.Context, series TaskSeries, user User) (TaskSeries, error)
+	ListByUser(ctx context.Context, user User) ([]TaskSeries, error)
+	Update(ctx context.Context, series TaskSeries, user User) (TaskSeries, error)
+	UpdateFromOccurrence(ctx context.Context, taskID int64, series TaskSeries, user User) (TaskSeries, error)
+	Remove(ctx context.Context, id int64, user User) error
+	Generate(ctx context.Context) (int, error)
+}
+
+type TaskSeriesCreator interface {
+	Add(ctx context.Context, series TaskSeries) (int64, error)
+}
+
+type TaskSeriesRetriever interface {
+	List(ctx context.Context) ([]TaskSeries, error)
+	ListByID(ctx context.Context, id int64) (TaskSeries, error)
+	ListByUserID(ctx context.Context, userID int64) ([]TaskSeries, error)
+	ListPending(ctx context.Context, until time.Time) ([]TaskSeries, error)
+}
+
+type TaskSeriesUpdater interface {
+	Update(ctx context.Context, series TaskSeries) error
+	MarkGenerated(ctx context.Context, id int64, until time.Time) error
+}
+
+type TaskSeriesRemover interface {
+	Remove(ctx context.Context, id int64) error
+}
+
+type TaskOccurrenceWriter interface {
+	AddOccurrence(ctx context.Context, task Task) (int64, bool, error)
+	RemoveOpenOccurrences(ctx context.Context, seriesID int64, from time.Time) error
+	MoveOccurrences(ctx context.Context, seriesID, toSeriesID int64, from time.Time) error
+}
+
+type TaskSeries struct {
+	ID             int64
+	Summary        string
+	RRule          string
+	Start          time.Time
+	UserID         int64
+	Type           string
+	Priority       string
+	SiteID         int64
+	Duration       time.Duration
+	Window         time.Duration
+	Timezone       string
+	GeneratedUntil *time.Time
+}
+
+type RRule struct {
+	Freq     string
+	Interval int
+	ByDay    []ByDay
+	Until    *time.Time
+	Count    int
+}
+
+type ByDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+func NewTaskSeries(summary, rrule string, start *time.Time, userID int64, taskType, priority, timezone string) (TaskSeries, error) {
+	var err []string
+
+	if summary == "" {
+		err = append(err, "summary must not be empty")
+	}
+
+	if len(summary) > 2500 {
+		err = append(err, "summary size is too big")
+	}
+
+	if _, e := ParseRRule(rrule); e != nil {
+		err = append(err, e.Error())
+	}
+
+	if start == nil {
+		err = append(err, "start must not be empty")
+	}
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if taskType == "" {
+		taskType = DefaultTaskType
+	}
+
+	if len(taskType) > 64 {
+		err = append(err, "type size is too big")
+	}
+
+	if priority == "" {
+		priority = DefaultTaskPriority
+	}
+
+	if !IsValidTaskPriority(priority) {
+		err = append(err, "priority must be one of P1, P2, P3, P4")
+	}
+
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+
+	if e := ValidateTimezone(timezone); e != nil {
+		err = append(err, e.Error())
+	}
+
+	if len(err) > 0 {
+		return TaskSeries{}, fmt.Errorf("%w: %s", ErrInvalidTaskSeries, strings.Join(err, "; "))
+	}
+
+	return TaskSeries{Summary: summary, RRule: rrule, Start: *start, UserID: userID, Type: taskType, Priority: priority, Timezone: timezone}, nil
+}
+
+func ParseRRule(value string) (RRule, error) {
+	rule := RRule{Interval: 1}
+
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return RRule{}, fmt.Errorf("%w: must not be empty", ErrInvalidRRule)
+	}
+
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return RRule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRRule, part)
+		}
+
+		key, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+
+		switch key {
+		case "FREQ":
+			if val != FreqDaily && val != FreqWeekly && val != FreqMonthly {
+				return RRule{}, fmt.Errorf("%w: FREQ %q is not supported", ErrInvalidRRule, val)
+			}
+			rule.Freq = val
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return RRule{}, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrInvalidRRule)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return RRule{}, fmt.Errorf("%w: COUNT must be a positive integer", ErrInvalidRRule)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := time.Parse(rruleUntilLayout, val)
+			if err != nil {
+				return RRule{}, fmt.Errorf("%w: UNTIL must follow %s", ErrInvalidRRule, rruleUntilLayout)
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, d := range strings.Split(val, ",") {
+				byDay, err := parseByDay(d)
+				if err != nil {
+					return RRule{}, err
+				}
+				rule.ByDay = append(rule.ByDay, byDay)
+			}
+		default:
+			return RRule{}, fmt.Errorf("%w: %s is not supported", ErrInvalidRRule, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return RRule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRRule)
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return RRule{}, fmt.Errorf("%w: COUNT and UNTIL must not be used together", ErrInvalidRRule)
+	}
+
+	for _, d := range rule.ByDay {
+		if d.Ordinal != 0 && rule.Freq != FreqMonthly {
+			return RRule{}, fmt.Errorf("%w: BYDAY ordinals are only supported with MONTHLY", ErrInvalidRRule)
+		}
+	}
+
+	return rule, nil
+}
+
+func parseByDay(value string) (ByDay, error) {
+	if len(value) < 2 {
+		return ByDay{}, fmt.Errorf("%w: BYDAY %q is malformed", ErrInvalidRRule, value)
+	}
+
+	weekday, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return ByDay{}, fmt.Errorf("%w: BYDAY %q is malformed", ErrInvalidRRule, value)
+	}
+
+	var ordinal int
+
+	if prefix := value[:len(value)-2]; prefix != "" {
+		o, err := strconv.Atoi(prefix)
+		if err != nil || o == 0 || o > 5 || o < -5 {
+			return ByDay{}, fmt.Errorf("%w: BYDAY %q is malformed", ErrInvalidRRule, value)
+		}
+		ordinal = o
+	}
+
+	return ByDay{Ordinal: ordinal, Weekday: weekday}, nil
+}
+
+func (s *TaskSeries) LocalStart() time.Time {
+	return s.Start.In(LoadLocation(s.Timezone))
+}
+
+// ValidateSchedule checks the duration of the occurrences and the window that
+// opens at each of them, which must be long enough to fit the duration.
+func (s *TaskSeries) ValidateSchedule() error {
+	var err []string
+
+	if s.Duration < 0 {
+		err = append(err, "duration must not be negative")
+	}
+
+	if s.Window < 0 {
+		err = append(err, "time window must not be negative")
+	}
+
+	if s.Window > 0 && s.Window < s.Duration {
+		err = append(err, "task must fit in the time window")
+	}
+
+	if len(err) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidTaskSeries, strings.Join(err, "; "))
+	}
+
+	return nil
+}
+
+// Occurrence is the open task the series schedules at, which must be in UTC.
+func (s *TaskSeries) Occurrence(at time.Time) Task {
+	task := Task{
+		Summary:      s.Summary,
+		Date:         &at,
+		UserID:       s.UserID,
+		SiteID:       s.SiteID,
+		Type:         s.Type,
+		Priority:     s.Priority,
+		Status:       TaskStatusOpen,
+		DueAt:        &at,
+		SeriesID:     s.ID,
+		OccurrenceAt: &at,
+		Duration:     s.Duration,
+	}
+
+	if task.Priority == "" {
+		task.Priority = DefaultTaskPriority
+	}
+
+	if s.Window > 0 {
+		end := at.Add(s.Window)
+		task.WindowStart = &at
+		task.WindowEnd = &end
+	}
+
+	return task
+}
+
+func (r RRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		var days []string
+
+		for _, d := range r.ByDay {
+			for code, w := range weekdays {
+				if w != d.Weekday {
+					continue
+				}
+
+				if d.Ordinal != 0 {
+					code = strconv.Itoa(d.Ordinal) + code
+				}
+
+				days = append(days, code)
+			}
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleUntilLayout))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (r RRule) Occurrences(start, from, to time.Time) []time.Time {
+	var (
+		result []time.Time
+		count  int
+	)
+
+	for period := 0; period < rruleMaxPeriods; period++ {
+		anchor, candidates := r.expand(start, period)
+
+		if anchor.After(to) {
+			break
+		}
+
+		for _, c := range candidates {
+			if c.Before(start) {
+				continue
+			}
+
+			if r.Until != nil && c.After(*r.Until) {
+				return result
+			}
+
+			count++
+			if r.Count > 0 && count > r.Count {
+				return result
+			}
+
+			if !c.Before(to) {
+				return result
+			}
+
+			if !c.Before(from) {
+				result = append(result, c)
+			}
+		}
+	}
+
+	return result
+}
+
+func (r RRule) expand(start time.Time, period int) (time.Time, []time.Time) {
+	step := period * r.Interval
+
+	switch r.Freq {
+	case FreqDaily:
+		day := start.AddDate(0, 0, step)
+		if len(r.ByDay) > 0 && !r.hasWeekday(day.Weekday()) {
+			return day, nil
+		}
+
+		return day, []time.Time{day}
+	case FreqWeekly:
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday())+6)%7)+7*step)
+
+		byDay := r.ByDay
+		if len(byDay) == 0 {
+			byDay = []ByDay{{Weekday: start.Weekday()}}
+		}
+
+		var result []time.Time
+
+		for _, d := range byDay {
+			result = append(result, weekStart.AddDate(0, 0, (int(d.Weekday)+6)%7))
+		}
+
+		sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+
+		return weekStart, result
+	case FreqMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(step), 1, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+
+		if len(r.ByDay) == 0 {
+			day := first.AddDate(0, 0, start.Day()-1)
+			if day.Month() != first.Month() {
+				return first, nil
+			}
+
+			return first, []time.Time{day}
+		}
+
+		return first, r.monthlyByDay(first)
+	}
+
+	return start, nil
+}
+
+func (r RRule) monthlyByDay(first time.Time) []time.Time {
+	var result []time.Time
+
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	for _, d := range r.ByDay {
+		var matches []time.Time
+
+		for day := 0; day < daysInMonth; day++ {
+			candidate := first.AddDate(0, 0, day)
+			if candidate.Weekday() == d.Weekday {
+				matches = append(matches, candidate)
+			}
+		}
+
+		switch {
+		case d.Ordinal == 0:
+			result = append(result, matches...)
+		case d.Ordinal > 0 && d.Ordinal <= len(matches):
+			result = append(result, matches[d.Ordinal-1])
+		case d.Ordinal < 0 && -d.Ordinal <= len(matches):
+			result = append(result, matches[len(matches)+d.Ordinal])
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+
+	var unique []time.Time
+
+	for i, t := range result {
+		if i == 0 || !t.Equal(result[i-1]) {
+			unique = append(unique, t)
+		}
+	}
+
+	return unique
+}
+
+func (r RRule) hasWeekday(weekday time.Weekday) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday == weekday {
+			return true
+		}
+	}
+
+	return false
+}
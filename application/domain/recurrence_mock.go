@@ -0,0 +1,401 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recurrence.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaskSeriesUsecase is a mock of TaskSeriesUsecase interface.
+type MockTaskSeriesUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSeriesUsecaseMockRecorder
+}
+
+// MockTaskSeriesUsecaseMockRecorder is the mock recorder for MockTaskSeriesUsecase.
+type MockTaskSeriesUsecaseMockRecorder struct {
+	mock *MockTaskSeriesUsecase
+}
+
+// NewMockTaskSeriesUsecase creates a new mock instance.
+func NewMockTaskSeriesUsecase(ctrl *gomock.Controller) *MockTaskSeriesUsecase {
+	mock := &MockTaskSeriesUsecase{ctrl: ctrl}
+	mock.recorder = &MockTaskSeriesUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSeriesUsecase) EXPECT() *MockTaskSeriesUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTaskSeriesUsecase) Add(ctx context.Context, series TaskSeries, user User) (TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, series, user)
+	ret0, _ := ret[0].(TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTaskSeriesUsecaseMockRecorder) Add(ctx, series, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).Add), ctx, series, user)
+}
+
+// Generate mocks base method.
+func (m *MockTaskSeriesUsecase) Generate(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockTaskSeriesUsecaseMockRecorder) Generate(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).Generate), ctx)
+}
+
+// ListByUser mocks base method.
+func (m *MockTaskSeriesUsecase) ListByUser(ctx context.Context, user User) ([]TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUser", ctx, user)
+	ret0, _ := ret[0].([]TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUser indicates an expected call of ListByUser.
+func (mr *MockTaskSeriesUsecaseMockRecorder) ListByUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).ListByUser), ctx, user)
+}
+
+// Remove mocks base method.
+func (m *MockTaskSeriesUsecase) Remove(ctx context.Context, id int64, user User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockTaskSeriesUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).Remove), ctx, id, user)
+}
+
+// Update mocks base method.
+func (m *MockTaskSeriesUsecase) Update(ctx context.Context, series TaskSeries, user User) (TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, series, user)
+	ret0, _ := ret[0].(TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskSeriesUsecaseMockRecorder) Update(ctx, series, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).Update), ctx, series, user)
+}
+
+// UpdateFromOccurrence mocks base method.
+func (m *MockTaskSeriesUsecase) UpdateFromOccurrence(ctx context.Context, taskID int64, series TaskSeries, user User) (TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFromOccurrence", ctx, taskID, series, user)
+	ret0, _ := ret[0].(TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFromOccurrence indicates an expected call of UpdateFromOccurrence.
+func (mr *MockTaskSeriesUsecaseMockRecorder) UpdateFromOccurrence(ctx, taskID, series, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFromOccurrence", reflect.TypeOf((*MockTaskSeriesUsecase)(nil).UpdateFromOccurrence), ctx, taskID, series, user)
+}
+
+// MockTaskSeriesCreator is a mock of TaskSeriesCreator interface.
+type MockTaskSeriesCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSeriesCreatorMockRecorder
+}
+
+// MockTaskSeriesCreatorMockRecorder is the mock recorder for MockTaskSeriesCreator.
+type MockTaskSeriesCreatorMockRecorder struct {
+	mock *MockTaskSeriesCreator
+}
+
+// NewMockTaskSeriesCreator creates a new mock instance.
+func NewMockTaskSeriesCreator(ctrl *gomock.Controller) *MockTaskSeriesCreator {
+	mock := &MockTaskSeriesCreator{ctrl: ctrl}
+	mock.recorder = &MockTaskSeriesCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSeriesCreator) EXPECT() *MockTaskSeriesCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTaskSeriesCreator) Add(ctx context.Context, series TaskSeries) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, series)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTaskSeriesCreatorMockRecorder) Add(ctx, series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTaskSeriesCreator)(nil).Add), ctx, series)
+}
+
+// MockTaskSeriesRetriever is a mock of TaskSeriesRetriever interface.
+type MockTaskSeriesRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSeriesRetrieverMockRecorder
+}
+
+// MockTaskSeriesRetrieverMockRecorder is the mock recorder for MockTaskSeriesRetriever.
+type MockTaskSeriesRetrieverMockRecorder struct {
+	mock *MockTaskSeriesRetriever
+}
+
+// NewMockTaskSeriesRetriever creates a new mock instance.
+func NewMockTaskSeriesRetriever(ctrl *gomock.Controller) *MockTaskSeriesRetriever {
+	mock := &MockTaskSeriesRetriever{ctrl: ctrl}
+	mock.recorder = &MockTaskSeriesRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSeriesRetriever) EXPECT() *MockTaskSeriesRetrieverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockTaskSeriesRetriever) List(ctx context.Context) ([]TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskSeriesRetrieverMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskSeriesRetriever)(nil).List), ctx)
+}
+
+// ListByID mocks base method.
+func (m *MockTaskSeriesRetriever) ListByID(ctx context.Context, id int64) (TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockTaskSeriesRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockTaskSeriesRetriever)(nil).ListByID), ctx, id)
+}
+
+// ListByUserID mocks base method.
+func (m *MockTaskSeriesRetriever) ListByUserID(ctx context.Context, userID int64) ([]TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockTaskSeriesRetrieverMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockTaskSeriesRetriever)(nil).ListByUserID), ctx, userID)
+}
+
+// ListPending mocks base method.
+func (m *MockTaskSeriesRetriever) ListPending(ctx context.Context, until time.Time) ([]TaskSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, until)
+	ret0, _ := ret[0].([]TaskSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockTaskSeriesRetrieverMockRecorder) ListPending(ctx, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockTaskSeriesRetriever)(nil).ListPending), ctx, until)
+}
+
+// MockTaskSeriesUpdater is a mock of TaskSeriesUpdater interface.
+type MockTaskSeriesUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSeriesUpdaterMockRecorder
+}
+
+// MockTaskSeriesUpdaterMockRecorder is the mock recorder for MockTaskSeriesUpdater.
+type MockTaskSeriesUpdaterMockRecorder struct {
+	mock *MockTaskSeriesUpdater
+}
+
+// NewMockTaskSeriesUpdater creates a new mock instance.
+func NewMockTaskSeriesUpdater(ctrl *gomock.Controller) *MockTaskSeriesUpdater {
+	mock := &MockTaskSeriesUpdater{ctrl: ctrl}
+	mock.recorder = &MockTaskSeriesUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSeriesUpdater) EXPECT() *MockTaskSeriesUpdaterMockRecorder {
+	return m.recorder
+}
+
+// MarkGenerated mocks base method.
+func (m *MockTaskSeriesUpdater) MarkGenerated(ctx context.Context, id int64, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGenerated", ctx, id, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkGenerated indicates an expected call of MarkGenerated.
+func (mr *MockTaskSeriesUpdaterMockRecorder) MarkGenerated(ctx, id, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGenerated", reflect.TypeOf((*MockTaskSeriesUpdater)(nil).MarkGenerated), ctx, id, until)
+}
+
+// Update mocks base method.
+func (m *MockTaskSeriesUpdater) Update(ctx context.Context, series TaskSeries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskSeriesUpdaterMockRecorder) Update(ctx, series interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskSeriesUpdater)(nil).Update), ctx, series)
+}
+
+// MockTaskSeriesRemover is a mock of TaskSeriesRemover interface.
+type MockTaskSeriesRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskSeriesRemoverMockRecorder
+}
+
+// MockTaskSeriesRemoverMockRecorder is the mock recorder for MockTaskSeriesRemover.
+type MockTaskSeriesRemoverMockRecorder struct {
+	mock *MockTaskSeriesRemover
+}
+
+// NewMockTaskSeriesRemover creates a new mock instance.
+func NewMockTaskSeriesRemover(ctrl *gomock.Controller) *MockTaskSeriesRemover {
+	mock := &MockTaskSeriesRemover{ctrl: ctrl}
+	mock.recorder = &MockTaskSeriesRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskSeriesRemover) EXPECT() *MockTaskSeriesRemoverMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockTaskSeriesRemover) Remove(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockTaskSeriesRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskSeriesRemover)(nil).Remove), ctx, id)
+}
+
+// MockTaskOccurrenceWriter is a mock of TaskOccurrenceWriter interface.
+type MockTaskOccurrenceWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskOccurrenceWriterMockRecorder
+}
+
+// MockTaskOccurrenceWriterMockRecorder is the mock recorder for MockTaskOccurrenceWriter.
+type MockTaskOccurrenceWriterMockRecorder struct {
+	mock *MockTaskOccurrenceWriter
+}
+
+// NewMockTaskOccurrenceWriter creates a new mock instance.
+func NewMockTaskOccurrenceWriter(ctrl *gomock.Controller) *MockTaskOccurrenceWriter {
+	mock := &MockTaskOccurrenceWriter{ctrl: ctrl}
+	mock.recorder = &MockTaskOccurrenceWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskOccurrenceWriter) EXPECT() *MockTaskOccurrenceWriterMockRecorder {
+	return m.recorder
+}
+
+// AddOccurrence mocks base method.
+func (m *MockTaskOccurrenceWriter) AddOccurrence(ctx context.Context, task Task) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOccurrence", ctx, task)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddOccurrence indicates an expected call of AddOccurrence.
+func (mr *MockTaskOccurrenceWriterMockRecorder) AddOccurrence(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOccurrence", reflect.TypeOf((*MockTaskOccurrenceWriter)(nil).AddOccurrence), ctx, task)
+}
+
+// MoveOccurrences mocks base method.
+func (m *MockTaskOccurrenceWriter) MoveOccurrences(ctx context.Context, seriesID, toSeriesID int64, from time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOccurrences", ctx, seriesID, toSeriesID, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveOccurrences indicates an expected call of MoveOccurrences.
+func (mr *MockTaskOccurrenceWriterMockRecorder) MoveOccurrences(ctx, seriesID, toSeriesID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOccurrences", reflect.TypeOf((*MockTaskOccurrenceWriter)(nil).MoveOccurrences), ctx, seriesID, toSeriesID, from)
+}
+
+// RemoveOpenOccurrences mocks base method.
+func (m *MockTaskOccurrenceWriter) RemoveOpenOccurrences(ctx context.Context, seriesID int64, from time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOpenOccurrences", ctx, seriesID, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOpenOccurrences indicates an expected call of RemoveOpenOccurrences.
+func (mr *MockTaskOccurrenceWriterMockRecorder) RemoveOpenOccurrences(ctx, seriesID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOpenOccurrences", reflect.TypeOf((*MockTaskOccurrenceWriter)(nil).RemoveOpenOccurrences), ctx, seriesID, from)
+}
@@ -0,0 +1,327 @@
+//go:build unit
+
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	until := time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    RRule
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when rule is empty",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when FREQ is missing",
+			value:   "INTERVAL=2",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when FREQ is not supported",
+			value:   "FREQ=YEARLY",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when INTERVAL is not positive",
+			value:   "FREQ=DAILY;INTERVAL=0",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when COUNT and UNTIL are used together",
+			value:   "FREQ=DAILY;COUNT=2;UNTIL=20231231T235959Z",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when BYDAY ordinal is used with WEEKLY",
+			value:   "FREQ=WEEKLY;BYDAY=1MO",
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when part is not supported",
+			value:   "FREQ=DAILY;BYHOUR=10",
+			wantErr: true,
+		},
+		{
+			name:  "Expect weekly rule with prefix",
+			value: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=4",
+			want: RRule{
+				Freq:     FreqWeekly,
+				Interval: 2,
+				ByDay:    []ByDay{{Weekday: time.Monday}, {Weekday: time.Thursday}},
+				Count:    4,
+			},
+		},
+		{
+			name:  "Expect monthly rule with ordinal and until",
+			value: "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20231231T235959Z",
+			want: RRule{
+				Freq:     FreqMonthly,
+				Interval: 1,
+				ByDay:    []ByDay{{Ordinal: -1, Weekday: time.Friday}},
+				Until:    &until,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRRule(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRRule_String(t *testing.T) {
+	rule, err := ParseRRule("FREQ=MONTHLY;INTERVAL=3;BYDAY=2TU;COUNT=5")
+	assert.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=3;BYDAY=2TU;COUNT=5", rule.String())
+}
+
+func TestRRule_Occurrences(t *testing.T) {
+	var (
+		start = time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC) // Wednesday
+		to    = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	at := func(month time.Month, day int) time.Time {
+		year := 2023
+		if month < time.November {
+			year = 2024
+		}
+
+		return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	type args struct {
+		rule string
+		from time.Time
+		to   time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		want []time.Time
+	}{
+		{
+			name: "Expect daily occurrences limited by COUNT",
+			args: args{rule: "FREQ=DAILY;INTERVAL=2;COUNT=3", from: start, to: to},
+			want: []time.Time{at(time.November, 1), at(time.November, 3), at(time.November, 5)},
+		},
+		{
+			name: "Expect COUNT to be counted from start when from is later",
+			args: args{rule: "FREQ=DAILY;COUNT=3", from: at(time.November, 2), to: to},
+			want: []time.Time{at(time.November, 2), at(time.November, 3)},
+		},
+		{
+			name: "Expect weekly occurrences on the given weekdays",
+			args: args{rule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4", from: start, to: to},
+			want: []time.Time{at(time.November, 1), at(time.November, 6), at(time.November, 8), at(time.November, 13)},
+		},
+		{
+			name: "Expect weekly occurrences to stop at UNTIL",
+			args: args{rule: "FREQ=WEEKLY;INTERVAL=2;UNTIL=20231201T000000Z", from: start, to: to},
+			want: []time.Time{at(time.November, 1), at(time.November, 15), at(time.November, 29)},
+		},
+		{
+			name: "Expect monthly occurrences on the last friday",
+			args: args{rule: "FREQ=MONTHLY;BYDAY=-1FR", from: start, to: to},
+			want: []time.Time{at(time.November, 24), at(time.December, 29), at(time.January, 26), at(time.February, 23)},
+		},
+		{
+			name: "Expect occurrences to stop at the window end",
+			args: args{rule: "FREQ=DAILY", from: start, to: at(time.November, 3)},
+			want: []time.Time{at(time.November, 1), at(time.November, 2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRRule(tt.args.rule)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, rule.Occurrences(start, tt.args.from, tt.args.to))
+		})
+	}
+}
+
+func TestRRule_Occurrences_MonthEnd(t *testing.T) {
+	start := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	rule, err := ParseRRule("FREQ=MONTHLY;COUNT=3")
+	assert.NoError(t, err)
+
+	want := []time.Time{
+		start,
+		time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 31, 9, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, want, rule.Occurrences(start, start, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestNewTaskSeries(t *testing.T) {
+	start := time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+
+	type args struct {
+		summary  string
+		rrule    string
+		start    *time.Time
+		userID   int64
+		taskType string
+		priority string
+		timezone string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    TaskSeries
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when summary is empty",
+			args:    args{summary: "", rrule: "FREQ=DAILY", start: &start, userID: 1},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when rule is invalid",
+			args:    args{summary: "Visit", rrule: "FREQ=HOURLY", start: &start, userID: 1},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when start is empty",
+			args:    args{summary: "Visit", rrule: "FREQ=DAILY", start: nil, userID: 1},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when user ID is 0",
+			args:    args{summary: "Visit", rrule: "FREQ=DAILY", start: &start, userID: 0},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success with default type",
+			args: args{summary: "Visit", rrule: "FREQ=DAILY", start: &start, userID: 1},
+			want: TaskSeries{Summary: "Visit", RRule: "FREQ=DAILY", Start: start, UserID: 1, Type: DefaultTaskType, Priority: DefaultTaskPriority, Timezone: DefaultTimezone},
+		},
+		{
+			name: "Expect success with priority",
+			args: args{summary: "Visit", rrule: "FREQ=DAILY", start: &start, userID: 1, priority: TaskPriorityP1},
+			want: TaskSeries{Summary: "Visit", RRule: "FREQ=DAILY", Start: start, UserID: 1, Type: DefaultTaskType, Priority: TaskPriorityP1, Timezone: DefaultTimezone},
+		},
+		{
+			name:    "Expect error when priority is unknown",
+			args:    args{summary: "Visit", rrule: "FREQ=DAILY", start: &start, userID: 1, priority: "P9"},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when timezone is unknown",
+			args:    args{summary: "Visit", rrule: "FREQ=DAILY", start: &start, userID: 1, timezone: "Mars/Olympus"},
+			want:    TaskSeries{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTaskSeries(tt.args.summary, tt.args.rrule, tt.args.start, tt.args.userID, tt.args.taskType, tt.args.priority, tt.args.timezone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskSeries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskSeries_ValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		series  TaskSeries
+		wantErr bool
+	}{
+		{name: "Expect series without schedule to be valid", series: TaskSeries{}, wantErr: false},
+		{name: "Expect duration in the window to be valid", series: TaskSeries{Duration: time.Hour, Window: 2 * time.Hour}, wantErr: false},
+		{name: "Expect error when duration is negative", series: TaskSeries{Duration: -time.Minute}, wantErr: true},
+		{name: "Expect error when window is negative", series: TaskSeries{Window: -time.Minute}, wantErr: true},
+		{name: "Expect error when duration does not fit in the window", series: TaskSeries{Duration: 2 * time.Hour, Window: time.Hour}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.series.ValidateSchedule()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTaskSeries)
+			}
+		})
+	}
+}
+
+func TestTaskSeries_Occurrence(t *testing.T) {
+	var (
+		at    = time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+		end   = at.Add(2 * time.Hour)
+		basic = TaskSeries{ID: 7, Summary: "encrypted", UserID: 2, Type: DefaultTaskType}
+	)
+
+	tests := []struct {
+		name   string
+		series TaskSeries
+		want   Task
+	}{
+		{
+			name:   "Expect occurrence with the default priority",
+			series: basic,
+			want:   Task{Summary: "encrypted", Date: &at, UserID: 2, Type: DefaultTaskType, Priority: DefaultTaskPriority, Status: TaskStatusOpen, DueAt: &at, SeriesID: 7, OccurrenceAt: &at},
+		},
+		{
+			name:   "Expect occurrence to keep the schedule of the series",
+			series: TaskSeries{ID: 7, Summary: "encrypted", UserID: 2, Type: DefaultTaskType, Priority: TaskPriorityP1, SiteID: 4, Duration: time.Hour, Window: 2 * time.Hour},
+			want:   Task{Summary: "encrypted", Date: &at, UserID: 2, SiteID: 4, Type: DefaultTaskType, Priority: TaskPriorityP1, Status: TaskStatusOpen, DueAt: &at, SeriesID: 7, OccurrenceAt: &at, Duration: time.Hour, WindowStart: &at, WindowEnd: &end},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.series.Occurrence(at))
+		})
+	}
+}
+
+func TestRRule_Occurrences_DaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	series := TaskSeries{Start: time.Date(2023, 11, 1, 13, 0, 0, 0, time.UTC), Timezone: "America/New_York"}
+
+	rule, err := ParseRRule("FREQ=WEEKLY;COUNT=2")
+	assert.NoError(t, err)
+
+	start := series.LocalStart()
+	got := rule.Occurrences(start, start, start.AddDate(0, 1, 0))
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, 9, got[1].In(loc).Hour())
+	assert.Equal(t, 14, got[1].UTC().Hour())
+}
@@ -0,0 +1,73 @@
+//go:generate mockgen -source=reminder.go -destination=reminder_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const EventTaskReminder = "task.reminder"
+
+// ErrReminderClaimLost is returned when a reminder is no longer claimed by the
+// owner, because its lease ran out or its task was rescheduled.
+var ErrReminderClaimLost = errors.New("reminder claim was lost")
+
+type ReminderUsecase interface {
+	ReminderScheduler
+	Dispatch(ctx context.Context) (int, error)
+}
+
+type ReminderScheduler interface {
+	Schedule(ctx context.Context, task Task) error
+	Cancel(ctx context.Context, taskID int64) error
+}
+
+type ReminderCreator interface {
+	Add(ctx context.Context, reminders []Reminder) error
+}
+
+type ReminderRemover interface {
+	RemovePendingByTaskID(ctx context.Context, taskID int64) error
+}
+
+type ReminderClaimer interface {
+	Claim(ctx context.Context, owner string, now time.Time, limit int) ([]Reminder, error)
+	MarkSent(ctx context.Context, id int64, owner string) error
+	Release(ctx context.Context, id int64, owner string) error
+}
+
+type Reminder struct {
+	ID       int64
+	TaskID   int64
+	UserID   int64
+	Offset   time.Duration
+	RemindAt time.Time
+	TaskDate time.Time
+}
+
+func NewReminders(task Task, offsets []time.Duration, now time.Time) []Reminder {
+	var result []Reminder
+
+	if task.ID == 0 || task.Date == nil {
+		return result
+	}
+
+	for _, offset := range offsets {
+		remindAt := task.Date.Add(-offset)
+		if !remindAt.After(now) {
+			continue
+		}
+
+		result = append(result, Reminder{
+			TaskID:   task.ID,
+			UserID:   task.UserID,
+			Offset:   offset,
+			RemindAt: remindAt,
+			TaskDate: *task.Date,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,270 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReminderUsecase is a mock of ReminderUsecase interface.
+type MockReminderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderUsecaseMockRecorder
+}
+
+// MockReminderUsecaseMockRecorder is the mock recorder for MockReminderUsecase.
+type MockReminderUsecaseMockRecorder struct {
+	mock *MockReminderUsecase
+}
+
+// NewMockReminderUsecase creates a new mock instance.
+func NewMockReminderUsecase(ctrl *gomock.Controller) *MockReminderUsecase {
+	mock := &MockReminderUsecase{ctrl: ctrl}
+	mock.recorder = &MockReminderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderUsecase) EXPECT() *MockReminderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockReminderUsecase) Cancel(ctx context.Context, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReminderUsecaseMockRecorder) Cancel(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReminderUsecase)(nil).Cancel), ctx, taskID)
+}
+
+// Dispatch mocks base method.
+func (m *MockReminderUsecase) Dispatch(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockReminderUsecaseMockRecorder) Dispatch(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockReminderUsecase)(nil).Dispatch), ctx)
+}
+
+// Schedule mocks base method.
+func (m *MockReminderUsecase) Schedule(ctx context.Context, task Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockReminderUsecaseMockRecorder) Schedule(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockReminderUsecase)(nil).Schedule), ctx, task)
+}
+
+// MockReminderScheduler is a mock of ReminderScheduler interface.
+type MockReminderScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderSchedulerMockRecorder
+}
+
+// MockReminderSchedulerMockRecorder is the mock recorder for MockReminderScheduler.
+type MockReminderSchedulerMockRecorder struct {
+	mock *MockReminderScheduler
+}
+
+// NewMockReminderScheduler creates a new mock instance.
+func NewMockReminderScheduler(ctrl *gomock.Controller) *MockReminderScheduler {
+	mock := &MockReminderScheduler{ctrl: ctrl}
+	mock.recorder = &MockReminderSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderScheduler) EXPECT() *MockReminderSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockReminderScheduler) Cancel(ctx context.Context, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReminderSchedulerMockRecorder) Cancel(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReminderScheduler)(nil).Cancel), ctx, taskID)
+}
+
+// Schedule mocks base method.
+func (m *MockReminderScheduler) Schedule(ctx context.Context, task Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockReminderSchedulerMockRecorder) Schedule(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockReminderScheduler)(nil).Schedule), ctx, task)
+}
+
+// MockReminderCreator is a mock of ReminderCreator interface.
+type MockReminderCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderCreatorMockRecorder
+}
+
+// MockReminderCreatorMockRecorder is the mock recorder for MockReminderCreator.
+type MockReminderCreatorMockRecorder struct {
+	mock *MockReminderCreator
+}
+
+// NewMockReminderCreator creates a new mock instance.
+func NewMockReminderCreator(ctrl *gomock.Controller) *MockReminderCreator {
+	mock := &MockReminderCreator{ctrl: ctrl}
+	mock.recorder = &MockReminderCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderCreator) EXPECT() *MockReminderCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockReminderCreator) Add(ctx context.Context, reminders []Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, reminders)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockReminderCreatorMockRecorder) Add(ctx, reminders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockReminderCreator)(nil).Add), ctx, reminders)
+}
+
+// MockReminderRemover is a mock of ReminderRemover interface.
+type MockReminderRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRemoverMockRecorder
+}
+
+// MockReminderRemoverMockRecorder is the mock recorder for MockReminderRemover.
+type MockReminderRemoverMockRecorder struct {
+	mock *MockReminderRemover
+}
+
+// NewMockReminderRemover creates a new mock instance.
+func NewMockReminderRemover(ctrl *gomock.Controller) *MockReminderRemover {
+	mock := &MockReminderRemover{ctrl: ctrl}
+	mock.recorder = &MockReminderRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRemover) EXPECT() *MockReminderRemoverMockRecorder {
+	return m.recorder
+}
+
+// RemovePendingByTaskID mocks base method.
+func (m *MockReminderRemover) RemovePendingByTaskID(ctx context.Context, taskID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePendingByTaskID", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePendingByTaskID indicates an expected call of RemovePendingByTaskID.
+func (mr *MockReminderRemoverMockRecorder) RemovePendingByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePendingByTaskID", reflect.TypeOf((*MockReminderRemover)(nil).RemovePendingByTaskID), ctx, taskID)
+}
+
+// MockReminderClaimer is a mock of ReminderClaimer interface.
+type MockReminderClaimer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderClaimerMockRecorder
+}
+
+// MockReminderClaimerMockRecorder is the mock recorder for MockReminderClaimer.
+type MockReminderClaimerMockRecorder struct {
+	mock *MockReminderClaimer
+}
+
+// NewMockReminderClaimer creates a new mock instance.
+func NewMockReminderClaimer(ctrl *gomock.Controller) *MockReminderClaimer {
+	mock := &MockReminderClaimer{ctrl: ctrl}
+	mock.recorder = &MockReminderClaimerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderClaimer) EXPECT() *MockReminderClaimerMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockReminderClaimer) Claim(ctx context.Context, owner string, now time.Time, limit int) ([]Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, owner, now, limit)
+	ret0, _ := ret[0].([]Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockReminderClaimerMockRecorder) Claim(ctx, owner, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockReminderClaimer)(nil).Claim), ctx, owner, now, limit)
+}
+
+// MarkSent mocks base method.
+func (m *MockReminderClaimer) MarkSent(ctx context.Context, id int64, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", ctx, id, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockReminderClaimerMockRecorder) MarkSent(ctx, id, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockReminderClaimer)(nil).MarkSent), ctx, id, owner)
+}
+
+// Release mocks base method.
+func (m *MockReminderClaimer) Release(ctx context.Context, id int64, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockReminderClaimerMockRecorder) Release(ctx, id, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockReminderClaimer)(nil).Release), ctx, id, owner)
+}
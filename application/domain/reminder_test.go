@@ -0,0 +1,64 @@
+//go:build unit
+
+package domain
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewReminders(t *testing.T) {
+	var (
+		now     = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		date    = now.Add(48 * time.Hour)
+		soon    = now.Add(30 * time.Minute)
+		offsets = []time.Duration{24 * time.Hour, time.Hour}
+	)
+
+	type args struct {
+		task Task
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Reminder
+	}{
+		{
+			name: "Expect no reminders when task has no date",
+			args: args{
+				task: Task{ID: 1, UserID: 2},
+			},
+			want: nil,
+		},
+		{
+			name: "Expect no reminders when task has no ID",
+			args: args{
+				task: Task{Date: &date, UserID: 2},
+			},
+			want: nil,
+		},
+		{
+			name: "Expect reminders in the past to be skipped",
+			args: args{
+				task: Task{ID: 1, Date: &soon, UserID: 2},
+			},
+			want: nil,
+		},
+		{
+			name: "Expect one reminder per offset",
+			args: args{
+				task: Task{ID: 1, Date: &date, UserID: 2},
+			},
+			want: []Reminder{
+				{TaskID: 1, UserID: 2, Offset: 24 * time.Hour, RemindAt: date.Add(-24 * time.Hour), TaskDate: date},
+				{TaskID: 1, UserID: 2, Offset: time.Hour, RemindAt: date.Add(-time.Hour), TaskDate: date},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewReminders(tt.args.task, offsets, now))
+		})
+	}
+}
@@ -0,0 +1,207 @@
+//go:generate mockgen -source=signature.go -destination=signature_mock.go -package=domain
+
+package domain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSignature  = errors.New("signature fields are invalid")
+	ErrSignatureNotFound = errors.New("signature not found")
+	ErrTaskAlreadySigned = errors.New("task is already signed")
+	pngMagic             = []byte("\x89PNG\x0D\x0A\x1A\x0A")
+)
+
+const (
+	MaxSignatureSize = 512 * 1024
+
+	SignatureFormatPNG     = "png"
+	SignatureFormatSVG     = "svg"
+	SignatureFormatStrokes = "strokes"
+)
+
+type SignatureUsecase interface {
+	Sign(ctx context.Context, signature Signature, user User) (Signature, error)
+	Get(ctx context.Context, taskID int64, user User) (Signature, error)
+	Verify(ctx context.Context, taskID int64, user User) (SignatureVerification, error)
+}
+
+type SignatureCreator interface {
+	Add(ctx context.Context, signature Signature) (int64, error)
+}
+
+type SignatureRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64) (Signature, error)
+}
+
+type Signature struct {
+	ID          int64
+	TaskID      int64
+	SignerName  string
+	Format      string
+	Content     []byte
+	ContentHash string
+	TaskHash    string
+	SignedBy    int64
+	SignedAt    time.Time
+}
+
+type SignatureVerification struct {
+	Signature   Signature
+	CurrentHash string
+	Valid       bool
+}
+
+type signaturePoint struct {
+	X *float64 `json:"x"`
+	Y *float64 `json:"y"`
+}
+
+type signedTask struct {
+	ID          int64  `json:"id"`
+	Summary     string `json:"summary"`
+	Date        string `json:"date"`
+	UserID      int64  `json:"user_id"`
+	SiteID      int64  `json:"site_id"`
+	Type        string `json:"type"`
+	Priority    string `json:"priority"`
+	Status      string `json:"status"`
+	DueAt       string `json:"due_at"`
+	CreatedAt   string `json:"created_at"`
+	Duration    int64  `json:"duration_seconds"`
+	WindowStart string `json:"window_start"`
+	WindowEnd   string `json:"window_end"`
+	SignerName  string `json:"signer_name"`
+	ContentHash string `json:"content_hash"`
+	SignedBy    int64  `json:"signed_by"`
+	SignedAt    string `json:"signed_at"`
+}
+
+func NewSignature(taskID int64, signerName, format string, content []byte) (Signature, error) {
+	var err []string
+
+	signerName = strings.TrimSpace(signerName)
+
+	if taskID == 0 {
+		err = append(err, "task ID must not be 0")
+	}
+
+	if signerName == "" {
+		err = append(err, "signer name must not be empty")
+	}
+
+	if len(signerName) > 255 {
+		err = append(err, "signer name size is too big")
+	}
+
+	if len(content) == 0 {
+		err = append(err, "signature must not be empty")
+	}
+
+	if len(content) > MaxSignatureSize {
+		err = append(err, fmt.Sprintf("signature must not exceed %d bytes", MaxSignatureSize))
+	}
+
+	if len(content) > 0 {
+		if e := validateSignatureContent(format, content); e != "" {
+			err = append(err, e)
+		}
+	}
+
+	if len(err) > 0 {
+		return Signature{}, fmt.Errorf("%w: %s", ErrInvalidSignature, strings.Join(err, "; "))
+	}
+
+	return Signature{TaskID: taskID, SignerName: signerName, Format: format, Content: content, ContentHash: hashOf(content)}, nil
+}
+
+func validateSignatureContent(format string, content []byte) string {
+	switch format {
+	case SignatureFormatPNG:
+		if !bytes.HasPrefix(content, pngMagic) {
+			return "png signature is not a PNG image"
+		}
+	case SignatureFormatSVG:
+		if !bytes.Contains(bytes.ToLower(content), []byte("<svg")) {
+			return "svg signature is not an SVG document"
+		}
+	case SignatureFormatStrokes:
+		var strokes [][]signaturePoint
+		if err := json.Unmarshal(content, &strokes); err != nil || len(strokes) == 0 {
+			return "strokes must be a non-empty list of point lists"
+		}
+
+		for _, stroke := range strokes {
+			if len(stroke) == 0 {
+				return "strokes must not be empty"
+			}
+
+			for _, p := range stroke {
+				if p.X == nil || p.Y == nil {
+					return "stroke points must have x and y"
+				}
+			}
+		}
+	default:
+		return fmt.Sprintf("format %q is not supported", format)
+	}
+
+	return ""
+}
+
+func (s Signature) Digest(task Task) string {
+	payload, _ := json.Marshal(signedTask{
+		ID:          task.ID,
+		Summary:     task.Summary,
+		Date:        formatSigned(task.Date),
+		UserID:      task.UserID,
+		SiteID:      task.SiteID,
+		Type:        task.Type,
+		Priority:    task.Priority,
+		Status:      task.Status,
+		DueAt:       formatSigned(task.DueAt),
+		CreatedAt:   formatSigned(&task.CreatedAt),
+		Duration:    int64(task.Duration / time.Second),
+		WindowStart: formatSigned(task.WindowStart),
+		WindowEnd:   formatSigned(task.WindowEnd),
+		SignerName:  s.SignerName,
+		ContentHash: s.ContentHash,
+		SignedBy:    s.SignedBy,
+		SignedAt:    formatSigned(&s.SignedAt),
+	})
+
+	return hashOf(payload)
+}
+
+func (s Signature) Verify(task Task) SignatureVerification {
+	current := s.Digest(task)
+
+	return SignatureVerification{
+		Signature:   s,
+		CurrentHash: current,
+		Valid:       current == s.TaskHash && hashOf(s.Content) == s.ContentHash,
+	}
+}
+
+func formatSigned(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(DateTimeLayout)
+}
+
+func hashOf(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
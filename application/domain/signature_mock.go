@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: signature.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSignatureUsecase is a mock of SignatureUsecase interface.
+type MockSignatureUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureUsecaseMockRecorder
+}
+
+// MockSignatureUsecaseMockRecorder is the mock recorder for MockSignatureUsecase.
+type MockSignatureUsecaseMockRecorder struct {
+	mock *MockSignatureUsecase
+}
+
+// NewMockSignatureUsecase creates a new mock instance.
+func NewMockSignatureUsecase(ctrl *gomock.Controller) *MockSignatureUsecase {
+	mock := &MockSignatureUsecase{ctrl: ctrl}
+	mock.recorder = &MockSignatureUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureUsecase) EXPECT() *MockSignatureUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockSignatureUsecase) Get(ctx context.Context, taskID int64, user User) (Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, taskID, user)
+	ret0, _ := ret[0].(Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSignatureUsecaseMockRecorder) Get(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSignatureUsecase)(nil).Get), ctx, taskID, user)
+}
+
+// Sign mocks base method.
+func (m *MockSignatureUsecase) Sign(ctx context.Context, signature Signature, user User) (Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, signature, user)
+	ret0, _ := ret[0].(Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockSignatureUsecaseMockRecorder) Sign(ctx, signature, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockSignatureUsecase)(nil).Sign), ctx, signature, user)
+}
+
+// Verify mocks base method.
+func (m *MockSignatureUsecase) Verify(ctx context.Context, taskID int64, user User) (SignatureVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, taskID, user)
+	ret0, _ := ret[0].(SignatureVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockSignatureUsecaseMockRecorder) Verify(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockSignatureUsecase)(nil).Verify), ctx, taskID, user)
+}
+
+// MockSignatureCreator is a mock of SignatureCreator interface.
+type MockSignatureCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureCreatorMockRecorder
+}
+
+// MockSignatureCreatorMockRecorder is the mock recorder for MockSignatureCreator.
+type MockSignatureCreatorMockRecorder struct {
+	mock *MockSignatureCreator
+}
+
+// NewMockSignatureCreator creates a new mock instance.
+func NewMockSignatureCreator(ctrl *gomock.Controller) *MockSignatureCreator {
+	mock := &MockSignatureCreator{ctrl: ctrl}
+	mock.recorder = &MockSignatureCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureCreator) EXPECT() *MockSignatureCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockSignatureCreator) Add(ctx context.Context, signature Signature) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, signature)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSignatureCreatorMockRecorder) Add(ctx, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSignatureCreator)(nil).Add), ctx, signature)
+}
+
+// MockSignatureRetriever is a mock of SignatureRetriever interface.
+type MockSignatureRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureRetrieverMockRecorder
+}
+
+// MockSignatureRetrieverMockRecorder is the mock recorder for MockSignatureRetriever.
+type MockSignatureRetrieverMockRecorder struct {
+	mock *MockSignatureRetriever
+}
+
+// NewMockSignatureRetriever creates a new mock instance.
+func NewMockSignatureRetriever(ctrl *gomock.Controller) *MockSignatureRetriever {
+	mock := &MockSignatureRetriever{ctrl: ctrl}
+	mock.recorder = &MockSignatureRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureRetriever) EXPECT() *MockSignatureRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByTaskID mocks base method.
+func (m *MockSignatureRetriever) ListByTaskID(ctx context.Context, taskID int64) (Signature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].(Signature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockSignatureRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockSignatureRetriever)(nil).ListByTaskID), ctx, taskID)
+}
@@ -0,0 +1,175 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSignature(t *testing.T) {
+	type args struct {
+		taskID     int64
+		signerName string
+		format     string
+		content    []byte
+	}
+
+	png := []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><path d="M0 0L10 10"/></svg>`)
+	strokes := []byte(`[[{"x":1,"y":2},{"x":3,"y":4}]]`)
+
+	tests := []struct {
+		name    string
+		args    args
+		want    Signature
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when task is missing",
+			args:    args{signerName: "Jane Doe", format: SignatureFormatPNG, content: png},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when signer name is blank",
+			args:    args{taskID: 1, signerName: "  ", format: SignatureFormatPNG, content: png},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when signer name is too big",
+			args:    args{taskID: 1, signerName: strings.Repeat("a", 256), format: SignatureFormatPNG, content: png},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when signature is empty",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatPNG},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when signature is too big",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatSVG, content: append(svg, make([]byte, MaxSignatureSize)...)},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when format is not supported",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: "gif", content: png},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when png content is not a PNG image",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatPNG, content: svg},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when svg content is not an SVG document",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatSVG, content: []byte("hello")},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when strokes are empty",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatStrokes, content: []byte(`[[]]`)},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when stroke points miss coordinates",
+			args:    args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatStrokes, content: []byte(`[[{"x":1}]]`)},
+			wantErr: true,
+		},
+		{
+			name: "Expect success with strokes",
+			args: args{taskID: 1, signerName: " Jane Doe ", format: SignatureFormatStrokes, content: strokes},
+			want: Signature{TaskID: 1, SignerName: "Jane Doe", Format: SignatureFormatStrokes, Content: strokes, ContentHash: hashOf(strokes)},
+		},
+		{
+			name: "Expect success with png",
+			args: args{taskID: 1, signerName: "Jane Doe", format: SignatureFormatPNG, content: png},
+			want: Signature{TaskID: 1, SignerName: "Jane Doe", Format: SignatureFormatPNG, Content: png, ContentHash: hashOf(png)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSignature(tt.args.taskID, tt.args.signerName, tt.args.format, tt.args.content)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidSignature))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSignature_Verify(t *testing.T) {
+	date := time.Date(2023, 12, 2, 9, 0, 0, 0, time.UTC)
+	signed := Task{ID: 1, Summary: "Replace the breaker", Date: &date, UserID: 2, Type: DefaultTaskType, Priority: TaskPriorityP2, Status: TaskStatusCompleted, Duration: time.Hour}
+
+	signature, err := NewSignature(1, "Jane Doe", SignatureFormatStrokes, []byte(`[[{"x":1,"y":2}]]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature.SignedBy = 2
+	signature.SignedAt = time.Date(2023, 12, 2, 10, 0, 0, 0, time.UTC)
+	signature.TaskHash = signature.Digest(signed)
+
+	local := date.In(time.FixedZone("BRT", -3*60*60))
+
+	tests := []struct {
+		name      string
+		task      func() Task
+		signature func() Signature
+		want      bool
+	}{
+		{
+			name:      "Expect valid when nothing changed",
+			task:      func() Task { return signed },
+			signature: func() Signature { return signature },
+			want:      true,
+		},
+		{
+			name:      "Expect valid when dates come back in another location",
+			task:      func() Task { t := signed; t.Date = &local; return t },
+			signature: func() Signature { return signature },
+			want:      true,
+		},
+		{
+			name:      "Expect invalid when summary changed",
+			task:      func() Task { t := signed; t.Summary = "Replace the fuse"; return t },
+			signature: func() Signature { return signature },
+			want:      false,
+		},
+		{
+			name:      "Expect invalid when status changed",
+			task:      func() Task { t := signed; t.Status = TaskStatusInProgress; return t },
+			signature: func() Signature { return signature },
+			want:      false,
+		},
+		{
+			name:      "Expect invalid when signer changed",
+			task:      func() Task { return signed },
+			signature: func() Signature { s := signature; s.SignerName = "John Doe"; return s },
+			want:      false,
+		},
+		{
+			name:      "Expect invalid when signature content changed",
+			task:      func() Task { return signed },
+			signature: func() Signature { s := signature; s.Content = []byte(`[[{"x":9,"y":9}]]`); return s },
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.signature().Verify(tt.task())
+
+			assert.Equal(t, tt.want, got.Valid)
+			assert.Len(t, got.CurrentHash, 64)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+//go:generate mockgen -source=sla.go -destination=sla_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrSLAPolicyNotFound = errors.New("sla policy not found")
+
+const (
+	EventTaskOverdue = "task.overdue"
+
+	SLAOverdue = "overdue"
+	SLAAtRisk  = "at_risk"
+)
+
+type OverdueUsecase interface {
+	Detect(ctx context.Context) (int, error)
+}
+
+type SLAPolicyRetriever interface {
+	// ListByTaskTypeAndPriority returns the most specific policy for the task
+	// type and priority: one set for both, then for the priority only, then for
+	// the type only.
+	ListByTaskTypeAndPriority(ctx context.Context, taskType, priority string) (SLAPolicy, error)
+}
+
+type TaskOverdueFlagger interface {
+	ListOverdue(ctx context.Context, now time.Time) ([]Task, error)
+	FlagOverdue(ctx context.Context, id int64, now time.Time) (bool, error)
+}
+
+// SLAPolicy applies to tasks of TaskType and Priority, where an empty value
+// matches any.
+type SLAPolicy struct {
+	TaskType        string
+	Priority        string
+	ResolutionTime  time.Duration
+	AtRiskThreshold time.Duration
+}
+
+func (p *SLAPolicy) Deadline(createdAt time.Time, date *time.Time) time.Time {
+	if date != nil {
+		return *date
+	}
+
+	return createdAt.Add(p.ResolutionTime)
+}
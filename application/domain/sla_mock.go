@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sla.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOverdueUsecase is a mock of OverdueUsecase interface.
+type MockOverdueUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOverdueUsecaseMockRecorder
+}
+
+// MockOverdueUsecaseMockRecorder is the mock recorder for MockOverdueUsecase.
+type MockOverdueUsecaseMockRecorder struct {
+	mock *MockOverdueUsecase
+}
+
+// NewMockOverdueUsecase creates a new mock instance.
+func NewMockOverdueUsecase(ctrl *gomock.Controller) *MockOverdueUsecase {
+	mock := &MockOverdueUsecase{ctrl: ctrl}
+	mock.recorder = &MockOverdueUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOverdueUsecase) EXPECT() *MockOverdueUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Detect mocks base method.
+func (m *MockOverdueUsecase) Detect(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detect", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detect indicates an expected call of Detect.
+func (mr *MockOverdueUsecaseMockRecorder) Detect(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockOverdueUsecase)(nil).Detect), ctx)
+}
+
+// MockSLAPolicyRetriever is a mock of SLAPolicyRetriever interface.
+type MockSLAPolicyRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockSLAPolicyRetrieverMockRecorder
+}
+
+// MockSLAPolicyRetrieverMockRecorder is the mock recorder for MockSLAPolicyRetriever.
+type MockSLAPolicyRetrieverMockRecorder struct {
+	mock *MockSLAPolicyRetriever
+}
+
+// NewMockSLAPolicyRetriever creates a new mock instance.
+func NewMockSLAPolicyRetriever(ctrl *gomock.Controller) *MockSLAPolicyRetriever {
+	mock := &MockSLAPolicyRetriever{ctrl: ctrl}
+	mock.recorder = &MockSLAPolicyRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSLAPolicyRetriever) EXPECT() *MockSLAPolicyRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByTaskTypeAndPriority mocks base method.
+func (m *MockSLAPolicyRetriever) ListByTaskTypeAndPriority(ctx context.Context, taskType, priority string) (SLAPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskTypeAndPriority", ctx, taskType, priority)
+	ret0, _ := ret[0].(SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskTypeAndPriority indicates an expected call of ListByTaskTypeAndPriority.
+func (mr *MockSLAPolicyRetrieverMockRecorder) ListByTaskTypeAndPriority(ctx, taskType, priority interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskTypeAndPriority", reflect.TypeOf((*MockSLAPolicyRetriever)(nil).ListByTaskTypeAndPriority), ctx, taskType, priority)
+}
+
+// MockTaskOverdueFlagger is a mock of TaskOverdueFlagger interface.
+type MockTaskOverdueFlagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskOverdueFlaggerMockRecorder
+}
+
+// MockTaskOverdueFlaggerMockRecorder is the mock recorder for MockTaskOverdueFlagger.
+type MockTaskOverdueFlaggerMockRecorder struct {
+	mock *MockTaskOverdueFlagger
+}
+
+// NewMockTaskOverdueFlagger creates a new mock instance.
+func NewMockTaskOverdueFlagger(ctrl *gomock.Controller) *MockTaskOverdueFlagger {
+	mock := &MockTaskOverdueFlagger{ctrl: ctrl}
+	mock.recorder = &MockTaskOverdueFlaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskOverdueFlagger) EXPECT() *MockTaskOverdueFlaggerMockRecorder {
+	return m.recorder
+}
+
+// FlagOverdue mocks base method.
+func (m *MockTaskOverdueFlagger) FlagOverdue(ctx context.Context, id int64, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagOverdue", ctx, id, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlagOverdue indicates an expected call of FlagOverdue.
+func (mr *MockTaskOverdueFlaggerMockRecorder) FlagOverdue(ctx, id, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagOverdue", reflect.TypeOf((*MockTaskOverdueFlagger)(nil).FlagOverdue), ctx, id, now)
+}
+
+// ListOverdue mocks base method.
+func (m *MockTaskOverdueFlagger) ListOverdue(ctx context.Context, now time.Time) ([]Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx, now)
+	ret0, _ := ret[0].([]Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockTaskOverdueFlaggerMockRecorder) ListOverdue(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockTaskOverdueFlagger)(nil).ListOverdue), ctx, now)
+}
@@ -11,16 +11,67 @@ import (
 )
 
 var (
-	ErrInvalidTask   = errors.New("task fields are invalid")
-	ErrTasksNotFound = errors.New("tasks not found")
-	TaskDateLayout   = "01/02/2006 15:04"
+	ErrInvalidTask           = errors.New("task fields are invalid")
+	ErrTasksNotFound         = errors.New("tasks not found")
+	ErrInvalidTaskTransition = errors.New("task status transition is not allowed")
+	ErrTaskConflict          = errors.New("task overlaps another booking of the technician")
+	ErrTaskVersionConflict   = errors.New("task was changed by another request")
+	ErrInvalidTaskBatch      = errors.New("task batch is invalid")
+	ErrTaskBatchAborted      = errors.New("task batch was rolled back")
+	ErrInvalidTaskImport     = errors.New("task import is invalid")
+	TaskDateLayout           = "01/02/2006 15:04"
+	DateTimeLayout           = time.RFC3339
+)
+
+const (
+	DefaultTaskType = "standard"
+
+	TaskPriorityP1      = "P1"
+	TaskPriorityP2      = "P2"
+	TaskPriorityP3      = "P3"
+	TaskPriorityP4      = "P4"
+	DefaultTaskPriority = TaskPriorityP3
+
+	TaskSortPriority = "priority"
+	TaskSortDueAt    = "due_at"
+	TaskSortDate     = "date"
+
+	TaskStatusOpen       = "open"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusCompleted  = "completed"
+
+	InitialTaskVersion = 1
+
+	TaskOperationCreate = "create"
+	TaskOperationUpdate = "update"
+	TaskOperationDelete = "delete"
+
+	MaxTaskBatchSize = 100
+
+	MaxTaskImportRows = 5000
+
+	TaskChangeCreated = "created"
+	TaskChangeUpdated = "updated"
+	TaskChangeDeleted = "deleted"
+
+	MaxTaskChanges = 500
+
+	// MinBookingDuration is how long a task without a duration keeps its
+	// technician busy, so two tasks booked at the same time still conflict.
+	MinBookingDuration = time.Minute
 )
 
 type TaskUsecase interface {
 	Add(ctx context.Context, task Task, user User) (Task, error)
-	ListByUser(ctx context.Context, user User) ([]Task, error)
+	ListByUser(ctx context.Context, user User, filter TaskFilter) ([]Task, error)
+	Get(ctx context.Context, id int64, user User) (Task, error)
+	Next(ctx context.Context, user User) (Task, error)
 	Update(ctx context.Context, task Task, user User) (Task, error)
-	Remove(ctx context.Context, id int64, user User) error
+	Remove(ctx context.Context, id, version int64, user User) error
+	Batch(ctx context.Context, operations []TaskOperation, atomic bool, user User) ([]TaskOperationResult, error)
+	Export(ctx context.Context, user User, filter TaskFilter, fn func(task Task) error) error
+	Import(ctx context.Context, rows []TaskImportRow, dryRun bool, user User) ([]TaskImportResult, error)
+	Changes(ctx context.Context, user User, cursor TaskCursor) ([]TaskChange, TaskCursor, error)
 }
 
 type TaskCreator interface {
@@ -28,9 +79,21 @@ type TaskCreator interface {
 }
 
 type TaskRetriever interface {
-	List(ctx context.Context) ([]Task, error)
+	List(ctx context.Context, filter TaskFilter) ([]Task, error)
+	ListByID(ctx context.Context, id int64) (Task, error)
 	ListByIDAndUserID(ctx context.Context, id, userID int64) (Task, error)
-	ListByUserID(ctx context.Context, userID int64) ([]Task, error)
+	// LockByIDAndUserID is ListByIDAndUserID holding the task row until the
+	// transaction carried by ctx ends.
+	LockByIDAndUserID(ctx context.Context, id, userID int64) (Task, error)
+	ListByUserID(ctx context.Context, userID int64, filter TaskFilter) ([]Task, error)
+	ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]Task, error)
+	ListNextByUserID(ctx context.Context, userID int64) (Task, error)
+	// Stream calls fn for each task matching filter as rows are read, stopping
+	// at the first error fn returns.
+	Stream(ctx context.Context, filter TaskFilter, fn func(task Task) error) error
+	// ListChanged returns up to limit tasks changed after cursor and before
+	// until, oldest change first. A userID of 0 matches every user.
+	ListChanged(ctx context.Context, userID int64, cursor TaskCursor, until time.Time, limit int) ([]TaskChange, error)
 }
 
 type TaskUpdater interface {
@@ -38,7 +101,13 @@ type TaskUpdater interface {
 }
 
 type TaskRemover interface {
-	Remove(ctx context.Context, id int64) error
+	Remove(ctx context.Context, id, version int64) error
+}
+
+// TaskTransactor runs fn in a single transaction carried by the context it is
+// given, committing when fn returns nil and rolling back otherwise.
+type TaskTransactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type TaskNotifier interface {
@@ -51,15 +120,143 @@ type SummaryEncryptor interface {
 }
 
 type Task struct {
-	ID      int64
-	Summary string
-	Date    *time.Time
-	UserID  int64
+	ID           int64
+	Summary      string
+	Date         *time.Time
+	UserID       int64
+	Type         string
+	Priority     string
+	Status       string
+	DueAt        *time.Time
+	OverdueAt    *time.Time
+	CreatedAt    time.Time
+	SeriesID     int64
+	OccurrenceAt *time.Time
+	SiteID       int64
+	Duration     time.Duration
+	WindowStart  *time.Time
+	WindowEnd    *time.Time
+	Version      int64
+}
+
+// TaskOperation is one item of a batch. Delete operations only use the ID and
+// Version of Task.
+type TaskOperation struct {
+	Method string
+	Task   Task
+}
+
+type TaskOperationResult struct {
+	Task Task
+	Err  error
+}
+
+// TaskImportRow is one line of an import file, assigned to the technician with
+// TechnicianEmail. Err is set when the line could not be parsed; such rows are
+// reported and never imported.
+type TaskImportRow struct {
+	Line            int
+	TechnicianEmail string
+	Task            Task
+	Err             error
+}
+
+type TaskImportResult struct {
+	Line int
+	Task Task
+	Err  error
+}
+
+// TaskCursor is a position in the sequence of task changes, which are ordered
+// by ChangedAt and then TaskID.
+type TaskCursor struct {
+	ChangedAt time.Time
+	TaskID    int64
+}
+
+type TaskChange struct {
+	Type      string
+	Task      Task
+	ChangedAt time.Time
+}
+
+func (c TaskChange) Cursor() TaskCursor {
+	return TaskCursor{ChangedAt: c.ChangedAt, TaskID: c.Task.ID}
+}
+
+func TaskChangeType(task Task, deleted bool) string {
+	switch {
+	case deleted:
+		return TaskChangeDeleted
+	case task.Version <= InitialTaskVersion:
+		return TaskChangeCreated
+	}
+
+	return TaskChangeUpdated
+}
+
+type TaskConflictError struct {
+	TaskIDs []int64
+}
+
+func (e *TaskConflictError) Error() string {
+	ids := make([]string, len(e.TaskIDs))
+	for i, id := range e.TaskIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+
+	return fmt.Sprintf("%v: %s", ErrTaskConflict, strings.Join(ids, ", "))
+}
+
+func (e *TaskConflictError) Unwrap() error {
+	return ErrTaskConflict
+}
+
+// TaskVersionError reports that a task was changed since the version the
+// caller last read. Expected is the version the caller sent, Current the one
+// stored.
+type TaskVersionError struct {
+	TaskID   int64
+	Expected int64
+	Current  int64
+}
+
+func (e *TaskVersionError) Error() string {
+	return fmt.Sprintf("%v: task %d is at version %d, not %d", ErrTaskVersionConflict, e.TaskID, e.Current, e.Expected)
+}
+
+func (e *TaskVersionError) Unwrap() error {
+	return ErrTaskVersionConflict
+}
+
+type TaskFilter struct {
+	SLA        string
+	Priority   string
+	Sort       string
+	CustomerID int64
+	SiteID     int64
+	Now        time.Time
 }
 
-func NewTask(summary string, date *time.Time, userID int64) (Task, error) {
+func NewTask(summary string, date *time.Time, userID int64, taskType, priority string) (Task, error) {
 	var err []string
 
+	if taskType == "" {
+		taskType = DefaultTaskType
+	}
+
+	if priority == "" {
+		priority = DefaultTaskPriority
+	}
+
+	if !IsValidTaskPriority(priority) {
+		err = append(err, "priority must be one of P1, P2, P3, P4")
+	}
+
+	if len(taskType) > 64 {
+		err = append(err, "type size is too big")
+	}
+
 	if summary == "" {
 		err = append(err, "summary must not be empty")
 	}
@@ -73,8 +270,96 @@ func NewTask(summary string, date *time.Time, userID int64) (Task, error) {
 	}
 
 	if len(err) > 0 {
-		return Task{}, fmt.Errorf("%v: %s", ErrInvalidTask, strings.Join(err, "; "))
+		return Task{}, fmt.Errorf("%w: %s", ErrInvalidTask, strings.Join(err, "; "))
+	}
+
+	return Task{Summary: summary, Date: date, UserID: userID, Type: taskType, Priority: priority, Status: TaskStatusOpen}, nil
+}
+
+func IsValidTaskPriority(priority string) bool {
+	switch priority {
+	case TaskPriorityP1, TaskPriorityP2, TaskPriorityP3, TaskPriorityP4:
+		return true
+	}
+
+	return false
+}
+
+func (t *Task) TransitionTo(status string) error {
+	if t.Status == status {
+		return nil
+	}
+
+	allowed := map[string][]string{
+		TaskStatusOpen:       {TaskStatusInProgress, TaskStatusCompleted},
+		TaskStatusInProgress: {TaskStatusOpen, TaskStatusCompleted},
+	}
+
+	for _, s := range allowed[t.Status] {
+		if s == status {
+			t.Status = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s to %s", ErrInvalidTaskTransition, t.Status, status)
+}
+
+func (t *Task) End() *time.Time {
+	if t.Date == nil {
+		return nil
+	}
+
+	end := t.Date.Add(t.Duration)
+
+	return &end
+}
+
+// BookingEnd is when the task stops keeping its technician busy. It is at
+// least MinBookingDuration after the task starts.
+func (t *Task) BookingEnd() *time.Time {
+	if t.Date == nil {
+		return nil
+	}
+
+	duration := t.Duration
+	if duration < MinBookingDuration {
+		duration = MinBookingDuration
+	}
+
+	end := t.Date.Add(duration)
+
+	return &end
+}
+
+func (t *Task) ValidateSchedule() error {
+	var err []string
+
+	if t.Duration < 0 {
+		err = append(err, "duration must not be negative")
+	}
+
+	if (t.WindowStart == nil) != (t.WindowEnd == nil) {
+		err = append(err, "time window must have both start and end")
+	}
+
+	if t.WindowStart != nil && t.WindowEnd != nil {
+		if !t.WindowEnd.After(*t.WindowStart) {
+			err = append(err, "time window end must be after its start")
+		}
+
+		if t.Date != nil && (t.Date.Before(*t.WindowStart) || t.End().After(*t.WindowEnd)) {
+			err = append(err, "task must fit in the time window")
+		}
+	}
+
+	if len(err) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidTask, strings.Join(err, "; "))
 	}
 
-	return Task{Summary: summary, Date: date, UserID: userID}, nil
+	return nil
+}
+
+func (t *Task) IsOverdue(now time.Time) bool {
+	return t.Status != TaskStatusCompleted && t.DueAt != nil && t.DueAt.Before(now)
 }
@@ -7,6 +7,7 @@ package domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -49,33 +50,123 @@ func (mr *MockTaskUsecaseMockRecorder) Add(ctx, task, user interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTaskUsecase)(nil).Add), ctx, task, user)
 }
 
+// Batch mocks base method.
+func (m *MockTaskUsecase) Batch(ctx context.Context, operations []TaskOperation, atomic bool, user User) ([]TaskOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Batch", ctx, operations, atomic, user)
+	ret0, _ := ret[0].([]TaskOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Batch indicates an expected call of Batch.
+func (mr *MockTaskUsecaseMockRecorder) Batch(ctx, operations, atomic, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Batch", reflect.TypeOf((*MockTaskUsecase)(nil).Batch), ctx, operations, atomic, user)
+}
+
+// Changes mocks base method.
+func (m *MockTaskUsecase) Changes(ctx context.Context, user User, cursor TaskCursor) ([]TaskChange, TaskCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Changes", ctx, user, cursor)
+	ret0, _ := ret[0].([]TaskChange)
+	ret1, _ := ret[1].(TaskCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Changes indicates an expected call of Changes.
+func (mr *MockTaskUsecaseMockRecorder) Changes(ctx, user, cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changes", reflect.TypeOf((*MockTaskUsecase)(nil).Changes), ctx, user, cursor)
+}
+
+// Export mocks base method.
+func (m *MockTaskUsecase) Export(ctx context.Context, user User, filter TaskFilter, fn func(Task) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, user, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTaskUsecaseMockRecorder) Export(ctx, user, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTaskUsecase)(nil).Export), ctx, user, filter, fn)
+}
+
+// Get mocks base method.
+func (m *MockTaskUsecase) Get(ctx context.Context, id int64, user User) (Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, user)
+	ret0, _ := ret[0].(Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaskUsecaseMockRecorder) Get(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaskUsecase)(nil).Get), ctx, id, user)
+}
+
+// Import mocks base method.
+func (m *MockTaskUsecase) Import(ctx context.Context, rows []TaskImportRow, dryRun bool, user User) ([]TaskImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, dryRun, user)
+	ret0, _ := ret[0].([]TaskImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTaskUsecaseMockRecorder) Import(ctx, rows, dryRun, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTaskUsecase)(nil).Import), ctx, rows, dryRun, user)
+}
+
 // ListByUser mocks base method.
-func (m *MockTaskUsecase) ListByUser(ctx context.Context, user User) ([]Task, error) {
+func (m *MockTaskUsecase) ListByUser(ctx context.Context, user User, filter TaskFilter) ([]Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByUser", ctx, user)
+	ret := m.ctrl.Call(m, "ListByUser", ctx, user, filter)
 	ret0, _ := ret[0].([]Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByUser indicates an expected call of ListByUser.
-func (mr *MockTaskUsecaseMockRecorder) ListByUser(ctx, user interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) ListByUser(ctx, user, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockTaskUsecase)(nil).ListByUser), ctx, user, filter)
+}
+
+// Next mocks base method.
+func (m *MockTaskUsecase) Next(ctx context.Context, user User) (Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, user)
+	ret0, _ := ret[0].(Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockTaskUsecaseMockRecorder) Next(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockTaskUsecase)(nil).ListByUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockTaskUsecase)(nil).Next), ctx, user)
 }
 
 // Remove mocks base method.
-func (m *MockTaskUsecase) Remove(ctx context.Context, id int64, user User) error {
+func (m *MockTaskUsecase) Remove(ctx context.Context, id, version int64, user User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", ctx, id, user)
+	ret := m.ctrl.Call(m, "Remove", ctx, id, version, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockTaskUsecaseMockRecorder) Remove(ctx, id, user interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) Remove(ctx, id, version, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskUsecase)(nil).Remove), ctx, id, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskUsecase)(nil).Remove), ctx, id, version, user)
 }
 
 // Update mocks base method.
@@ -155,18 +246,33 @@ func (m *MockTaskRetriever) EXPECT() *MockTaskRetrieverMockRecorder {
 }
 
 // List mocks base method.
-func (m *MockTaskRetriever) List(ctx context.Context) ([]Task, error) {
+func (m *MockTaskRetriever) List(ctx context.Context, filter TaskFilter) ([]Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockTaskRetrieverMockRecorder) List(ctx interface{}) *gomock.Call {
+func (mr *MockTaskRetrieverMockRecorder) List(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskRetriever)(nil).List), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskRetriever)(nil).List), ctx, filter)
+}
+
+// ListByID mocks base method.
+func (m *MockTaskRetriever) ListByID(ctx context.Context, id int64) (Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockTaskRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockTaskRetriever)(nil).ListByID), ctx, id)
 }
 
 // ListByIDAndUserID mocks base method.
@@ -185,18 +291,92 @@ func (mr *MockTaskRetrieverMockRecorder) ListByIDAndUserID(ctx, id, userID inter
 }
 
 // ListByUserID mocks base method.
-func (m *MockTaskRetriever) ListByUserID(ctx context.Context, userID int64) ([]Task, error) {
+func (m *MockTaskRetriever) ListByUserID(ctx context.Context, userID int64, filter TaskFilter) ([]Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID, filter)
 	ret0, _ := ret[0].([]Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListByUserID indicates an expected call of ListByUserID.
-func (mr *MockTaskRetrieverMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+func (mr *MockTaskRetrieverMockRecorder) ListByUserID(ctx, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockTaskRetriever)(nil).ListByUserID), ctx, userID, filter)
+}
+
+// ListChanged mocks base method.
+func (m *MockTaskRetriever) ListChanged(ctx context.Context, userID int64, cursor TaskCursor, until time.Time, limit int) ([]TaskChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChanged", ctx, userID, cursor, until, limit)
+	ret0, _ := ret[0].([]TaskChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChanged indicates an expected call of ListChanged.
+func (mr *MockTaskRetrieverMockRecorder) ListChanged(ctx, userID, cursor, until, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChanged", reflect.TypeOf((*MockTaskRetriever)(nil).ListChanged), ctx, userID, cursor, until, limit)
+}
+
+// ListNextByUserID mocks base method.
+func (m *MockTaskRetriever) ListNextByUserID(ctx context.Context, userID int64) (Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNextByUserID", ctx, userID)
+	ret0, _ := ret[0].(Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNextByUserID indicates an expected call of ListNextByUserID.
+func (mr *MockTaskRetrieverMockRecorder) ListNextByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNextByUserID", reflect.TypeOf((*MockTaskRetriever)(nil).ListNextByUserID), ctx, userID)
+}
+
+// ListOverlapping mocks base method.
+func (m *MockTaskRetriever) ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlapping", ctx, userID, start, end, excludeID)
+	ret0, _ := ret[0].([]Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlapping indicates an expected call of ListOverlapping.
+func (mr *MockTaskRetrieverMockRecorder) ListOverlapping(ctx, userID, start, end, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlapping", reflect.TypeOf((*MockTaskRetriever)(nil).ListOverlapping), ctx, userID, start, end, excludeID)
+}
+
+// LockByIDAndUserID mocks base method.
+func (m *MockTaskRetriever) LockByIDAndUserID(ctx context.Context, id, userID int64) (Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByIDAndUserID", ctx, id, userID)
+	ret0, _ := ret[0].(Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByIDAndUserID indicates an expected call of LockByIDAndUserID.
+func (mr *MockTaskRetrieverMockRecorder) LockByIDAndUserID(ctx, id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockTaskRetriever)(nil).ListByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByIDAndUserID", reflect.TypeOf((*MockTaskRetriever)(nil).LockByIDAndUserID), ctx, id, userID)
+}
+
+// Stream mocks base method.
+func (m *MockTaskRetriever) Stream(ctx context.Context, filter TaskFilter, fn func(Task) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockTaskRetrieverMockRecorder) Stream(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockTaskRetriever)(nil).Stream), ctx, filter, fn)
 }
 
 // MockTaskUpdater is a mock of TaskUpdater interface.
@@ -260,17 +440,54 @@ func (m *MockTaskRemover) EXPECT() *MockTaskRemoverMockRecorder {
 }
 
 // Remove mocks base method.
-func (m *MockTaskRemover) Remove(ctx context.Context, id int64) error {
+func (m *MockTaskRemover) Remove(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret := m.ctrl.Call(m, "Remove", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Remove indicates an expected call of Remove.
-func (mr *MockTaskRemoverMockRecorder) Remove(ctx, id interface{}) *gomock.Call {
+func (mr *MockTaskRemoverMockRecorder) Remove(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskRemover)(nil).Remove), ctx, id, version)
+}
+
+// MockTaskTransactor is a mock of TaskTransactor interface.
+type MockTaskTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskTransactorMockRecorder
+}
+
+// MockTaskTransactorMockRecorder is the mock recorder for MockTaskTransactor.
+type MockTaskTransactorMockRecorder struct {
+	mock *MockTaskTransactor
+}
+
+// NewMockTaskTransactor creates a new mock instance.
+func NewMockTaskTransactor(ctrl *gomock.Controller) *MockTaskTransactor {
+	mock := &MockTaskTransactor{ctrl: ctrl}
+	mock.recorder = &MockTaskTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskTransactor) EXPECT() *MockTaskTransactorMockRecorder {
+	return m.recorder
+}
+
+// WithinTransaction mocks base method.
+func (m *MockTaskTransactor) WithinTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTransaction indicates an expected call of WithinTransaction.
+func (mr *MockTaskTransactorMockRecorder) WithinTransaction(ctx, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockTaskRemover)(nil).Remove), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTransaction", reflect.TypeOf((*MockTaskTransactor)(nil).WithinTransaction), ctx, fn)
 }
 
 // MockTaskNotifier is a mock of TaskNotifier interface.
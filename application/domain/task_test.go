@@ -11,9 +11,11 @@ import (
 
 func TestNewTask(t *testing.T) {
 	type args struct {
-		summary string
-		date    *time.Time
-		userID  int64
+		summary  string
+		date     *time.Time
+		userID   int64
+		taskType string
+		priority string
 	}
 
 	currentTime := time.Now()
@@ -60,23 +62,67 @@ func TestNewTask(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Expect success",
+			name: "Expect error when type is too big",
+			args: args{
+				summary:  summary,
+				date:     &currentTime,
+				userID:   userID,
+				taskType: strings.Repeat("a", 65),
+			},
+			want:    Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when priority is unknown",
+			args: args{
+				summary:  summary,
+				date:     &currentTime,
+				userID:   userID,
+				priority: "P5",
+			},
+			want:    Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success with default type",
 			args: args{
 				summary: summary,
 				date:    &currentTime,
 				userID:  userID,
 			},
 			want: Task{
-				Summary: summary,
-				Date:    &currentTime,
-				UserID:  userID,
+				Summary:  summary,
+				Date:     &currentTime,
+				UserID:   userID,
+				Type:     DefaultTaskType,
+				Priority: DefaultTaskPriority,
+				Status:   TaskStatusOpen,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				summary:  summary,
+				date:     &currentTime,
+				userID:   userID,
+				taskType: "emergency",
+				priority: TaskPriorityP1,
+			},
+			want: Task{
+				Summary:  summary,
+				Date:     &currentTime,
+				UserID:   userID,
+				Type:     "emergency",
+				Priority: TaskPriorityP1,
+				Status:   TaskStatusOpen,
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewTask(tt.args.summary, tt.args.date, tt.args.userID)
+			got, err := NewTask(tt.args.summary, tt.args.date, tt.args.userID, tt.args.taskType, tt.args.priority)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewTask() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -86,3 +132,231 @@ func TestNewTask(t *testing.T) {
 		})
 	}
 }
+
+func TestTask_TransitionTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Expect open task to start",
+			from:    TaskStatusOpen,
+			to:      TaskStatusInProgress,
+			want:    TaskStatusInProgress,
+			wantErr: false,
+		},
+		{
+			name:    "Expect in progress task to complete",
+			from:    TaskStatusInProgress,
+			to:      TaskStatusCompleted,
+			want:    TaskStatusCompleted,
+			wantErr: false,
+		},
+		{
+			name:    "Expect same status to be accepted",
+			from:    TaskStatusCompleted,
+			to:      TaskStatusCompleted,
+			want:    TaskStatusCompleted,
+			wantErr: false,
+		},
+		{
+			name:    "Expect error when reopening a completed task",
+			from:    TaskStatusCompleted,
+			to:      TaskStatusOpen,
+			want:    TaskStatusCompleted,
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when status is unknown",
+			from:    TaskStatusOpen,
+			to:      "cancelled",
+			want:    TaskStatusOpen,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := Task{Status: tt.from}
+
+			err := task.TransitionTo(tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TransitionTo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, task.Status)
+		})
+	}
+}
+
+func TestTask_IsOverdue(t *testing.T) {
+	var (
+		now    = time.Now()
+		past   = now.Add(-time.Hour)
+		future = now.Add(time.Hour)
+	)
+
+	tests := []struct {
+		name string
+		task Task
+		want bool
+	}{
+		{
+			name: "Expect task without due date not to be overdue",
+			task: Task{Status: TaskStatusOpen},
+			want: false,
+		},
+		{
+			name: "Expect task due in the future not to be overdue",
+			task: Task{Status: TaskStatusOpen, DueAt: &future},
+			want: false,
+		},
+		{
+			name: "Expect completed task not to be overdue",
+			task: Task{Status: TaskStatusCompleted, DueAt: &past},
+			want: false,
+		},
+		{
+			name: "Expect open task past due date to be overdue",
+			task: Task{Status: TaskStatusInProgress, DueAt: &past},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.task.IsOverdue(now))
+		})
+	}
+}
+
+func TestTask_BookingEnd(t *testing.T) {
+	date := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		task Task
+		want *time.Time
+	}{
+		{
+			name: "Expect task without date not to be booked",
+			task: Task{Duration: time.Hour},
+			want: nil,
+		},
+		{
+			name: "Expect task to be booked for its duration",
+			task: Task{Date: &date, Duration: time.Hour},
+			want: func() *time.Time { end := date.Add(time.Hour); return &end }(),
+		},
+		{
+			name: "Expect task without duration to be booked for the minimum",
+			task: Task{Date: &date},
+			want: func() *time.Time { end := date.Add(MinBookingDuration); return &end }(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.task.BookingEnd())
+		})
+	}
+}
+
+func TestTask_ValidateSchedule(t *testing.T) {
+	var (
+		date        = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		windowStart = date.Add(-time.Hour)
+		windowEnd   = date.Add(2 * time.Hour)
+	)
+
+	tests := []struct {
+		name    string
+		task    Task
+		wantErr bool
+	}{
+		{
+			name:    "Expect task without schedule to be valid",
+			task:    Task{},
+			wantErr: false,
+		},
+		{
+			name:    "Expect error when duration is negative",
+			task:    Task{Date: &date, Duration: -time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when window is incomplete",
+			task:    Task{Date: &date, WindowStart: &windowStart},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when window end is before its start",
+			task:    Task{WindowStart: &windowEnd, WindowEnd: &windowStart},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when task ends after the window",
+			task:    Task{Date: &date, Duration: 3 * time.Hour, WindowStart: &windowStart, WindowEnd: &windowEnd},
+			wantErr: true,
+		},
+		{
+			name:    "Expect task inside the window to be valid",
+			task:    Task{Date: &date, Duration: 2 * time.Hour, WindowStart: &windowStart, WindowEnd: &windowEnd},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.task.ValidateSchedule()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskConflictError(t *testing.T) {
+	err := error(&TaskConflictError{TaskIDs: []int64{7, 9}})
+
+	assert.ErrorIs(t, err, ErrTaskConflict)
+	assert.Equal(t, "task overlaps another booking of the technician: 7, 9", err.Error())
+}
+
+func TestTaskVersionError(t *testing.T) {
+	err := error(&TaskVersionError{TaskID: 1, Expected: 3, Current: 4})
+
+	assert.ErrorIs(t, err, ErrTaskVersionConflict)
+	assert.Equal(t, "task was changed by another request: task 1 is at version 4, not 3", err.Error())
+}
+
+func TestTaskChangeType(t *testing.T) {
+	tests := []struct {
+		name    string
+		task    Task
+		deleted bool
+		want    string
+	}{
+		{
+			name: "Expect task at its first version to be created",
+			task: Task{Version: InitialTaskVersion},
+			want: TaskChangeCreated,
+		},
+		{
+			name: "Expect task at a later version to be updated",
+			task: Task{Version: 3},
+			want: TaskChangeUpdated,
+		},
+		{
+			name:    "Expect removed task to be deleted",
+			task:    Task{Version: 2},
+			deleted: true,
+			want:    TaskChangeDeleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TaskChangeType(tt.task, tt.deleted))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+//go:generate mockgen -source=timeentry.go -destination=timeentry_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTimeEntry  = errors.New("time entry fields are invalid")
+	ErrTimeEntryNotFound = errors.New("time entry not found")
+	ErrTimeEntryOverlap  = errors.New("time entry overlaps another entry of the technician")
+	ErrTimerRunning      = errors.New("technician already has a running timer")
+)
+
+const (
+	TimeSummaryByTask = "task"
+	TimeSummaryByUser = "user"
+)
+
+type TimeEntryUsecase interface {
+	Start(ctx context.Context, taskID int64, note string, user User) (TimeEntry, error)
+	Stop(ctx context.Context, taskID int64, user User) (TimeEntry, error)
+	Add(ctx context.Context, entry TimeEntry, user User) (TimeEntry, error)
+	ListByTask(ctx context.Context, taskID int64, user User) ([]TimeEntry, error)
+	Approve(ctx context.Context, id int64, user User) (TimeEntry, error)
+	Summarize(ctx context.Context, filter TimeSummaryFilter, user User) ([]TimeSummary, error)
+}
+
+type TimeEntryCreator interface {
+	Add(ctx context.Context, entry TimeEntry) (int64, error)
+}
+
+type TimeEntryRetriever interface {
+	ListByID(ctx context.Context, id int64) (TimeEntry, error)
+	ListByTaskID(ctx context.Context, taskID int64) ([]TimeEntry, error)
+	ListRunningByUserID(ctx context.Context, userID int64) (TimeEntry, error)
+	ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]TimeEntry, error)
+	Summarize(ctx context.Context, filter TimeSummaryFilter) ([]TimeSummary, error)
+}
+
+type TimeEntryUpdater interface {
+	Update(ctx context.Context, entry TimeEntry) error
+}
+
+type TimeEntry struct {
+	ID         int64
+	TaskID     int64
+	UserID     int64
+	Start      time.Time
+	End        *time.Time
+	Note       string
+	ApprovedBy int64
+	ApprovedAt *time.Time
+}
+
+type TimeSummaryFilter struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+	UserID  int64
+}
+
+type TimeSummary struct {
+	TaskID   int64
+	UserID   int64
+	Total    time.Duration
+	Approved time.Duration
+}
+
+func NewTimeEntry(taskID, userID int64, start, end *time.Time, note string) (TimeEntry, error) {
+	var err []string
+
+	if taskID == 0 {
+		err = append(err, "task ID must not be 0")
+	}
+
+	if userID == 0 {
+		err = append(err, "user ID must not be 0")
+	}
+
+	if start == nil {
+		err = append(err, "start must not be empty")
+	}
+
+	if end == nil {
+		err = append(err, "end must not be empty")
+	}
+
+	if start != nil && end != nil && !end.After(*start) {
+		err = append(err, "end must be after start")
+	}
+
+	if len(note) > 500 {
+		err = append(err, "note size is too big")
+	}
+
+	if len(err) > 0 {
+		return TimeEntry{}, fmt.Errorf("%w: %s", ErrInvalidTimeEntry, strings.Join(err, "; "))
+	}
+
+	return TimeEntry{TaskID: taskID, UserID: userID, Start: start.UTC(), End: utc(end), Note: note}, nil
+}
+
+func NewTimeSummaryFilter(from, to *time.Time, groupBy string) (TimeSummaryFilter, error) {
+	var err []string
+
+	if from == nil || to == nil {
+		err = append(err, "from and to must not be empty")
+	} else if !to.After(*from) {
+		err = append(err, "to must be after from")
+	}
+
+	if groupBy == "" {
+		groupBy = TimeSummaryByTask
+	}
+
+	if groupBy != TimeSummaryByTask && groupBy != TimeSummaryByUser {
+		err = append(err, fmt.Sprintf("group %q is not supported", groupBy))
+	}
+
+	if len(err) > 0 {
+		return TimeSummaryFilter{}, fmt.Errorf("%w: %s", ErrInvalidTimeEntry, strings.Join(err, "; "))
+	}
+
+	return TimeSummaryFilter{From: from.UTC(), To: to.UTC(), GroupBy: groupBy}, nil
+}
+
+func (e *TimeEntry) IsRunning() bool {
+	return e.End == nil
+}
+
+func (e *TimeEntry) Duration() time.Duration {
+	if e.End == nil {
+		return 0
+	}
+
+	return e.End.Sub(e.Start)
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	u := t.UTC()
+
+	return &u
+}
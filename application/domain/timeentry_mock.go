@@ -0,0 +1,299 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: timeentry.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTimeEntryUsecase is a mock of TimeEntryUsecase interface.
+type MockTimeEntryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryUsecaseMockRecorder
+}
+
+// MockTimeEntryUsecaseMockRecorder is the mock recorder for MockTimeEntryUsecase.
+type MockTimeEntryUsecaseMockRecorder struct {
+	mock *MockTimeEntryUsecase
+}
+
+// NewMockTimeEntryUsecase creates a new mock instance.
+func NewMockTimeEntryUsecase(ctrl *gomock.Controller) *MockTimeEntryUsecase {
+	mock := &MockTimeEntryUsecase{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryUsecase) EXPECT() *MockTimeEntryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTimeEntryUsecase) Add(ctx context.Context, entry TimeEntry, user User) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, entry, user)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTimeEntryUsecaseMockRecorder) Add(ctx, entry, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTimeEntryUsecase)(nil).Add), ctx, entry, user)
+}
+
+// Approve mocks base method.
+func (m *MockTimeEntryUsecase) Approve(ctx context.Context, id int64, user User) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, user)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockTimeEntryUsecaseMockRecorder) Approve(ctx, id, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockTimeEntryUsecase)(nil).Approve), ctx, id, user)
+}
+
+// ListByTask mocks base method.
+func (m *MockTimeEntryUsecase) ListByTask(ctx context.Context, taskID int64, user User) ([]TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockTimeEntryUsecaseMockRecorder) ListByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockTimeEntryUsecase)(nil).ListByTask), ctx, taskID, user)
+}
+
+// Start mocks base method.
+func (m *MockTimeEntryUsecase) Start(ctx context.Context, taskID int64, note string, user User) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, taskID, note, user)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTimeEntryUsecaseMockRecorder) Start(ctx, taskID, note, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTimeEntryUsecase)(nil).Start), ctx, taskID, note, user)
+}
+
+// Stop mocks base method.
+func (m *MockTimeEntryUsecase) Stop(ctx context.Context, taskID int64, user User) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, taskID, user)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockTimeEntryUsecaseMockRecorder) Stop(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTimeEntryUsecase)(nil).Stop), ctx, taskID, user)
+}
+
+// Summarize mocks base method.
+func (m *MockTimeEntryUsecase) Summarize(ctx context.Context, filter TimeSummaryFilter, user User) ([]TimeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, filter, user)
+	ret0, _ := ret[0].([]TimeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockTimeEntryUsecaseMockRecorder) Summarize(ctx, filter, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockTimeEntryUsecase)(nil).Summarize), ctx, filter, user)
+}
+
+// MockTimeEntryCreator is a mock of TimeEntryCreator interface.
+type MockTimeEntryCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryCreatorMockRecorder
+}
+
+// MockTimeEntryCreatorMockRecorder is the mock recorder for MockTimeEntryCreator.
+type MockTimeEntryCreatorMockRecorder struct {
+	mock *MockTimeEntryCreator
+}
+
+// NewMockTimeEntryCreator creates a new mock instance.
+func NewMockTimeEntryCreator(ctrl *gomock.Controller) *MockTimeEntryCreator {
+	mock := &MockTimeEntryCreator{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryCreator) EXPECT() *MockTimeEntryCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockTimeEntryCreator) Add(ctx context.Context, entry TimeEntry) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, entry)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockTimeEntryCreatorMockRecorder) Add(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockTimeEntryCreator)(nil).Add), ctx, entry)
+}
+
+// MockTimeEntryRetriever is a mock of TimeEntryRetriever interface.
+type MockTimeEntryRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryRetrieverMockRecorder
+}
+
+// MockTimeEntryRetrieverMockRecorder is the mock recorder for MockTimeEntryRetriever.
+type MockTimeEntryRetrieverMockRecorder struct {
+	mock *MockTimeEntryRetriever
+}
+
+// NewMockTimeEntryRetriever creates a new mock instance.
+func NewMockTimeEntryRetriever(ctrl *gomock.Controller) *MockTimeEntryRetriever {
+	mock := &MockTimeEntryRetriever{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryRetriever) EXPECT() *MockTimeEntryRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByID mocks base method.
+func (m *MockTimeEntryRetriever) ListByID(ctx context.Context, id int64) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockTimeEntryRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockTimeEntryRetriever)(nil).ListByID), ctx, id)
+}
+
+// ListByTaskID mocks base method.
+func (m *MockTimeEntryRetriever) ListByTaskID(ctx context.Context, taskID int64) ([]TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockTimeEntryRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockTimeEntryRetriever)(nil).ListByTaskID), ctx, taskID)
+}
+
+// ListOverlapping mocks base method.
+func (m *MockTimeEntryRetriever) ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlapping", ctx, userID, start, end, excludeID)
+	ret0, _ := ret[0].([]TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlapping indicates an expected call of ListOverlapping.
+func (mr *MockTimeEntryRetrieverMockRecorder) ListOverlapping(ctx, userID, start, end, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlapping", reflect.TypeOf((*MockTimeEntryRetriever)(nil).ListOverlapping), ctx, userID, start, end, excludeID)
+}
+
+// ListRunningByUserID mocks base method.
+func (m *MockTimeEntryRetriever) ListRunningByUserID(ctx context.Context, userID int64) (TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRunningByUserID", ctx, userID)
+	ret0, _ := ret[0].(TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRunningByUserID indicates an expected call of ListRunningByUserID.
+func (mr *MockTimeEntryRetrieverMockRecorder) ListRunningByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRunningByUserID", reflect.TypeOf((*MockTimeEntryRetriever)(nil).ListRunningByUserID), ctx, userID)
+}
+
+// Summarize mocks base method.
+func (m *MockTimeEntryRetriever) Summarize(ctx context.Context, filter TimeSummaryFilter) ([]TimeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, filter)
+	ret0, _ := ret[0].([]TimeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockTimeEntryRetrieverMockRecorder) Summarize(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockTimeEntryRetriever)(nil).Summarize), ctx, filter)
+}
+
+// MockTimeEntryUpdater is a mock of TimeEntryUpdater interface.
+type MockTimeEntryUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryUpdaterMockRecorder
+}
+
+// MockTimeEntryUpdaterMockRecorder is the mock recorder for MockTimeEntryUpdater.
+type MockTimeEntryUpdaterMockRecorder struct {
+	mock *MockTimeEntryUpdater
+}
+
+// NewMockTimeEntryUpdater creates a new mock instance.
+func NewMockTimeEntryUpdater(ctrl *gomock.Controller) *MockTimeEntryUpdater {
+	mock := &MockTimeEntryUpdater{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryUpdater) EXPECT() *MockTimeEntryUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockTimeEntryUpdater) Update(ctx context.Context, entry TimeEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTimeEntryUpdaterMockRecorder) Update(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTimeEntryUpdater)(nil).Update), ctx, entry)
+}
@@ -0,0 +1,155 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewTimeEntry(t *testing.T) {
+	var (
+		start  = time.Date(2023, 11, 15, 6, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+		end    = start.Add(90 * time.Minute)
+		utcEnd = time.Date(2023, 11, 15, 10, 30, 0, 0, time.UTC)
+	)
+
+	type args struct {
+		taskID int64
+		userID int64
+		start  *time.Time
+		end    *time.Time
+		note   string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    TimeEntry
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when task is missing",
+			args:    args{userID: 2, start: &start, end: &end},
+			want:    TimeEntry{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when end is missing",
+			args:    args{taskID: 1, userID: 2, start: &start},
+			want:    TimeEntry{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when end is before start",
+			args:    args{taskID: 1, userID: 2, start: &end, end: &start},
+			want:    TimeEntry{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when note is too big",
+			args:    args{taskID: 1, userID: 2, start: &start, end: &end, note: strings.Repeat("a", 501)},
+			want:    TimeEntry{},
+			wantErr: true,
+		},
+		{
+			name: "Expect dates in UTC",
+			args: args{taskID: 1, userID: 2, start: &start, end: &end, note: "Replaced compressor"},
+			want: TimeEntry{
+				TaskID: 1,
+				UserID: 2,
+				Start:  time.Date(2023, 11, 15, 9, 0, 0, 0, time.UTC),
+				End:    &utcEnd,
+				Note:   "Replaced compressor",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTimeEntry(tt.args.taskID, tt.args.userID, tt.args.start, tt.args.end, tt.args.note)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTimeEntry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidTimeEntry))
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.Duration(), got.Duration())
+		})
+	}
+}
+
+func TestNewTimeSummaryFilter(t *testing.T) {
+	var (
+		from = time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name    string
+		from    *time.Time
+		to      *time.Time
+		groupBy string
+		want    TimeSummaryFilter
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when range is missing",
+			from:    &from,
+			want:    TimeSummaryFilter{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when range is inverted",
+			from:    &to,
+			to:      &from,
+			want:    TimeSummaryFilter{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when group is not supported",
+			from:    &from,
+			to:      &to,
+			groupBy: "customer",
+			want:    TimeSummaryFilter{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect group by task by default",
+			from:    &from,
+			to:      &to,
+			want:    TimeSummaryFilter{From: from, To: to, GroupBy: TimeSummaryByTask},
+			wantErr: false,
+		},
+		{
+			name:    "Expect group by user",
+			from:    &from,
+			to:      &to,
+			groupBy: TimeSummaryByUser,
+			want:    TimeSummaryFilter{From: from, To: to, GroupBy: TimeSummaryByUser},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTimeSummaryFilter(tt.from, tt.to, tt.groupBy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTimeSummaryFilter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidTimeEntry))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,28 +5,68 @@ package domain
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 )
 
 var (
 	ErrUserNotFound    = errors.New("user not found")
 	ErrUserNotAllowed  = errors.New("forbidden")
 	ErrUserInvalidPass = errors.New("invalid password")
+	ErrInvalidTimezone = errors.New("timezone is invalid")
 )
 
 const (
 	Manager    = "manager"
 	Technician = "technician"
+
+	DefaultTimezone = "UTC"
 )
 
+type UserUsecase interface {
+	UpdateTimezone(ctx context.Context, user User, timezone string) (User, string, error)
+}
+
 type User struct {
 	ID       int64
 	Email    string
 	Password string
 	RoleID   int64
+	Timezone string
 }
 
 type UserRetriever interface {
 	ListByEmail(ctx context.Context, email string) (User, error)
+	ListByID(ctx context.Context, id int64) (User, error)
+}
+
+type UserTimezoneUpdater interface {
+	UpdateTimezone(ctx context.Context, userID int64, timezone string) error
+}
+
+func ValidateTimezone(timezone string) error {
+	if timezone == "" || len(timezone) > 64 {
+		return fmt.Errorf("%w: %q", ErrInvalidTimezone, timezone)
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidTimezone, timezone)
+	}
+
+	return nil
+}
+
+func LoadLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
 }
 
 func (u *User) GetRole() string {
@@ -39,3 +79,7 @@ func (u *User) GetRole() string {
 
 	return ""
 }
+
+func (u *User) Location() *time.Location {
+	return LoadLocation(u.Timezone)
+}
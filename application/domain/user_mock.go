@@ -11,6 +11,45 @@ import (
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockUserUsecase is a mock of UserUsecase interface.
+type MockUserUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserUsecaseMockRecorder
+}
+
+// MockUserUsecaseMockRecorder is the mock recorder for MockUserUsecase.
+type MockUserUsecaseMockRecorder struct {
+	mock *MockUserUsecase
+}
+
+// NewMockUserUsecase creates a new mock instance.
+func NewMockUserUsecase(ctrl *gomock.Controller) *MockUserUsecase {
+	mock := &MockUserUsecase{ctrl: ctrl}
+	mock.recorder = &MockUserUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockUserUsecase) UpdateTimezone(ctx context.Context, user User, timezone string) (User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, user, timezone)
+	ret0, _ := ret[0].(User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockUserUsecaseMockRecorder) UpdateTimezone(ctx, user, timezone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockUserUsecase)(nil).UpdateTimezone), ctx, user, timezone)
+}
+
 // MockUserRetriever is a mock of UserRetriever interface.
 type MockUserRetriever struct {
 	ctrl     *gomock.Controller
@@ -48,3 +87,55 @@ func (mr *MockUserRetrieverMockRecorder) ListByEmail(ctx, email interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEmail", reflect.TypeOf((*MockUserRetriever)(nil).ListByEmail), ctx, email)
 }
+
+// ListByID mocks base method.
+func (m *MockUserRetriever) ListByID(ctx context.Context, id int64) (User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByID", ctx, id)
+	ret0, _ := ret[0].(User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByID indicates an expected call of ListByID.
+func (mr *MockUserRetrieverMockRecorder) ListByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByID", reflect.TypeOf((*MockUserRetriever)(nil).ListByID), ctx, id)
+}
+
+// MockUserTimezoneUpdater is a mock of UserTimezoneUpdater interface.
+type MockUserTimezoneUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTimezoneUpdaterMockRecorder
+}
+
+// MockUserTimezoneUpdaterMockRecorder is the mock recorder for MockUserTimezoneUpdater.
+type MockUserTimezoneUpdaterMockRecorder struct {
+	mock *MockUserTimezoneUpdater
+}
+
+// NewMockUserTimezoneUpdater creates a new mock instance.
+func NewMockUserTimezoneUpdater(ctrl *gomock.Controller) *MockUserTimezoneUpdater {
+	mock := &MockUserTimezoneUpdater{ctrl: ctrl}
+	mock.recorder = &MockUserTimezoneUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTimezoneUpdater) EXPECT() *MockUserTimezoneUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateTimezone mocks base method.
+func (m *MockUserTimezoneUpdater) UpdateTimezone(ctx context.Context, userID int64, timezone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimezone", ctx, userID, timezone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimezone indicates an expected call of UpdateTimezone.
+func (mr *MockUserTimezoneUpdaterMockRecorder) UpdateTimezone(ctx, userID, timezone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimezone", reflect.TypeOf((*MockUserTimezoneUpdater)(nil).UpdateTimezone), ctx, userID, timezone)
+}
@@ -50,3 +50,31 @@ func TestUser_GetRole(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		wantErr  bool
+	}{
+		{name: "Expect error when timezone is empty", timezone: "", wantErr: true},
+		{name: "Expect error when timezone is unknown", timezone: "Mars/Olympus", wantErr: true},
+		{name: "Expect UTC to be valid", timezone: "UTC", wantErr: false},
+		{name: "Expect IANA name to be valid", timezone: "America/Sao_Paulo", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTimezone(tt.timezone); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTimezone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUser_Location(t *testing.T) {
+	user := User{Timezone: "Mars/Olympus"}
+	assert.Equal(t, "UTC", user.Location().String())
+
+	user.Timezone = "America/Sao_Paulo"
+	assert.Equal(t, "America/Sao_Paulo", user.Location().String())
+}
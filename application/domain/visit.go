@@ -0,0 +1,84 @@
+//go:generate mockgen -source=visit.go -destination=visit_mock.go -package=domain
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidVisit     = errors.New("visit fields are invalid")
+	ErrVisitNotFound    = errors.New("visit not found")
+	ErrAlreadyCheckedIn = errors.New("technician is already checked in on this task")
+	ErrNotCheckedIn     = errors.New("technician is not checked in on this task")
+)
+
+type VisitUsecase interface {
+	CheckIn(ctx context.Context, taskID int64, checkpoint Checkpoint, user User) (Visit, error)
+	CheckOut(ctx context.Context, taskID int64, checkpoint Checkpoint, user User) (Visit, error)
+	ListByTask(ctx context.Context, taskID int64, user User) ([]Visit, error)
+}
+
+type VisitCreator interface {
+	Add(ctx context.Context, visit Visit) (int64, error)
+}
+
+type VisitRetriever interface {
+	ListByTaskID(ctx context.Context, taskID int64) ([]Visit, error)
+	ListOpenByTaskID(ctx context.Context, taskID int64) (Visit, error)
+}
+
+type VisitUpdater interface {
+	CheckOut(ctx context.Context, visit Visit) error
+}
+
+type Visit struct {
+	ID       int64
+	TaskID   int64
+	UserID   int64
+	CheckIn  Checkpoint
+	CheckOut *Checkpoint
+}
+
+type Checkpoint struct {
+	Coordinates Coordinates
+	DeviceTime  time.Time
+	At          time.Time
+	Distance    *float64
+	OutOfRange  bool
+}
+
+func NewCheckpoint(coordinates *Coordinates, deviceTime *time.Time) (Checkpoint, error) {
+	var err []string
+
+	if coordinates == nil {
+		err = append(err, "coordinates must not be empty")
+	} else if !coordinates.IsValid() {
+		err = append(err, "coordinates are out of range")
+	}
+
+	if deviceTime == nil {
+		err = append(err, "device time must not be empty")
+	}
+
+	if len(err) > 0 {
+		return Checkpoint{}, fmt.Errorf("%w: %s", ErrInvalidVisit, strings.Join(err, "; "))
+	}
+
+	return Checkpoint{Coordinates: *coordinates, DeviceTime: deviceTime.UTC()}, nil
+}
+
+func (c *Checkpoint) Locate(site *Coordinates, radius float64) {
+	if site == nil {
+		return
+	}
+
+	distance := c.Coordinates.DistanceTo(*site)
+
+	c.Distance = &distance
+	c.OutOfRange = distance > radius
+}
@@ -0,0 +1,208 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: visit.go
+
+// Package domain is a generated GoMock package.
+package domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockVisitUsecase is a mock of VisitUsecase interface.
+type MockVisitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisitUsecaseMockRecorder
+}
+
+// MockVisitUsecaseMockRecorder is the mock recorder for MockVisitUsecase.
+type MockVisitUsecaseMockRecorder struct {
+	mock *MockVisitUsecase
+}
+
+// NewMockVisitUsecase creates a new mock instance.
+func NewMockVisitUsecase(ctrl *gomock.Controller) *MockVisitUsecase {
+	mock := &MockVisitUsecase{ctrl: ctrl}
+	mock.recorder = &MockVisitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisitUsecase) EXPECT() *MockVisitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockVisitUsecase) CheckIn(ctx context.Context, taskID int64, checkpoint Checkpoint, user User) (Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, taskID, checkpoint, user)
+	ret0, _ := ret[0].(Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockVisitUsecaseMockRecorder) CheckIn(ctx, taskID, checkpoint, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockVisitUsecase)(nil).CheckIn), ctx, taskID, checkpoint, user)
+}
+
+// CheckOut mocks base method.
+func (m *MockVisitUsecase) CheckOut(ctx context.Context, taskID int64, checkpoint Checkpoint, user User) (Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOut", ctx, taskID, checkpoint, user)
+	ret0, _ := ret[0].(Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckOut indicates an expected call of CheckOut.
+func (mr *MockVisitUsecaseMockRecorder) CheckOut(ctx, taskID, checkpoint, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOut", reflect.TypeOf((*MockVisitUsecase)(nil).CheckOut), ctx, taskID, checkpoint, user)
+}
+
+// ListByTask mocks base method.
+func (m *MockVisitUsecase) ListByTask(ctx context.Context, taskID int64, user User) ([]Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID, user)
+	ret0, _ := ret[0].([]Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockVisitUsecaseMockRecorder) ListByTask(ctx, taskID, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockVisitUsecase)(nil).ListByTask), ctx, taskID, user)
+}
+
+// MockVisitCreator is a mock of VisitCreator interface.
+type MockVisitCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisitCreatorMockRecorder
+}
+
+// MockVisitCreatorMockRecorder is the mock recorder for MockVisitCreator.
+type MockVisitCreatorMockRecorder struct {
+	mock *MockVisitCreator
+}
+
+// NewMockVisitCreator creates a new mock instance.
+func NewMockVisitCreator(ctrl *gomock.Controller) *MockVisitCreator {
+	mock := &MockVisitCreator{ctrl: ctrl}
+	mock.recorder = &MockVisitCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisitCreator) EXPECT() *MockVisitCreatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockVisitCreator) Add(ctx context.Context, visit Visit) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, visit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockVisitCreatorMockRecorder) Add(ctx, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockVisitCreator)(nil).Add), ctx, visit)
+}
+
+// MockVisitRetriever is a mock of VisitRetriever interface.
+type MockVisitRetriever struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisitRetrieverMockRecorder
+}
+
+// MockVisitRetrieverMockRecorder is the mock recorder for MockVisitRetriever.
+type MockVisitRetrieverMockRecorder struct {
+	mock *MockVisitRetriever
+}
+
+// NewMockVisitRetriever creates a new mock instance.
+func NewMockVisitRetriever(ctrl *gomock.Controller) *MockVisitRetriever {
+	mock := &MockVisitRetriever{ctrl: ctrl}
+	mock.recorder = &MockVisitRetrieverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisitRetriever) EXPECT() *MockVisitRetrieverMockRecorder {
+	return m.recorder
+}
+
+// ListByTaskID mocks base method.
+func (m *MockVisitRetriever) ListByTaskID(ctx context.Context, taskID int64) ([]Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockVisitRetrieverMockRecorder) ListByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockVisitRetriever)(nil).ListByTaskID), ctx, taskID)
+}
+
+// ListOpenByTaskID mocks base method.
+func (m *MockVisitRetriever) ListOpenByTaskID(ctx context.Context, taskID int64) (Visit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenByTaskID", ctx, taskID)
+	ret0, _ := ret[0].(Visit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenByTaskID indicates an expected call of ListOpenByTaskID.
+func (mr *MockVisitRetrieverMockRecorder) ListOpenByTaskID(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenByTaskID", reflect.TypeOf((*MockVisitRetriever)(nil).ListOpenByTaskID), ctx, taskID)
+}
+
+// MockVisitUpdater is a mock of VisitUpdater interface.
+type MockVisitUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisitUpdaterMockRecorder
+}
+
+// MockVisitUpdaterMockRecorder is the mock recorder for MockVisitUpdater.
+type MockVisitUpdaterMockRecorder struct {
+	mock *MockVisitUpdater
+}
+
+// NewMockVisitUpdater creates a new mock instance.
+func NewMockVisitUpdater(ctrl *gomock.Controller) *MockVisitUpdater {
+	mock := &MockVisitUpdater{ctrl: ctrl}
+	mock.recorder = &MockVisitUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisitUpdater) EXPECT() *MockVisitUpdaterMockRecorder {
+	return m.recorder
+}
+
+// CheckOut mocks base method.
+func (m *MockVisitUpdater) CheckOut(ctx context.Context, visit Visit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOut", ctx, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOut indicates an expected call of CheckOut.
+func (mr *MockVisitUpdaterMockRecorder) CheckOut(ctx, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOut", reflect.TypeOf((*MockVisitUpdater)(nil).CheckOut), ctx, visit)
+}
@@ -0,0 +1,116 @@
+//go:build unit
+
+package domain
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewCheckpoint(t *testing.T) {
+	deviceTime := time.Date(2023, 11, 15, 9, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	tests := []struct {
+		name        string
+		coordinates *Coordinates
+		deviceTime  *time.Time
+		want        Checkpoint
+		wantErr     bool
+	}{
+		{
+			name:       "Expect error when coordinates are missing",
+			deviceTime: &deviceTime,
+			want:       Checkpoint{},
+			wantErr:    true,
+		},
+		{
+			name:        "Expect error when coordinates are out of range",
+			coordinates: &Coordinates{Latitude: 10, Longitude: 200},
+			deviceTime:  &deviceTime,
+			want:        Checkpoint{},
+			wantErr:     true,
+		},
+		{
+			name:        "Expect error when device time is missing",
+			coordinates: &Coordinates{Latitude: -23.5613, Longitude: -46.6565},
+			want:        Checkpoint{},
+			wantErr:     true,
+		},
+		{
+			name:        "Expect device time in UTC",
+			coordinates: &Coordinates{Latitude: -23.5613, Longitude: -46.6565},
+			deviceTime:  &deviceTime,
+			want: Checkpoint{
+				Coordinates: Coordinates{Latitude: -23.5613, Longitude: -46.6565},
+				DeviceTime:  time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCheckpoint(tt.coordinates, tt.deviceTime)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCheckpoint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidVisit))
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCoordinates_DistanceTo(t *testing.T) {
+	paulista := Coordinates{Latitude: -23.5613, Longitude: -46.6565}
+	se := Coordinates{Latitude: -23.5505, Longitude: -46.6333}
+
+	assert.Equal(t, 0.0, paulista.DistanceTo(paulista))
+	assert.InDelta(t, 2650, paulista.DistanceTo(se), 50)
+	assert.InDelta(t, paulista.DistanceTo(se), se.DistanceTo(paulista), 0.001)
+}
+
+func TestCheckpoint_Locate(t *testing.T) {
+	site := &Coordinates{Latitude: -23.5613, Longitude: -46.6565}
+
+	tests := []struct {
+		name           string
+		coordinates    Coordinates
+		site           *Coordinates
+		wantDistance   bool
+		wantOutOfRange bool
+	}{
+		{
+			name:        "Expect no distance when site has no coordinates",
+			coordinates: Coordinates{Latitude: -23.5613, Longitude: -46.6565},
+		},
+		{
+			name:         "Expect in range next to the site",
+			coordinates:  Coordinates{Latitude: -23.5614, Longitude: -46.6566},
+			site:         site,
+			wantDistance: true,
+		},
+		{
+			name:           "Expect out of range far from the site",
+			coordinates:    Coordinates{Latitude: -23.5505, Longitude: -46.6333},
+			site:           site,
+			wantDistance:   true,
+			wantOutOfRange: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Checkpoint{Coordinates: tt.coordinates}
+
+			c.Locate(tt.site, 200)
+
+			assert.Equal(t, tt.wantDistance, c.Distance != nil)
+			assert.Equal(t, tt.wantOutOfRange, c.OutOfRange)
+		})
+	}
+}
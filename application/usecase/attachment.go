@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+type attachmentUseCase struct {
+	creator   domain.AttachmentCreator
+	retriever domain.AttachmentRetriever
+	remover   domain.AttachmentRemover
+	tasks     domain.TaskRetriever
+	storage   domain.BlobStorage
+	encryptor domain.SummaryEncryptor
+	maxSize   int64
+	now       func() time.Time
+}
+
+func NewAttachment(
+	creator domain.AttachmentCreator,
+	retriever domain.AttachmentRetriever,
+	remover domain.AttachmentRemover,
+	tasks domain.TaskRetriever,
+	storage domain.BlobStorage,
+	encryptor domain.SummaryEncryptor,
+	maxSize int64,
+) (domain.AttachmentUsecase, error) {
+	if creator == nil {
+		return &attachmentUseCase{}, errors.New("attachment creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &attachmentUseCase{}, errors.New("attachment retriever must not be nil")
+	}
+
+	if remover == nil {
+		return &attachmentUseCase{}, errors.New("attachment remover must not be nil")
+	}
+
+	if tasks == nil {
+		return &attachmentUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if storage == nil {
+		return &attachmentUseCase{}, errors.New("blob storage must not be nil")
+	}
+
+	if encryptor == nil {
+		return &attachmentUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	if maxSize <= 0 {
+		return &attachmentUseCase{}, errors.New("max size must be positive")
+	}
+
+	return &attachmentUseCase{
+		creator:   creator,
+		retriever: retriever,
+		remover:   remover,
+		tasks:     tasks,
+		storage:   storage,
+		encryptor: encryptor,
+		maxSize:   maxSize,
+		now:       time.Now,
+	}, nil
+}
+
+func (u *attachmentUseCase) Upload(ctx context.Context, attachment domain.Attachment, content []byte, user domain.User) (domain.Attachment, error) {
+	if err := validateUser(user); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	if attachment.UserID != user.ID {
+		return domain.Attachment{}, domain.ErrUserNotAllowed
+	}
+
+	attachment.Size = int64(len(content))
+	if attachment.Size > u.maxSize {
+		return domain.Attachment{}, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, u.maxSize)
+	}
+
+	if _, err := u.tasks.ListByIDAndUserID(ctx, attachment.TaskID, user.ID); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	key, err := storageKey(attachment.TaskID)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	encrypted, err := u.encryptor.Encrypt(string(content))
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	if err := u.storage.Put(ctx, key, []byte(encrypted)); err != nil {
+		return domain.Attachment{}, err
+	}
+
+	attachment.StorageKey = key
+	attachment.CreatedAt = u.now().UTC()
+
+	id, err := u.creator.Add(ctx, attachment)
+	if err != nil {
+		if err := u.storage.Delete(ctx, key); err != nil {
+			log.Printf("error deleting orphan blob %s: %v", key, err) // Later: send to metrics/observability
+		}
+
+		return domain.Attachment{}, err
+	}
+	attachment.ID = id
+
+	return attachment, nil
+}
+
+func (u *attachmentUseCase) ListByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.Attachment, error) {
+	if err := u.authorize(ctx, taskID, user); err != nil {
+		return []domain.Attachment{}, err
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *attachmentUseCase) Download(ctx context.Context, taskID, id int64, user domain.User) (domain.Attachment, []byte, error) {
+	if err := u.authorize(ctx, taskID, user); err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	attachment, err := u.find(ctx, taskID, id)
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	encrypted, err := u.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrBlobNotFound) {
+			return domain.Attachment{}, nil, domain.ErrAttachmentNotFound
+		}
+
+		return domain.Attachment{}, nil, err
+	}
+
+	content, err := u.encryptor.Decrypt(string(encrypted))
+	if err != nil {
+		return domain.Attachment{}, nil, err
+	}
+
+	return attachment, []byte(content), nil
+}
+
+func (u *attachmentUseCase) Remove(ctx context.Context, taskID, id int64, user domain.User) error {
+	if err := u.authorize(ctx, taskID, user); err != nil {
+		return err
+	}
+
+	attachment, err := u.find(ctx, taskID, id)
+	if err != nil {
+		return err
+	}
+
+	if user.GetRole() != domain.Manager && attachment.UserID != user.ID {
+		return domain.ErrUserNotAllowed
+	}
+
+	if err := u.remover.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		log.Printf("error deleting blob %s: %v", attachment.StorageKey, err) // Later: send to metrics/observability
+	}
+
+	return nil
+}
+
+func (u *attachmentUseCase) authorize(ctx context.Context, taskID int64, user domain.User) error {
+	if taskID == 0 {
+		return errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *attachmentUseCase) find(ctx context.Context, taskID, id int64) (domain.Attachment, error) {
+	attachment, err := u.retriever.ListByID(ctx, id)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	if attachment.TaskID != taskID {
+		return domain.Attachment{}, domain.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
+
+func storageKey(taskID int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("tasks/%d/%s", taskID, hex.EncodeToString(b)), nil
+}
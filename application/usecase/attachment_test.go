@@ -0,0 +1,305 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockAttachmentCreator(ctrl)
+	retriever := domain.NewMockAttachmentRetriever(ctrl)
+	remover := domain.NewMockAttachmentRemover(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	storage := domain.NewMockBlobStorage(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+
+	type args struct {
+		creator   domain.AttachmentCreator
+		retriever domain.AttachmentRetriever
+		remover   domain.AttachmentRemover
+		tasks     domain.TaskRetriever
+		storage   domain.BlobStorage
+		encryptor domain.SummaryEncryptor
+		maxSize   int64
+	}
+
+	valid := args{creator, retriever, remover, tasks, storage, encryptor, 1024}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without storage", args: func() args { a := valid; a.storage = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without max size", args: func() args { a := valid; a.maxSize = 0; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewAttachment(a.creator, a.retriever, a.remover, a.tasks, a.storage, a.encryptor, a.maxSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAttachment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type attachmentDependencies struct {
+	creator   *domain.MockAttachmentCreator
+	retriever *domain.MockAttachmentRetriever
+	remover   *domain.MockAttachmentRemover
+	tasks     *domain.MockTaskRetriever
+	storage   *domain.MockBlobStorage
+	encryptor *domain.MockSummaryEncryptor
+}
+
+func newAttachmentUseCase(ctrl *gomock.Controller, now time.Time) (*attachmentUseCase, attachmentDependencies) {
+	d := attachmentDependencies{
+		creator:   domain.NewMockAttachmentCreator(ctrl),
+		retriever: domain.NewMockAttachmentRetriever(ctrl),
+		remover:   domain.NewMockAttachmentRemover(ctrl),
+		tasks:     domain.NewMockTaskRetriever(ctrl),
+		storage:   domain.NewMockBlobStorage(ctrl),
+		encryptor: domain.NewMockSummaryEncryptor(ctrl),
+	}
+
+	return &attachmentUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		remover:   d.remover,
+		tasks:     d.tasks,
+		storage:   d.storage,
+		encryptor: d.encryptor,
+		maxSize:   16,
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_attachmentUseCase_Upload(t *testing.T) {
+	var (
+		now        = time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+		user       = domain.User{ID: 2, RoleID: 2}
+		task       = domain.Task{ID: 1, UserID: 2}
+		attachment = domain.Attachment{TaskID: 1, UserID: 2, Name: "before.jpg", ContentType: "image/jpeg"}
+	)
+
+	tests := []struct {
+		name            string
+		attachment      domain.Attachment
+		content         []byte
+		setDependencies func(d *attachmentDependencies)
+		wantErr         error
+	}{
+		{
+			name:       "Expect error when uploading on behalf of another user",
+			attachment: domain.Attachment{TaskID: 1, UserID: 3},
+			content:    []byte("photo"),
+			wantErr:    domain.ErrUserNotAllowed,
+		},
+		{
+			name:       "Expect error when file is too large",
+			attachment: attachment,
+			content:    []byte("a photo bigger than the limit"),
+			wantErr:    domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:       "Expect error when task is not assigned to the technician",
+			attachment: attachment,
+			content:    []byte("photo"),
+			setDependencies: func(d *attachmentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name:       "Expect blob to be removed when the record fails",
+			attachment: attachment,
+			content:    []byte("photo"),
+			setDependencies: func(d *attachmentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.encryptor.EXPECT().Encrypt("photo").Return("encrypted", nil)
+				d.storage.EXPECT().Put(context.Background(), gomock.Any(), []byte("encrypted")).Return(nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(0), errors.New("err"))
+				d.storage.EXPECT().Delete(context.Background(), gomock.Any()).Return(nil)
+			},
+			wantErr: errors.New("err"),
+		},
+		{
+			name:       "Expect content to be encrypted before being stored",
+			attachment: attachment,
+			content:    []byte("photo"),
+			setDependencies: func(d *attachmentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.encryptor.EXPECT().Encrypt("photo").Return("encrypted", nil)
+				d.storage.EXPECT().Put(context.Background(), gomock.Any(), []byte("encrypted")).Return(nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(7), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newAttachmentUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Upload(context.Background(), tt.attachment, tt.content, user)
+			if (err != nil) != (tt.wantErr != nil) || (err != nil && !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error()) {
+				t.Errorf("Upload() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, int64(7), got.ID)
+			assert.Equal(t, int64(5), got.Size)
+			assert.Equal(t, now, got.CreatedAt)
+			assert.Regexp(t, `^tasks/1/[0-9a-f]{32}$`, got.StorageKey)
+		})
+	}
+}
+
+func Test_attachmentUseCase_Download(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		attachment = domain.Attachment{ID: 7, TaskID: 1, UserID: 2, Name: "before.jpg", ContentType: "image/jpeg", StorageKey: "tasks/1/abc"}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *attachmentDependencies)
+		want            []byte
+		wantErr         error
+	}{
+		{
+			name: "Expect error when technician is not assigned to the task",
+			user: technician,
+			setDependencies: func(d *attachmentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name: "Expect error when attachment belongs to another task",
+			user: manager,
+			setDependencies: func(d *attachmentDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(7)).Return(domain.Attachment{ID: 7, TaskID: 9}, nil)
+			},
+			wantErr: domain.ErrAttachmentNotFound,
+		},
+		{
+			name: "Expect error when blob is missing",
+			user: manager,
+			setDependencies: func(d *attachmentDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(7)).Return(attachment, nil)
+				d.storage.EXPECT().Get(context.Background(), "tasks/1/abc").Return(nil, domain.ErrBlobNotFound)
+			},
+			wantErr: domain.ErrAttachmentNotFound,
+		},
+		{
+			name: "Expect decrypted content",
+			user: manager,
+			setDependencies: func(d *attachmentDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(7)).Return(attachment, nil)
+				d.storage.EXPECT().Get(context.Background(), "tasks/1/abc").Return([]byte("encrypted"), nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("photo", nil)
+			},
+			want: []byte("photo"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newAttachmentUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			_, got, err := u.Download(context.Background(), 1, 7, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Download() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_attachmentUseCase_Remove(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		task       = domain.Task{ID: 1, UserID: 2}
+		attachment = domain.Attachment{ID: 7, TaskID: 1, UserID: 3, StorageKey: "tasks/1/abc"}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *attachmentDependencies)
+		wantErr         error
+	}{
+		{
+			name: "Expect technician not to remove attachments uploaded by others",
+			user: technician,
+			setDependencies: func(d *attachmentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(7)).Return(attachment, nil)
+			},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect manager to remove the record and the blob",
+			user: manager,
+			setDependencies: func(d *attachmentDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(7)).Return(attachment, nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(7)).Return(nil)
+				d.storage.EXPECT().Delete(context.Background(), "tasks/1/abc").Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newAttachmentUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			err := u.Remove(context.Background(), 1, 7, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+const (
+	calendarHistory    = 90 * 24 * time.Hour
+	calendarTokenBytes = 32
+)
+
+type calendarUseCase struct {
+	creator   domain.CalendarTokenCreator
+	retriever domain.CalendarTokenRetriever
+	remover   domain.CalendarTokenRemover
+	events    domain.CalendarEventRetriever
+	users     domain.UserRetriever
+	encryptor domain.SummaryEncryptor
+	newToken  func() (string, error)
+	now       func() time.Time
+}
+
+func NewCalendar(
+	creator domain.CalendarTokenCreator,
+	retriever domain.CalendarTokenRetriever,
+	remover domain.CalendarTokenRemover,
+	events domain.CalendarEventRetriever,
+	users domain.UserRetriever,
+	encryptor domain.SummaryEncryptor,
+) (domain.CalendarUsecase, error) {
+	if creator == nil {
+		return &calendarUseCase{}, errors.New("calendar token creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &calendarUseCase{}, errors.New("calendar token retriever must not be nil")
+	}
+
+	if remover == nil {
+		return &calendarUseCase{}, errors.New("calendar token remover must not be nil")
+	}
+
+	if events == nil {
+		return &calendarUseCase{}, errors.New("calendar event retriever must not be nil")
+	}
+
+	if users == nil {
+		return &calendarUseCase{}, errors.New("user retriever must not be nil")
+	}
+
+	if encryptor == nil {
+		return &calendarUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	return &calendarUseCase{
+		creator:   creator,
+		retriever: retriever,
+		remover:   remover,
+		events:    events,
+		users:     users,
+		encryptor: encryptor,
+		newToken:  randomCalendarToken,
+		now:       time.Now,
+	}, nil
+}
+
+// Feed returns the events of the user the token belongs to: the tasks scheduled
+// in the last 90 days or later, and the ones removed in that period as
+// cancelled events.
+func (u *calendarUseCase) Feed(ctx context.Context, token string) (domain.CalendarFeed, error) {
+	if token == "" {
+		return domain.CalendarFeed{}, domain.ErrCalendarFeedNotFound
+	}
+
+	stored, err := u.retriever.ListByHash(ctx, hashCalendarToken(token))
+	if err != nil {
+		return domain.CalendarFeed{}, err
+	}
+
+	user, err := u.users.ListByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return domain.CalendarFeed{}, domain.ErrCalendarFeedNotFound
+		}
+
+		return domain.CalendarFeed{}, err
+	}
+
+	events, err := u.events.ListCalendarEvents(ctx, user.ID, u.now().Add(-calendarHistory))
+	if err != nil {
+		return domain.CalendarFeed{}, err
+	}
+
+	feed := domain.CalendarFeed{User: user}
+
+	for _, event := range events {
+		summary, err := u.encryptor.Decrypt(event.Task.Summary)
+		if err != nil {
+			log.Printf("error decrypting task %d (calendar): %v", event.Task.ID, err) // Later: send to metrics/observability
+			continue
+		}
+
+		event.Task.Summary = summary
+		feed.Events = append(feed.Events, event)
+	}
+
+	return feed, nil
+}
+
+// IssueToken creates a calendar token for the user, revoking the previous one.
+// The token is only returned here; it cannot be read back later.
+func (u *calendarUseCase) IssueToken(ctx context.Context, user domain.User) (string, error) {
+	if user.ID == 0 {
+		return "", errors.New("user ID must not be empty")
+	}
+
+	token, err := u.newToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = u.creator.Add(ctx, domain.CalendarToken{UserID: user.ID, Hash: hashCalendarToken(token), CreatedAt: u.now().UTC()})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (u *calendarUseCase) RevokeToken(ctx context.Context, user domain.User) error {
+	if user.ID == 0 {
+		return errors.New("user ID must not be empty")
+	}
+
+	return u.remover.Remove(ctx, user.ID)
+}
+
+func randomCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,258 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockCalendarTokenCreator(ctrl)
+	retriever := domain.NewMockCalendarTokenRetriever(ctrl)
+	remover := domain.NewMockCalendarTokenRemover(ctrl)
+	events := domain.NewMockCalendarEventRetriever(ctrl)
+	users := domain.NewMockUserRetriever(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+
+	type args struct {
+		creator   domain.CalendarTokenCreator
+		retriever domain.CalendarTokenRetriever
+		remover   domain.CalendarTokenRemover
+		events    domain.CalendarEventRetriever
+		users     domain.UserRetriever
+		encryptor domain.SummaryEncryptor
+	}
+
+	valid := args{creator, retriever, remover, events, users, encryptor}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without event retriever", args: func() args { a := valid; a.events = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without user retriever", args: func() args { a := valid; a.users = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewCalendar(a.creator, a.retriever, a.remover, a.events, a.users, a.encryptor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCalendar() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type calendarDependencies struct {
+	creator   *domain.MockCalendarTokenCreator
+	retriever *domain.MockCalendarTokenRetriever
+	remover   *domain.MockCalendarTokenRemover
+	events    *domain.MockCalendarEventRetriever
+	users     *domain.MockUserRetriever
+	encryptor *domain.MockSummaryEncryptor
+}
+
+func newCalendarUseCase(ctrl *gomock.Controller, now time.Time) (*calendarUseCase, calendarDependencies) {
+	d := calendarDependencies{
+		creator:   domain.NewMockCalendarTokenCreator(ctrl),
+		retriever: domain.NewMockCalendarTokenRetriever(ctrl),
+		remover:   domain.NewMockCalendarTokenRemover(ctrl),
+		events:    domain.NewMockCalendarEventRetriever(ctrl),
+		users:     domain.NewMockUserRetriever(ctrl),
+		encryptor: domain.NewMockSummaryEncryptor(ctrl),
+	}
+
+	return &calendarUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		remover:   d.remover,
+		events:    d.events,
+		users:     d.users,
+		encryptor: d.encryptor,
+		newToken:  func() (string, error) { return "secret", nil },
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_calendarUseCase_Feed(t *testing.T) {
+	var (
+		now   = time.Date(2023, 12, 8, 10, 0, 0, 0, time.UTC)
+		since = now.Add(-90 * 24 * time.Hour)
+		hash  = hashCalendarToken("secret")
+		user  = domain.User{ID: 2, RoleID: 2, Timezone: "America/Sao_Paulo"}
+	)
+
+	tests := []struct {
+		name            string
+		token           string
+		setDependencies func(d *calendarDependencies)
+		want            domain.CalendarFeed
+		wantErr         error
+	}{
+		{
+			name:    "Expect empty token to be rejected",
+			wantErr: domain.ErrCalendarFeedNotFound,
+		},
+		{
+			name:  "Expect revoked token to be rejected",
+			token: "secret",
+			setDependencies: func(d *calendarDependencies) {
+				d.retriever.EXPECT().ListByHash(context.Background(), hash).Return(domain.CalendarToken{}, domain.ErrCalendarFeedNotFound)
+			},
+			wantErr: domain.ErrCalendarFeedNotFound,
+		},
+		{
+			name:  "Expect token of a removed user to be rejected",
+			token: "secret",
+			setDependencies: func(d *calendarDependencies) {
+				d.retriever.EXPECT().ListByHash(context.Background(), hash).Return(domain.CalendarToken{UserID: 2, Hash: hash}, nil)
+				d.users.EXPECT().ListByID(context.Background(), int64(2)).Return(domain.User{}, domain.ErrUserNotFound)
+			},
+			wantErr: domain.ErrCalendarFeedNotFound,
+		},
+		{
+			name:  "Expect events to be decrypted and unreadable ones skipped",
+			token: "secret",
+			setDependencies: func(d *calendarDependencies) {
+				d.retriever.EXPECT().ListByHash(context.Background(), hash).Return(domain.CalendarToken{UserID: 2, Hash: hash}, nil)
+				d.users.EXPECT().ListByID(context.Background(), int64(2)).Return(user, nil)
+				d.events.EXPECT().ListCalendarEvents(context.Background(), int64(2), since).Return([]domain.CalendarEvent{
+					{Task: domain.Task{ID: 1, Summary: "encrypted", Version: 2}},
+					{Task: domain.Task{ID: 2, Summary: "broken"}},
+					{Task: domain.Task{ID: 3, Summary: "removed", Version: 3}, Cancelled: true},
+				}, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Fix the pump", nil)
+				d.encryptor.EXPECT().Decrypt("broken").Return("", errors.New("err"))
+				d.encryptor.EXPECT().Decrypt("removed").Return("Replace the meter", nil)
+			},
+			want: domain.CalendarFeed{
+				User: user,
+				Events: []domain.CalendarEvent{
+					{Task: domain.Task{ID: 1, Summary: "Fix the pump", Version: 2}},
+					{Task: domain.Task{ID: 3, Summary: "Replace the meter", Version: 3}, Cancelled: true},
+				},
+			},
+		},
+		{
+			name:  "Expect storage errors to be returned",
+			token: "secret",
+			setDependencies: func(d *calendarDependencies) {
+				d.retriever.EXPECT().ListByHash(context.Background(), hash).Return(domain.CalendarToken{UserID: 2, Hash: hash}, nil)
+				d.users.EXPECT().ListByID(context.Background(), int64(2)).Return(user, nil)
+				d.events.EXPECT().ListCalendarEvents(context.Background(), int64(2), since).Return(nil, errors.New("err"))
+			},
+			wantErr: errors.New("err"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newCalendarUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Feed(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_calendarUseCase_IssueToken(t *testing.T) {
+	now := time.Date(2023, 12, 8, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *calendarDependencies)
+		want            string
+		wantErr         bool
+	}{
+		{
+			name:    "Expect error when user is missing",
+			wantErr: true,
+		},
+		{
+			name: "Expect only the hash of the token to be stored",
+			user: domain.User{ID: 2, RoleID: 2},
+			setDependencies: func(d *calendarDependencies) {
+				d.creator.EXPECT().Add(context.Background(), domain.CalendarToken{UserID: 2, Hash: hashCalendarToken("secret"), CreatedAt: now}).Return(nil)
+			},
+			want: "secret",
+		},
+		{
+			name: "Expect storage errors to be returned",
+			user: domain.User{ID: 2, RoleID: 2},
+			setDependencies: func(d *calendarDependencies) {
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(errors.New("err"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newCalendarUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.IssueToken(context.Background(), tt.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IssueToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_calendarUseCase_RevokeToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u, d := newCalendarUseCase(ctrl, time.Now())
+
+	d.remover.EXPECT().Remove(context.Background(), int64(2)).Return(nil)
+
+	assert.NoError(t, u.RevokeToken(context.Background(), domain.User{ID: 2, RoleID: 2}))
+	assert.Error(t, u.RevokeToken(context.Background(), domain.User{}))
+}
+
+func Test_randomCalendarToken(t *testing.T) {
+	first, err := randomCalendarToken()
+	assert.NoError(t, err)
+
+	second, err := randomCalendarToken()
+	assert.NoError(t, err)
+
+	assert.Len(t, first, 43)
+	assert.NotEqual(t, first, second)
+}
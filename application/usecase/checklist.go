@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"time"
+)
+
+type checklistUseCase struct {
+	creator   domain.ChecklistItemCreator
+	retriever domain.ChecklistItemRetriever
+	updater   domain.ChecklistItemUpdater
+	templates domain.ChecklistTemplateRetriever
+	tasks     domain.TaskRetriever
+	now       func() time.Time
+}
+
+func NewChecklist(
+	creator domain.ChecklistItemCreator,
+	retriever domain.ChecklistItemRetriever,
+	updater domain.ChecklistItemUpdater,
+	templates domain.ChecklistTemplateRetriever,
+	tasks domain.TaskRetriever,
+) (domain.ChecklistUsecase, error) {
+	if creator == nil {
+		return &checklistUseCase{}, errors.New("checklist item creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &checklistUseCase{}, errors.New("checklist item retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &checklistUseCase{}, errors.New("checklist item updater must not be nil")
+	}
+
+	if templates == nil {
+		return &checklistUseCase{}, errors.New("checklist template retriever must not be nil")
+	}
+
+	if tasks == nil {
+		return &checklistUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	return &checklistUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		templates: templates,
+		tasks:     tasks,
+		now:       time.Now,
+	}, nil
+}
+
+func (u *checklistUseCase) Attach(ctx context.Context, taskID, templateID int64, user domain.User) ([]domain.ChecklistItem, error) {
+	if taskID == 0 {
+		return []domain.ChecklistItem{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	template, err := u.templates.ListByID(ctx, templateID)
+	if err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	current, err := u.retriever.ListByTaskID(ctx, taskID)
+	if err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	for _, item := range current {
+		if item.TemplateID == template.ID {
+			return []domain.ChecklistItem{}, fmt.Errorf("%w: template %d is already attached", domain.ErrInvalidChecklist, template.ID)
+		}
+	}
+
+	if err := u.creator.Add(ctx, taskID, template.ItemsFor(taskID)); err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *checklistUseCase) ListByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.ChecklistItem, error) {
+	if taskID == 0 {
+		return []domain.ChecklistItem{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+			return []domain.ChecklistItem{}, err
+		}
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *checklistUseCase) Check(ctx context.Context, taskID, itemID int64, checked bool, user domain.User) (domain.ChecklistItem, error) {
+	if taskID == 0 || itemID == 0 {
+		return domain.ChecklistItem{}, errors.New("task and item IDs must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	task, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	if task.Status == domain.TaskStatusCompleted {
+		return domain.ChecklistItem{}, fmt.Errorf("%w: task is already completed", domain.ErrInvalidChecklist)
+	}
+
+	item, err := u.retriever.ListByID(ctx, itemID)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	if item.TaskID != taskID {
+		return domain.ChecklistItem{}, domain.ErrChecklistItemNotFound
+	}
+
+	if checked == item.IsChecked() {
+		return item, nil
+	}
+
+	item.CheckedBy = 0
+	item.CheckedAt = nil
+
+	if checked {
+		now := u.now().UTC()
+		item.CheckedBy = user.ID
+		item.CheckedAt = &now
+	}
+
+	if err := u.updater.Update(ctx, item); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	return item, nil
+}
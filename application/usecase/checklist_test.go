@@ -0,0 +1,249 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockChecklistItemCreator(ctrl)
+	retriever := domain.NewMockChecklistItemRetriever(ctrl)
+	updater := domain.NewMockChecklistItemUpdater(ctrl)
+	templates := domain.NewMockChecklistTemplateRetriever(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+
+	type args struct {
+		creator   domain.ChecklistItemCreator
+		retriever domain.ChecklistItemRetriever
+		updater   domain.ChecklistItemUpdater
+		templates domain.ChecklistTemplateRetriever
+		tasks     domain.TaskRetriever
+	}
+
+	valid := args{creator, retriever, updater, templates, tasks}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without template retriever", args: func() args { a := valid; a.templates = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewChecklist(a.creator, a.retriever, a.updater, a.templates, a.tasks)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklist() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type checklistDependencies struct {
+	creator   *domain.MockChecklistItemCreator
+	retriever *domain.MockChecklistItemRetriever
+	updater   *domain.MockChecklistItemUpdater
+	templates *domain.MockChecklistTemplateRetriever
+	tasks     *domain.MockTaskRetriever
+}
+
+func newChecklistUseCase(ctrl *gomock.Controller, now time.Time) (*checklistUseCase, checklistDependencies) {
+	d := checklistDependencies{
+		creator:   domain.NewMockChecklistItemCreator(ctrl),
+		retriever: domain.NewMockChecklistItemRetriever(ctrl),
+		updater:   domain.NewMockChecklistItemUpdater(ctrl),
+		templates: domain.NewMockChecklistTemplateRetriever(ctrl),
+		tasks:     domain.NewMockTaskRetriever(ctrl),
+	}
+
+	return &checklistUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		updater:   d.updater,
+		templates: d.templates,
+		tasks:     d.tasks,
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_checklistUseCase_Attach(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		template   = domain.ChecklistTemplate{ID: 3, Name: "Electrical safety", Items: []domain.ChecklistTemplateItem{
+			{Label: "Isolate power", Required: true},
+			{Label: "Test RCD", Required: true},
+		}}
+		attached = []domain.ChecklistItem{
+			{ID: 10, TaskID: 1, TemplateID: 3, Position: 1, Label: "Isolate power", Required: true},
+			{ID: 11, TaskID: 1, TemplateID: 3, Position: 2, Label: "Test RCD", Required: true},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *checklistDependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to attach a checklist",
+			user:    technician,
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect error when template does not exist",
+			user: manager,
+			setDependencies: func(d *checklistDependencies) {
+				d.templates.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.ChecklistTemplate{}, domain.ErrChecklistTemplateNotFound)
+			},
+			wantErr: domain.ErrChecklistTemplateNotFound,
+		},
+		{
+			name: "Expect error when template is already attached",
+			user: manager,
+			setDependencies: func(d *checklistDependencies) {
+				d.templates.EXPECT().ListByID(context.Background(), int64(3)).Return(template, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(attached, nil)
+			},
+			wantErr: domain.ErrInvalidChecklist,
+		},
+		{
+			name: "Expect template items to be copied to the task",
+			user: manager,
+			setDependencies: func(d *checklistDependencies) {
+				d.templates.EXPECT().ListByID(context.Background(), int64(3)).Return(template, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(nil, nil)
+				d.creator.EXPECT().Add(context.Background(), int64(1), []domain.ChecklistItem{
+					{TaskID: 1, TemplateID: 3, Position: 1, Label: "Isolate power", Required: true},
+					{TaskID: 1, TemplateID: 3, Position: 2, Label: "Test RCD", Required: true},
+				}).Return(nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(attached, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newChecklistUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Attach(context.Background(), 1, 3, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Attach() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, attached, got)
+		})
+	}
+}
+
+func Test_checklistUseCase_Check(t *testing.T) {
+	var (
+		now       = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		user      = domain.User{ID: 2, RoleID: 2}
+		task      = domain.Task{ID: 1, UserID: 2, Status: domain.TaskStatusInProgress}
+		item      = domain.ChecklistItem{ID: 10, TaskID: 1, TemplateID: 3, Label: "Isolate power", Required: true}
+		checked   = domain.ChecklistItem{ID: 10, TaskID: 1, TemplateID: 3, Label: "Isolate power", Required: true, CheckedBy: 2, CheckedAt: &now}
+		completed = domain.Task{ID: 1, UserID: 2, Status: domain.TaskStatusCompleted}
+	)
+
+	tests := []struct {
+		name            string
+		checked         bool
+		setDependencies func(d *checklistDependencies)
+		want            domain.ChecklistItem
+		wantErr         error
+	}{
+		{
+			name:    "Expect error when task is not assigned to the technician",
+			checked: true,
+			setDependencies: func(d *checklistDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name:    "Expect error when task is completed",
+			checked: false,
+			setDependencies: func(d *checklistDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(completed, nil)
+			},
+			wantErr: domain.ErrInvalidChecklist,
+		},
+		{
+			name:    "Expect error when item belongs to another task",
+			checked: true,
+			setDependencies: func(d *checklistDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(10)).Return(domain.ChecklistItem{ID: 10, TaskID: 7}, nil)
+			},
+			wantErr: domain.ErrChecklistItemNotFound,
+		},
+		{
+			name:    "Expect item to be checked",
+			checked: true,
+			setDependencies: func(d *checklistDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(10)).Return(item, nil)
+				d.updater.EXPECT().Update(context.Background(), checked).Return(nil)
+			},
+			want: checked,
+		},
+		{
+			name:    "Expect item to be unchecked",
+			checked: false,
+			setDependencies: func(d *checklistDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(10)).Return(checked, nil)
+				d.updater.EXPECT().Update(context.Background(), item).Return(nil)
+			},
+			want: item,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newChecklistUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Check(context.Background(), 1, 10, tt.checked, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
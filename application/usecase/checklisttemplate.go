@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+)
+
+type checklistTemplateUseCase struct {
+	creator   domain.ChecklistTemplateCreator
+	retriever domain.ChecklistTemplateRetriever
+	updater   domain.ChecklistTemplateUpdater
+	remover   domain.ChecklistTemplateRemover
+}
+
+func NewChecklistTemplate(
+	creator domain.ChecklistTemplateCreator,
+	retriever domain.ChecklistTemplateRetriever,
+	updater domain.ChecklistTemplateUpdater,
+	remover domain.ChecklistTemplateRemover,
+) (domain.ChecklistTemplateUsecase, error) {
+	if creator == nil {
+		return &checklistTemplateUseCase{}, errors.New("checklist template creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &checklistTemplateUseCase{}, errors.New("checklist template retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &checklistTemplateUseCase{}, errors.New("checklist template updater must not be nil")
+	}
+
+	if remover == nil {
+		return &checklistTemplateUseCase{}, errors.New("checklist template remover must not be nil")
+	}
+
+	return &checklistTemplateUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+	}, nil
+}
+
+func (u *checklistTemplateUseCase) Add(ctx context.Context, template domain.ChecklistTemplate, user domain.User) (domain.ChecklistTemplate, error) {
+	if err := validateManager(user); err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	id, err := u.creator.Add(ctx, template)
+	if err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+	template.ID = id
+
+	return template, nil
+}
+
+func (u *checklistTemplateUseCase) List(ctx context.Context, user domain.User) ([]domain.ChecklistTemplate, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.ChecklistTemplate{}, err
+	}
+
+	return u.retriever.List(ctx)
+}
+
+func (u *checklistTemplateUseCase) Update(ctx context.Context, template domain.ChecklistTemplate, user domain.User) (domain.ChecklistTemplate, error) {
+	if template.ID == 0 {
+		return domain.ChecklistTemplate{}, errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	current, err := u.retriever.ListByID(ctx, template.ID)
+	if err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	if template.Name != "" {
+		current.Name = template.Name
+	}
+
+	if len(template.Items) > 0 {
+		current.Items = template.Items
+	}
+
+	if _, err := domain.NewChecklistTemplate(current.Name, current.Items); err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	if err := u.updater.Update(ctx, current); err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	return current, nil
+}
+
+func (u *checklistTemplateUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+	if id == 0 {
+		return errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return err
+	}
+
+	return u.remover.Remove(ctx, id)
+}
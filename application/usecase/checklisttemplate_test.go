@@ -0,0 +1,127 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewChecklistTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockChecklistTemplateCreator(ctrl)
+	retriever := domain.NewMockChecklistTemplateRetriever(ctrl)
+	updater := domain.NewMockChecklistTemplateUpdater(ctrl)
+	remover := domain.NewMockChecklistTemplateRemover(ctrl)
+
+	type args struct {
+		creator   domain.ChecklistTemplateCreator
+		retriever domain.ChecklistTemplateRetriever
+		updater   domain.ChecklistTemplateUpdater
+		remover   domain.ChecklistTemplateRemover
+	}
+
+	valid := args{creator, retriever, updater, remover}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewChecklistTemplate(a.creator, a.retriever, a.updater, a.remover)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklistTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_checklistTemplateUseCase_Update(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockChecklistTemplateRetriever
+		updater   *domain.MockChecklistTemplateUpdater
+	}
+
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		items      = []domain.ChecklistTemplateItem{{Label: "Isolate power", Required: true}, {Label: "Test RCD", Required: true}}
+		current    = domain.ChecklistTemplate{ID: 3, Name: "Electrical safety", Items: items}
+	)
+
+	tests := []struct {
+		name            string
+		template        domain.ChecklistTemplate
+		user            domain.User
+		setDependencies func(d *dependencies)
+		want            domain.ChecklistTemplate
+		wantErr         error
+	}{
+		{
+			name:     "Expect technician not to update a template",
+			template: domain.ChecklistTemplate{ID: 3, Name: "Electrical"},
+			user:     technician,
+			want:     domain.ChecklistTemplate{},
+			wantErr:  domain.ErrUserNotAllowed,
+		},
+		{
+			name:     "Expect error when template does not exist",
+			template: domain.ChecklistTemplate{ID: 3, Name: "Electrical"},
+			user:     manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.ChecklistTemplate{}, domain.ErrChecklistTemplateNotFound)
+			},
+			want:    domain.ChecklistTemplate{},
+			wantErr: domain.ErrChecklistTemplateNotFound,
+		},
+		{
+			name:     "Expect items to be kept when only the name changes",
+			template: domain.ChecklistTemplate{ID: 3, Name: "Electrical"},
+			user:     manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(current, nil)
+				d.updater.EXPECT().Update(context.Background(), domain.ChecklistTemplate{ID: 3, Name: "Electrical", Items: items}).Return(nil)
+			},
+			want: domain.ChecklistTemplate{ID: 3, Name: "Electrical", Items: items},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockChecklistTemplateRetriever(ctrl),
+				updater:   domain.NewMockChecklistTemplateUpdater(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &checklistTemplateUseCase{retriever: d.retriever, updater: d.updater}
+
+			got, err := u.Update(context.Background(), tt.template, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+const commentMessage = "%s: the user %d added the comment %d on the task %d"
+
+type commentUseCase struct {
+	creator   domain.CommentCreator
+	retriever domain.CommentRetriever
+	updater   domain.CommentUpdater
+	remover   domain.CommentRemover
+	tasks     domain.TaskRetriever
+	encryptor domain.SummaryEncryptor
+	notifier  domain.TaskNotifier
+	now       func() time.Time
+}
+
+func NewComment(
+	creator domain.CommentCreator,
+	retriever domain.CommentRetriever,
+	updater domain.CommentUpdater,
+	remover domain.CommentRemover,
+	tasks domain.TaskRetriever,
+	encryptor domain.SummaryEncryptor,
+	notifier domain.TaskNotifier,
+) (domain.CommentUsecase, error) {
+	if creator == nil {
+		return &commentUseCase{}, errors.New("comment creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &commentUseCase{}, errors.New("comment retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &commentUseCase{}, errors.New("comment updater must not be nil")
+	}
+
+	if remover == nil {
+		return &commentUseCase{}, errors.New("comment remover must not be nil")
+	}
+
+	if tasks == nil {
+		return &commentUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if encryptor == nil {
+		return &commentUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	if notifier == nil {
+		return &commentUseCase{}, errors.New("notifier must not be nil")
+	}
+
+	return &commentUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+		tasks:     tasks,
+		encryptor: encryptor,
+		notifier:  notifier,
+		now:       time.Now,
+	}, nil
+}
+
+func (u *commentUseCase) Add(ctx context.Context, comment domain.Comment, user domain.User) (domain.Comment, error) {
+	if err := u.authorize(ctx, comment.TaskID, user); err != nil {
+		return domain.Comment{}, err
+	}
+
+	if comment.UserID != user.ID {
+		return domain.Comment{}, domain.ErrUserNotAllowed
+	}
+
+	if comment.Internal && user.GetRole() != domain.Manager {
+		return domain.Comment{}, domain.ErrUserNotAllowed
+	}
+
+	body := comment.Body
+
+	encrypted, err := u.encryptor.Encrypt(body)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment.Body = encrypted
+	comment.CreatedAt = u.now().UTC()
+	comment.EditedAt = nil
+
+	id, err := u.creator.Add(ctx, comment)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment.ID = id
+	comment.Body = body
+
+	detached(ctx, func(ctx context.Context) {
+		err := u.notifier.SendNotification(ctx, fmt.Sprintf(commentMessage, domain.EventTaskCommented, user.ID, comment.ID, comment.TaskID))
+		if err != nil {
+			log.Printf("error producing notification (comment): %v", err) // Later: send to metrics/observability
+		}
+	})
+
+	return comment, nil
+}
+
+func (u *commentUseCase) ListByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.Comment, error) {
+	if err := u.authorize(ctx, taskID, user); err != nil {
+		return []domain.Comment{}, err
+	}
+
+	comments, err := u.retriever.ListByTaskID(ctx, taskID, user.GetRole() == domain.Manager)
+	if err != nil {
+		return []domain.Comment{}, err
+	}
+
+	var result []domain.Comment
+
+	for _, c := range comments {
+		body, err := u.encryptor.Decrypt(c.Body)
+		if err != nil {
+			log.Printf("error decrypting comment %d: %v", c.ID, err) // Later: send to metrics/observability
+			continue
+		}
+
+		c.Body = body
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+func (u *commentUseCase) Update(ctx context.Context, comment domain.Comment, user domain.User) (domain.Comment, error) {
+	current, err := u.own(ctx, comment.TaskID, comment.ID, user)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	if err := current.Edit(comment.Body, u.now().UTC()); err != nil {
+		return domain.Comment{}, err
+	}
+
+	body := current.Body
+
+	encrypted, err := u.encryptor.Encrypt(body)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	current.Body = encrypted
+
+	if err := u.updater.Update(ctx, current); err != nil {
+		return domain.Comment{}, err
+	}
+
+	current.Body = body
+
+	return current, nil
+}
+
+func (u *commentUseCase) Remove(ctx context.Context, taskID, id int64, user domain.User) error {
+	if _, err := u.own(ctx, taskID, id, user); err != nil {
+		return err
+	}
+
+	return u.remover.Remove(ctx, id)
+}
+
+func (u *commentUseCase) authorize(ctx context.Context, taskID int64, user domain.User) error {
+	if taskID == 0 {
+		return errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return err
+	}
+
+	if user.GetRole() == domain.Manager {
+		_, err := u.tasks.ListByID(ctx, taskID)
+		return err
+	}
+
+	_, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID)
+
+	return err
+}
+
+func (u *commentUseCase) own(ctx context.Context, taskID, id int64, user domain.User) (domain.Comment, error) {
+	if id == 0 {
+		return domain.Comment{}, errors.New("comment ID must not be 0")
+	}
+
+	if err := u.authorize(ctx, taskID, user); err != nil {
+		return domain.Comment{}, err
+	}
+
+	comment, err := u.retriever.ListByID(ctx, id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	if comment.TaskID != taskID || (comment.Internal && user.GetRole() != domain.Manager) {
+		return domain.Comment{}, domain.ErrCommentNotFound
+	}
+
+	if comment.UserID != user.ID {
+		return domain.Comment{}, domain.ErrUserNotAllowed
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,304 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockCommentCreator(ctrl)
+	retriever := domain.NewMockCommentRetriever(ctrl)
+	updater := domain.NewMockCommentUpdater(ctrl)
+	remover := domain.NewMockCommentRemover(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+	notifier := domain.NewMockTaskNotifier(ctrl)
+
+	type args struct {
+		creator   domain.CommentCreator
+		retriever domain.CommentRetriever
+		updater   domain.CommentUpdater
+		remover   domain.CommentRemover
+		tasks     domain.TaskRetriever
+		encryptor domain.SummaryEncryptor
+		notifier  domain.TaskNotifier
+	}
+
+	valid := args{creator, retriever, updater, remover, tasks, encryptor, notifier}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without notifier", args: func() args { a := valid; a.notifier = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewComment(a.creator, a.retriever, a.updater, a.remover, a.tasks, a.encryptor, a.notifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewComment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type commentDependencies struct {
+	creator   *domain.MockCommentCreator
+	retriever *domain.MockCommentRetriever
+	updater   *domain.MockCommentUpdater
+	remover   *domain.MockCommentRemover
+	tasks     *domain.MockTaskRetriever
+	encryptor *domain.MockSummaryEncryptor
+	notifier  *domain.MockTaskNotifier
+}
+
+func newCommentUseCase(ctrl *gomock.Controller, now time.Time) (*commentUseCase, commentDependencies) {
+	d := commentDependencies{
+		creator:   domain.NewMockCommentCreator(ctrl),
+		retriever: domain.NewMockCommentRetriever(ctrl),
+		updater:   domain.NewMockCommentUpdater(ctrl),
+		remover:   domain.NewMockCommentRemover(ctrl),
+		tasks:     domain.NewMockTaskRetriever(ctrl),
+		encryptor: domain.NewMockSummaryEncryptor(ctrl),
+		notifier:  domain.NewMockTaskNotifier(ctrl),
+	}
+
+	return &commentUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		updater:   d.updater,
+		remover:   d.remover,
+		tasks:     d.tasks,
+		encryptor: d.encryptor,
+		notifier:  d.notifier,
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_commentUseCase_Add(t *testing.T) {
+	var (
+		now        = time.Date(2023, 12, 3, 10, 0, 0, 0, time.UTC)
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+	)
+
+	tests := []struct {
+		name             string
+		comment          domain.Comment
+		user             domain.User
+		setDependencies  func(d *commentDependencies)
+		want             domain.Comment
+		wantNotification string
+		wantErr          error
+	}{
+		{
+			name:    "Expect error when technician comments on a task of someone else",
+			comment: domain.Comment{TaskID: 1, UserID: 2, Body: "Done"},
+			user:    technician,
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name:    "Expect technician not to add internal notes",
+			comment: domain.Comment{TaskID: 1, UserID: 2, Body: "Done", Internal: true},
+			user:    technician,
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{ID: 1, UserID: 2}, nil)
+			},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect encrypted internal note and a notification",
+			comment: domain.Comment{TaskID: 1, UserID: 1, Body: "Customer is late on payments", Internal: true},
+			user:    manager,
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByID(context.Background(), int64(1)).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.encryptor.EXPECT().Encrypt("Customer is late on payments").Return("encrypted", nil)
+				d.creator.EXPECT().Add(context.Background(), domain.Comment{TaskID: 1, UserID: 1, Body: "encrypted", Internal: true, CreatedAt: now}).Return(int64(9), nil)
+			},
+			want:             domain.Comment{ID: 9, TaskID: 1, UserID: 1, Body: "Customer is late on payments", Internal: true, CreatedAt: now},
+			wantNotification: "task.commented: the user 1 added the comment 9 on the task 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newCommentUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			// The notification is sent in the background, so its failure does
+			// not fail the comment.
+			var wg sync.WaitGroup
+			if tt.wantNotification != "" {
+				wg.Add(1)
+				d.notifier.EXPECT().SendNotification(gomock.Any(), tt.wantNotification).
+					DoAndReturn(func(ctx context.Context, body string) error {
+						defer wg.Done()
+						return errors.New("err")
+					})
+			}
+
+			got, err := u.Add(context.Background(), tt.comment, tt.user)
+			wg.Wait()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_commentUseCase_ListByTask(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		comments   = []domain.Comment{{ID: 1, TaskID: 1, Body: "first"}, {ID: 2, TaskID: 1, Body: "broken"}}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *commentDependencies)
+		want            []domain.Comment
+	}{
+		{
+			name: "Expect internal notes to be hidden from technicians",
+			user: technician,
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1), false).Return(comments[:1], nil)
+				d.encryptor.EXPECT().Decrypt("first").Return("First", nil)
+			},
+			want: []domain.Comment{{ID: 1, TaskID: 1, Body: "First"}},
+		},
+		{
+			name: "Expect managers to see internal notes and undecryptable comments to be skipped",
+			user: manager,
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByID(context.Background(), int64(1)).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1), true).Return(comments, nil)
+				d.encryptor.EXPECT().Decrypt("first").Return("First", nil)
+				d.encryptor.EXPECT().Decrypt("broken").Return("", errors.New("err"))
+			},
+			want: []domain.Comment{{ID: 1, TaskID: 1, Body: "First"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newCommentUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.ListByTask(context.Background(), 1, tt.user)
+			if err != nil {
+				t.Errorf("ListByTask() error = %v", err)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_commentUseCase_Update(t *testing.T) {
+	var (
+		now        = time.Date(2023, 12, 3, 10, 0, 0, 0, time.UTC)
+		technician = domain.User{ID: 2, RoleID: 2}
+		task       = domain.Task{ID: 1, UserID: 2}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *commentDependencies)
+		want            domain.Comment
+		wantErr         error
+	}{
+		{
+			name: "Expect error when comment belongs to another task",
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(5)).Return(domain.Comment{ID: 5, TaskID: 7, UserID: 2}, nil)
+			},
+			wantErr: domain.ErrCommentNotFound,
+		},
+		{
+			name: "Expect internal notes not to be found by technicians",
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(5)).Return(domain.Comment{ID: 5, TaskID: 1, UserID: 1, Internal: true}, nil)
+			},
+			wantErr: domain.ErrCommentNotFound,
+		},
+		{
+			name: "Expect only the author to edit a comment",
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(5)).Return(domain.Comment{ID: 5, TaskID: 1, UserID: 1}, nil)
+			},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect edited comment to be encrypted and stamped",
+			setDependencies: func(d *commentDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), int64(5)).Return(domain.Comment{ID: 5, TaskID: 1, UserID: 2, Body: "old"}, nil)
+				d.encryptor.EXPECT().Encrypt("Breaker replaced").Return("encrypted", nil)
+				d.updater.EXPECT().Update(context.Background(), domain.Comment{ID: 5, TaskID: 1, UserID: 2, Body: "encrypted", EditedAt: &now}).Return(nil)
+			},
+			want: domain.Comment{ID: 5, TaskID: 1, UserID: 2, Body: "Breaker replaced", EditedAt: &now},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newCommentUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Update(context.Background(), domain.Comment{ID: 5, TaskID: 1, Body: "Breaker replaced"}, technician)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+)
+
+type customerUseCase struct {
+	creator   domain.CustomerCreator
+	retriever domain.CustomerRetriever
+	updater   domain.CustomerUpdater
+	remover   domain.CustomerRemover
+}
+
+func NewCustomer(
+	creator domain.CustomerCreator,
+	retriever domain.CustomerRetriever,
+	updater domain.CustomerUpdater,
+	remover domain.CustomerRemover,
+) (domain.CustomerUsecase, error) {
+	if creator == nil {
+		return &customerUseCase{}, errors.New("customer creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &customerUseCase{}, errors.New("customer retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &customerUseCase{}, errors.New("customer updater must not be nil")
+	}
+
+	if remover == nil {
+		return &customerUseCase{}, errors.New("customer remover must not be nil")
+	}
+
+	return &customerUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+	}, nil
+}
+
+func (u *customerUseCase) Add(ctx context.Context, customer domain.Customer, user domain.User) (domain.Customer, error) {
+	if err := validateManager(user); err != nil {
+		return domain.Customer{}, err
+	}
+
+	id, err := u.creator.Add(ctx, customer)
+	if err != nil {
+		return domain.Customer{}, err
+	}
+	customer.ID = id
+
+	return customer, nil
+}
+
+func (u *customerUseCase) List(ctx context.Context, user domain.User) ([]domain.Customer, error) {
+	if err := validateManager(user); err != nil {
+		return []domain.Customer{}, err
+	}
+
+	return u.retriever.List(ctx)
+}
+
+func (u *customerUseCase) Update(ctx context.Context, customer domain.Customer, user domain.User) (domain.Customer, error) {
+	if customer.ID == 0 {
+		return domain.Customer{}, errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return domain.Customer{}, err
+	}
+
+	current, err := u.retriever.ListByID(ctx, customer.ID)
+	if err != nil {
+		return domain.Customer{}, err
+	}
+
+	if customer.Name != "" {
+		current.Name = customer.Name
+	}
+
+	if customer.Email != "" {
+		current.Email = customer.Email
+	}
+
+	if customer.Phone != "" {
+		current.Phone = customer.Phone
+	}
+
+	if _, err := domain.NewCustomer(current.Name, current.Email, current.Phone); err != nil {
+		return domain.Customer{}, err
+	}
+
+	if err := u.updater.Update(ctx, current); err != nil {
+		return domain.Customer{}, err
+	}
+
+	return current, nil
+}
+
+func (u *customerUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+	if id == 0 {
+		return errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return err
+	}
+
+	return u.remover.Remove(ctx, id)
+}
+
+func validateManager(user domain.User) error {
+	if err := validateUser(user); err != nil {
+		return err
+	}
+
+	if user.GetRole() != domain.Manager {
+		return domain.ErrUserNotAllowed
+	}
+
+	return nil
+}
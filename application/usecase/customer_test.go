@@ -0,0 +1,126 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockCustomerCreator(ctrl)
+	retriever := domain.NewMockCustomerRetriever(ctrl)
+	updater := domain.NewMockCustomerUpdater(ctrl)
+	remover := domain.NewMockCustomerRemover(ctrl)
+
+	type args struct {
+		creator   domain.CustomerCreator
+		retriever domain.CustomerRetriever
+		updater   domain.CustomerUpdater
+		remover   domain.CustomerRemover
+	}
+
+	valid := args{creator, retriever, updater, remover}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewCustomer(a.creator, a.retriever, a.updater, a.remover)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCustomer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_customerUseCase_Update(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockCustomerRetriever
+		updater   *domain.MockCustomerUpdater
+	}
+
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		current    = domain.Customer{ID: 3, Name: "ACME", Email: "contact@acme.com"}
+	)
+
+	tests := []struct {
+		name            string
+		customer        domain.Customer
+		user            domain.User
+		setDependencies func(d *dependencies)
+		want            domain.Customer
+		wantErr         error
+	}{
+		{
+			name:     "Expect technician not to update a customer",
+			customer: domain.Customer{ID: 3, Name: "ACME Corp"},
+			user:     technician,
+			want:     domain.Customer{},
+			wantErr:  domain.ErrUserNotAllowed,
+		},
+		{
+			name:     "Expect error when customer does not exist",
+			customer: domain.Customer{ID: 3, Name: "ACME Corp"},
+			user:     manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.Customer{}, domain.ErrCustomerNotFound)
+			},
+			want:    domain.Customer{},
+			wantErr: domain.ErrCustomerNotFound,
+		},
+		{
+			name:     "Expect only the given fields to change",
+			customer: domain.Customer{ID: 3, Phone: "+5511999999999"},
+			user:     manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(current, nil)
+				d.updater.EXPECT().Update(context.Background(), domain.Customer{ID: 3, Name: "ACME", Email: "contact@acme.com", Phone: "+5511999999999"}).Return(nil)
+			},
+			want: domain.Customer{ID: 3, Name: "ACME", Email: "contact@acme.com", Phone: "+5511999999999"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockCustomerRetriever(ctrl),
+				updater:   domain.NewMockCustomerUpdater(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &customerUseCase{retriever: d.retriever, updater: d.updater}
+
+			got, err := u.Update(context.Background(), tt.customer, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
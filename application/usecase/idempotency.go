@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"time"
+)
+
+type idempotencyUseCase struct {
+	creator   domain.IdempotencyCreator
+	retriever domain.IdempotencyRetriever
+	updater   domain.IdempotencyUpdater
+	remover   domain.IdempotencyRemover
+	encryptor domain.SummaryEncryptor
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+func NewIdempotency(
+	creator domain.IdempotencyCreator,
+	retriever domain.IdempotencyRetriever,
+	updater domain.IdempotencyUpdater,
+	remover domain.IdempotencyRemover,
+	encryptor domain.SummaryEncryptor,
+	ttl time.Duration,
+) (domain.IdempotencyUsecase, error) {
+	if creator == nil {
+		return &idempotencyUseCase{}, errors.New("idempotency creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &idempotencyUseCase{}, errors.New("idempotency retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &idempotencyUseCase{}, errors.New("idempotency updater must not be nil")
+	}
+
+	if remover == nil {
+		return &idempotencyUseCase{}, errors.New("idempotency remover must not be nil")
+	}
+
+	if encryptor == nil {
+		return &idempotencyUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	if ttl <= 0 {
+		return &idempotencyUseCase{}, errors.New("ttl must be positive")
+	}
+
+	return &idempotencyUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+		encryptor: encryptor,
+		ttl:       ttl,
+		now:       time.Now,
+	}, nil
+}
+
+// Reserve claims the key for the request. When the key was already used for
+// the same request and that request completed, the stored response is returned
+// with replay set so it can be sent again instead of running the request twice.
+func (u *idempotencyUseCase) Reserve(ctx context.Context, key domain.IdempotencyKey) (domain.IdempotencyKey, bool, error) {
+	now := u.now().UTC()
+
+	key.StatusCode = 0
+	key.ContentType = ""
+	key.Body = ""
+	key.CreatedAt = now
+	key.ExpiresAt = now.Add(u.ttl)
+
+	err := u.creator.Add(ctx, key)
+	if err == nil {
+		return key, false, nil
+	}
+
+	if !errors.Is(err, domain.ErrIdempotencyKeyExists) {
+		return domain.IdempotencyKey{}, false, err
+	}
+
+	stored, err := u.retriever.ListByKey(ctx, key.UserID, key.Key)
+	if err != nil {
+		if errors.Is(err, domain.ErrIdempotencyKeyNotFound) {
+			return domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyInProgress
+		}
+
+		return domain.IdempotencyKey{}, false, err
+	}
+
+	if stored.Expired(now) {
+		if err = u.remover.Remove(ctx, key.UserID, key.Key); err != nil {
+			return domain.IdempotencyKey{}, false, err
+		}
+
+		if err = u.creator.Add(ctx, key); err != nil {
+			if errors.Is(err, domain.ErrIdempotencyKeyExists) {
+				return domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyInProgress
+			}
+
+			return domain.IdempotencyKey{}, false, err
+		}
+
+		return key, false, nil
+	}
+
+	if stored.Fingerprint != key.Fingerprint {
+		return domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyReused
+	}
+
+	if !stored.Completed() {
+		return domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyInProgress
+	}
+
+	if stored.Body != "" {
+		body, err := u.encryptor.Decrypt(stored.Body)
+		if err != nil {
+			return domain.IdempotencyKey{}, false, err
+		}
+
+		stored.Body = body
+	}
+
+	return stored, true, nil
+}
+
+func (u *idempotencyUseCase) Complete(ctx context.Context, key domain.IdempotencyKey) error {
+	if !key.Completed() {
+		return errors.New("status code must not be 0")
+	}
+
+	if key.Body != "" {
+		body, err := u.encryptor.Encrypt(key.Body)
+		if err != nil {
+			return err
+		}
+
+		key.Body = body
+	}
+
+	return u.updater.Complete(ctx, key)
+}
+
+func (u *idempotencyUseCase) Release(ctx context.Context, key domain.IdempotencyKey) error {
+	return u.remover.Remove(ctx, key.UserID, key.Key)
+}
+
+func (u *idempotencyUseCase) Purge(ctx context.Context) (int64, error) {
+	return u.remover.RemoveExpired(ctx, u.now().UTC())
+}
@@ -0,0 +1,236 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewIdempotency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockIdempotencyCreator(ctrl)
+	retriever := domain.NewMockIdempotencyRetriever(ctrl)
+	updater := domain.NewMockIdempotencyUpdater(ctrl)
+	remover := domain.NewMockIdempotencyRemover(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+
+	type args struct {
+		creator   domain.IdempotencyCreator
+		retriever domain.IdempotencyRetriever
+		updater   domain.IdempotencyUpdater
+		remover   domain.IdempotencyRemover
+		encryptor domain.SummaryEncryptor
+		ttl       time.Duration
+	}
+
+	valid := args{creator, retriever, updater, remover, encryptor, 24 * time.Hour}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without ttl", args: func() args { a := valid; a.ttl = 0; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewIdempotency(a.creator, a.retriever, a.updater, a.remover, a.encryptor, a.ttl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewIdempotency() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type idempotencyDependencies struct {
+	creator   *domain.MockIdempotencyCreator
+	retriever *domain.MockIdempotencyRetriever
+	updater   *domain.MockIdempotencyUpdater
+	remover   *domain.MockIdempotencyRemover
+	encryptor *domain.MockSummaryEncryptor
+}
+
+func newIdempotencyUseCase(ctrl *gomock.Controller, now time.Time) (*idempotencyUseCase, idempotencyDependencies) {
+	d := idempotencyDependencies{
+		creator:   domain.NewMockIdempotencyCreator(ctrl),
+		retriever: domain.NewMockIdempotencyRetriever(ctrl),
+		updater:   domain.NewMockIdempotencyUpdater(ctrl),
+		remover:   domain.NewMockIdempotencyRemover(ctrl),
+		encryptor: domain.NewMockSummaryEncryptor(ctrl),
+	}
+
+	return &idempotencyUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		updater:   d.updater,
+		remover:   d.remover,
+		encryptor: d.encryptor,
+		ttl:       24 * time.Hour,
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_idempotencyUseCase_Reserve(t *testing.T) {
+	var (
+		now      = time.Date(2023, 12, 5, 10, 0, 0, 0, time.UTC)
+		request  = domain.IdempotencyKey{UserID: 2, Key: "retry-1", Fingerprint: "abc"}
+		reserved = domain.IdempotencyKey{UserID: 2, Key: "retry-1", Fingerprint: "abc", CreatedAt: now, ExpiresAt: now.Add(24 * time.Hour)}
+		stored   = domain.IdempotencyKey{
+			UserID:      2,
+			Key:         "retry-1",
+			Fingerprint: "abc",
+			StatusCode:  201,
+			ContentType: "application/json; charset=utf-8",
+			Body:        "encrypted",
+			CreatedAt:   now.Add(-time.Hour),
+			ExpiresAt:   now.Add(23 * time.Hour),
+		}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *idempotencyDependencies)
+		want            domain.IdempotencyKey
+		wantReplay      bool
+		wantErr         error
+	}{
+		{
+			name: "Expect new key to be reserved",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(nil)
+			},
+			want: reserved,
+		},
+		{
+			name: "Expect completed request to be replayed",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(domain.ErrIdempotencyKeyExists)
+				d.retriever.EXPECT().ListByKey(context.Background(), int64(2), "retry-1").Return(stored, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return(`{"status":true}`, nil)
+			},
+			want: func() domain.IdempotencyKey {
+				s := stored
+				s.Body = `{"status":true}`
+				return s
+			}(),
+			wantReplay: true,
+		},
+		{
+			name: "Expect key reused with another payload to be rejected",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(domain.ErrIdempotencyKeyExists)
+				d.retriever.EXPECT().ListByKey(context.Background(), int64(2), "retry-1").Return(domain.IdempotencyKey{UserID: 2, Key: "retry-1", Fingerprint: "xyz", ExpiresAt: now.Add(time.Hour)}, nil)
+			},
+			wantErr: domain.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "Expect concurrent retry to be rejected while the first one runs",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(domain.ErrIdempotencyKeyExists)
+				d.retriever.EXPECT().ListByKey(context.Background(), int64(2), "retry-1").Return(domain.IdempotencyKey{UserID: 2, Key: "retry-1", Fingerprint: "abc", ExpiresAt: now.Add(time.Hour)}, nil)
+			},
+			wantErr: domain.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name: "Expect expired key to be reserved again",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(domain.ErrIdempotencyKeyExists)
+				d.retriever.EXPECT().ListByKey(context.Background(), int64(2), "retry-1").Return(domain.IdempotencyKey{UserID: 2, Key: "retry-1", Fingerprint: "xyz", StatusCode: 201, ExpiresAt: now}, nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(2), "retry-1").Return(nil)
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(nil)
+			},
+			want: reserved,
+		},
+		{
+			name: "Expect storage errors to be returned",
+			setDependencies: func(d *idempotencyDependencies) {
+				d.creator.EXPECT().Add(context.Background(), reserved).Return(errors.New("err"))
+			},
+			wantErr: errors.New("err"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newIdempotencyUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, replay, err := u.Reserve(context.Background(), request)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReplay, replay)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_idempotencyUseCase_Complete(t *testing.T) {
+	now := time.Date(2023, 12, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		key             domain.IdempotencyKey
+		setDependencies func(d *idempotencyDependencies)
+		wantErr         bool
+	}{
+		{
+			name:    "Expect error when the response is missing",
+			key:     domain.IdempotencyKey{UserID: 2, Key: "retry-1"},
+			wantErr: true,
+		},
+		{
+			name: "Expect body to be encrypted",
+			key:  domain.IdempotencyKey{UserID: 2, Key: "retry-1", StatusCode: 201, Body: `{"status":true}`},
+			setDependencies: func(d *idempotencyDependencies) {
+				d.encryptor.EXPECT().Encrypt(`{"status":true}`).Return("encrypted", nil)
+				d.updater.EXPECT().Complete(context.Background(), domain.IdempotencyKey{UserID: 2, Key: "retry-1", StatusCode: 201, Body: "encrypted"}).Return(nil)
+			},
+		},
+		{
+			name: "Expect empty body to be stored as is",
+			key:  domain.IdempotencyKey{UserID: 2, Key: "retry-1", StatusCode: 204},
+			setDependencies: func(d *idempotencyDependencies) {
+				d.updater.EXPECT().Complete(context.Background(), domain.IdempotencyKey{UserID: 2, Key: "retry-1", StatusCode: 204}).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newIdempotencyUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			if err := u.Complete(context.Background(), tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("Complete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
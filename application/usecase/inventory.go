@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+const lowStockMessage = "%s: the tech %d has %d left of part %d (%s), minimum is %d"
+
+type inventoryUseCase struct {
+	stock    domain.StockRetriever
+	updater  domain.StockUpdater
+	consumer domain.PartUsageCreator
+	usage    domain.PartUsageRetriever
+	parts    domain.PartRetriever
+	tasks    domain.TaskRetriever
+	notifier domain.TaskNotifier
+	now      func() time.Time
+}
+
+func NewInventory(
+	stock domain.StockRetriever,
+	updater domain.StockUpdater,
+	consumer domain.PartUsageCreator,
+	usage domain.PartUsageRetriever,
+	parts domain.PartRetriever,
+	tasks domain.TaskRetriever,
+	notifier domain.TaskNotifier,
+) (domain.InventoryUsecase, error) {
+	if stock == nil {
+		return &inventoryUseCase{}, errors.New("stock retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &inventoryUseCase{}, errors.New("stock updater must not be nil")
+	}
+
+	if consumer == nil {
+		return &inventoryUseCase{}, errors.New("part usage creator must not be nil")
+	}
+
+	if usage == nil {
+		return &inventoryUseCase{}, errors.New("part usage retriever must not be nil")
+	}
+
+	if parts == nil {
+		return &inventoryUseCase{}, errors.New("part retriever must not be nil")
+	}
+
+	if tasks == nil {
+		return &inventoryUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if notifier == nil {
+		return &inventoryUseCase{}, errors.New("notifier must not be nil")
+	}
+
+	return &inventoryUseCase{
+		stock:    stock,
+		updater:  updater,
+		consumer: consumer,
+		usage:    usage,
+		parts:    parts,
+		tasks:    tasks,
+		notifier: notifier,
+		now:      time.Now,
+	}, nil
+}
+
+func (u *inventoryUseCase) ListStock(ctx context.Context, userID int64, user domain.User) ([]domain.Stock, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.Stock{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if userID != 0 && userID != user.ID {
+			return []domain.Stock{}, domain.ErrUserNotAllowed
+		}
+
+		userID = user.ID
+	}
+
+	return u.stock.ListByUserID(ctx, userID)
+}
+
+func (u *inventoryUseCase) SetStock(ctx context.Context, stock domain.Stock, user domain.User) (domain.Stock, error) {
+	if err := validateManager(user); err != nil {
+		return domain.Stock{}, err
+	}
+
+	part, err := u.parts.ListByID(ctx, stock.Part.ID)
+	if err != nil {
+		return domain.Stock{}, err
+	}
+
+	stock.Part = part
+
+	if err := u.updater.Upsert(ctx, stock); err != nil {
+		return domain.Stock{}, err
+	}
+
+	return stock, nil
+}
+
+func (u *inventoryUseCase) Consume(ctx context.Context, usage domain.PartUsage, user domain.User) (domain.PartUsage, error) {
+	if err := validateUser(user); err != nil {
+		return domain.PartUsage{}, err
+	}
+
+	if usage.UserID != user.ID {
+		return domain.PartUsage{}, domain.ErrUserNotAllowed
+	}
+
+	if _, err := u.tasks.ListByIDAndUserID(ctx, usage.TaskID, user.ID); err != nil {
+		return domain.PartUsage{}, err
+	}
+
+	usage.CreatedAt = u.now().UTC()
+
+	id, stock, err := u.consumer.Consume(ctx, usage)
+	if err != nil {
+		return domain.PartUsage{}, err
+	}
+
+	usage.ID = id
+	usage.Stock = &stock
+
+	if stock.ReachedMinimum(usage.Quantity) {
+		detached(ctx, func(ctx context.Context) {
+			err := u.notifier.SendNotification(ctx, fmt.Sprintf(lowStockMessage, domain.EventStockLow, stock.UserID, stock.Quantity, stock.Part.ID, stock.Part.SKU, stock.MinQuantity))
+			if err != nil {
+				log.Printf("error producing notification (low stock): %v", err) // Later: send to metrics/observability
+			}
+		})
+	}
+
+	return usage, nil
+}
+
+func (u *inventoryUseCase) ListUsageByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.PartUsage, error) {
+	if taskID == 0 {
+		return []domain.PartUsage{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return []domain.PartUsage{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+			return []domain.PartUsage{}, err
+		}
+	}
+
+	return u.usage.ListByTaskID(ctx, taskID)
+}
+
+func (u *inventoryUseCase) UsageReport(ctx context.Context, filter domain.PartUsageFilter, user domain.User) ([]domain.PartUsageReport, error) {
+	if err := validateManager(user); err != nil {
+		return []domain.PartUsageReport{}, err
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.To.After(*filter.From) {
+		return []domain.PartUsageReport{}, fmt.Errorf("%w: to must be after from", domain.ErrInvalidPartUsage)
+	}
+
+	return u.usage.Report(ctx, filter)
+}
@@ -0,0 +1,295 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	stock := domain.NewMockStockRetriever(ctrl)
+	updater := domain.NewMockStockUpdater(ctrl)
+	consumer := domain.NewMockPartUsageCreator(ctrl)
+	usage := domain.NewMockPartUsageRetriever(ctrl)
+	parts := domain.NewMockPartRetriever(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	notifier := domain.NewMockTaskNotifier(ctrl)
+
+	type args struct {
+		stock    domain.StockRetriever
+		updater  domain.StockUpdater
+		consumer domain.PartUsageCreator
+		usage    domain.PartUsageRetriever
+		parts    domain.PartRetriever
+		tasks    domain.TaskRetriever
+		notifier domain.TaskNotifier
+	}
+
+	valid := args{stock, updater, consumer, usage, parts, tasks, notifier}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without stock retriever", args: func() args { a := valid; a.stock = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without stock updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without part usage creator", args: func() args { a := valid; a.consumer = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without part usage retriever", args: func() args { a := valid; a.usage = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without part retriever", args: func() args { a := valid; a.parts = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without notifier", args: func() args { a := valid; a.notifier = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewInventory(a.stock, a.updater, a.consumer, a.usage, a.parts, a.tasks, a.notifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewInventory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type inventoryDependencies struct {
+	stock    *domain.MockStockRetriever
+	updater  *domain.MockStockUpdater
+	consumer *domain.MockPartUsageCreator
+	usage    *domain.MockPartUsageRetriever
+	parts    *domain.MockPartRetriever
+	tasks    *domain.MockTaskRetriever
+	notifier *domain.MockTaskNotifier
+}
+
+func newInventoryUseCase(ctrl *gomock.Controller, now time.Time) (*inventoryUseCase, inventoryDependencies) {
+	d := inventoryDependencies{
+		stock:    domain.NewMockStockRetriever(ctrl),
+		updater:  domain.NewMockStockUpdater(ctrl),
+		consumer: domain.NewMockPartUsageCreator(ctrl),
+		usage:    domain.NewMockPartUsageRetriever(ctrl),
+		parts:    domain.NewMockPartRetriever(ctrl),
+		tasks:    domain.NewMockTaskRetriever(ctrl),
+		notifier: domain.NewMockTaskNotifier(ctrl),
+	}
+
+	return &inventoryUseCase{
+		stock:    d.stock,
+		updater:  d.updater,
+		consumer: d.consumer,
+		usage:    d.usage,
+		parts:    d.parts,
+		tasks:    d.tasks,
+		notifier: d.notifier,
+		now:      func() time.Time { return now },
+	}, d
+}
+
+func Test_inventoryUseCase_ListStock(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+	)
+
+	tests := []struct {
+		name            string
+		userID          int64
+		user            domain.User
+		setDependencies func(d *inventoryDependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to list the stock of someone else",
+			userID:  3,
+			user:    technician,
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect technician to list their own stock",
+			user: technician,
+			setDependencies: func(d *inventoryDependencies) {
+				d.stock.EXPECT().ListByUserID(context.Background(), technician.ID).Return([]domain.Stock{}, nil)
+			},
+		},
+		{
+			name: "Expect manager to list every stock",
+			user: manager,
+			setDependencies: func(d *inventoryDependencies) {
+				d.stock.EXPECT().ListByUserID(context.Background(), int64(0)).Return([]domain.Stock{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newInventoryUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			_, err := u.ListStock(context.Background(), tt.userID, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListStock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_inventoryUseCase_Consume(t *testing.T) {
+	var (
+		now        = time.Date(2023, 12, 4, 10, 0, 0, 0, time.UTC)
+		technician = domain.User{ID: 2, RoleID: 2}
+		part       = domain.Part{ID: 3, SKU: "BRK-20", Name: "Breaker 20A", Unit: "unit"}
+		usage      = domain.PartUsage{TaskID: 1, PartID: 3, UserID: 2, Quantity: 2}
+		recorded   = domain.PartUsage{TaskID: 1, PartID: 3, UserID: 2, Quantity: 2, CreatedAt: now}
+	)
+
+	tests := []struct {
+		name             string
+		usage            domain.PartUsage
+		setDependencies  func(d *inventoryDependencies)
+		want             domain.PartUsage
+		wantNotification string
+		wantErr          error
+	}{
+		{
+			name:    "Expect error when consuming from the van of someone else",
+			usage:   domain.PartUsage{TaskID: 1, PartID: 3, UserID: 3, Quantity: 2},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:  "Expect error when task is not assigned to the technician",
+			usage: usage,
+			setDependencies: func(d *inventoryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name:  "Expect error when stock is insufficient",
+			usage: usage,
+			setDependencies: func(d *inventoryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.consumer.EXPECT().Consume(context.Background(), recorded).Return(int64(0), domain.Stock{}, fmt.Errorf("%w: 1 left of part 3", domain.ErrInsufficientStock))
+			},
+			wantErr: domain.ErrInsufficientStock,
+		},
+		{
+			name:  "Expect no alert while stock is above minimum",
+			usage: usage,
+			setDependencies: func(d *inventoryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.consumer.EXPECT().Consume(context.Background(), recorded).Return(int64(8), domain.Stock{UserID: 2, Part: part, Quantity: 10, MinQuantity: 5}, nil)
+			},
+			want: domain.PartUsage{ID: 8, TaskID: 1, PartID: 3, UserID: 2, Quantity: 2, CreatedAt: now, Stock: &domain.Stock{UserID: 2, Part: part, Quantity: 10, MinQuantity: 5}},
+		},
+		{
+			name:  "Expect low stock alert when reaching minimum",
+			usage: usage,
+			setDependencies: func(d *inventoryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{ID: 1, UserID: 2}, nil)
+				d.consumer.EXPECT().Consume(context.Background(), recorded).Return(int64(8), domain.Stock{UserID: 2, Part: part, Quantity: 4, MinQuantity: 5}, nil)
+			},
+			want:             domain.PartUsage{ID: 8, TaskID: 1, PartID: 3, UserID: 2, Quantity: 2, CreatedAt: now, Stock: &domain.Stock{UserID: 2, Part: part, Quantity: 4, MinQuantity: 5}},
+			wantNotification: "stock.low: the tech 2 has 4 left of part 3 (BRK-20), minimum is 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newInventoryUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			var wg sync.WaitGroup
+			if tt.wantNotification != "" {
+				wg.Add(1)
+				d.notifier.EXPECT().SendNotification(gomock.Any(), tt.wantNotification).
+					DoAndReturn(func(ctx context.Context, body string) error {
+						defer wg.Done()
+						return nil
+					})
+			}
+
+			got, err := u.Consume(context.Background(), tt.usage, technician)
+			wg.Wait()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Consume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inventoryUseCase_UsageReport(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		from       = time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+		to         = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name            string
+		filter          domain.PartUsageFilter
+		user            domain.User
+		setDependencies func(d *inventoryDependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to see the report",
+			user:    technician,
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect error when window is inverted",
+			filter:  domain.PartUsageFilter{From: &to, To: &from},
+			user:    manager,
+			wantErr: domain.ErrInvalidPartUsage,
+		},
+		{
+			name:   "Expect report per customer",
+			filter: domain.PartUsageFilter{From: &from, To: &to, CustomerID: 4},
+			user:   manager,
+			setDependencies: func(d *inventoryDependencies) {
+				d.usage.EXPECT().Report(context.Background(), domain.PartUsageFilter{From: &from, To: &to, CustomerID: 4}).Return([]domain.PartUsageReport{{CustomerID: 4, Quantity: 7}}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newInventoryUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			_, err := u.UsageReport(context.Background(), tt.filter, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UsageReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
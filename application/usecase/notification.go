@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"strings"
+	"time"
+)
+
+const notificationDateLayout = "2006-01-02 15:04 MST"
+
+type notificationUseCase struct {
+	retriever domain.NotificationPreferenceRetriever
+	updater   domain.NotificationPreferenceUpdater
+	templater domain.NotificationTemplater
+	senders   map[string]domain.NotificationSender
+}
+
+func NewNotification(
+	retriever domain.NotificationPreferenceRetriever,
+	updater domain.NotificationPreferenceUpdater,
+	templater domain.NotificationTemplater,
+	senders map[string]domain.NotificationSender,
+) (domain.NotificationUsecase, error) {
+	if retriever == nil {
+		return &notificationUseCase{}, errors.New("notification preference retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &notificationUseCase{}, errors.New("notification preference updater must not be nil")
+	}
+
+	if templater == nil {
+		return &notificationUseCase{}, errors.New("templater must not be nil")
+	}
+
+	if len(senders) == 0 {
+		return &notificationUseCase{}, errors.New("senders must not be empty")
+	}
+
+	return &notificationUseCase{
+		retriever: retriever,
+		updater:   updater,
+		templater: templater,
+		senders:   senders,
+	}, nil
+}
+
+func (u *notificationUseCase) Notify(ctx context.Context, event string, userID int64, data map[string]string) error {
+	if userID == 0 {
+		return errors.New("user ID must not be empty")
+	}
+
+	preference, err := u.retriever.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	message, err := u.templater.Render(event, preference.Locale, localizeDates(data, preference.Location()))
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+
+	for _, channel := range preference.Channels {
+		sender, ok := u.senders[channel]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s: %v", channel, domain.ErrNotificationChannelNotFound))
+			continue
+		}
+
+		to := preference.Recipient(channel)
+		if to == "" {
+			errs = append(errs, fmt.Sprintf("%s: recipient must not be empty", channel))
+			continue
+		}
+
+		if err := sender.Send(ctx, to, message); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", channel, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (u *notificationUseCase) GetPreference(ctx context.Context, user domain.User) (domain.NotificationPreference, error) {
+	if user.ID == 0 {
+		return domain.NotificationPreference{}, errors.New("user ID must not be empty")
+	}
+
+	return u.retriever.ListByUserID(ctx, user.ID)
+}
+
+func (u *notificationUseCase) UpdatePreference(ctx context.Context, preference domain.NotificationPreference, user domain.User) (domain.NotificationPreference, error) {
+	if user.ID == 0 {
+		return domain.NotificationPreference{}, errors.New("user ID must not be empty")
+	}
+
+	if user.ID != preference.UserID {
+		return domain.NotificationPreference{}, domain.ErrUserNotAllowed
+	}
+
+	err := u.updater.Upsert(ctx, preference)
+	if err != nil {
+		return domain.NotificationPreference{}, err
+	}
+
+	return u.retriever.ListByUserID(ctx, user.ID)
+}
+
+func localizeDates(data map[string]string, loc *time.Location) map[string]string {
+	result := make(map[string]string, len(data))
+
+	for k, v := range data {
+		if parsed, err := time.Parse(domain.DateTimeLayout, v); err == nil {
+			v = parsed.In(loc).Format(notificationDateLayout)
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
@@ -0,0 +1,373 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	retriever := domain.NewMockNotificationPreferenceRetriever(ctrl)
+	updater := domain.NewMockNotificationPreferenceUpdater(ctrl)
+	templater := domain.NewMockNotificationTemplater(ctrl)
+	senders := map[string]domain.NotificationSender{
+		domain.EmailChannel: domain.NewMockNotificationSender(ctrl),
+	}
+
+	type args struct {
+		retriever domain.NotificationPreferenceRetriever
+		updater   domain.NotificationPreferenceUpdater
+		templater domain.NotificationTemplater
+		senders   map[string]domain.NotificationSender
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    domain.NotificationUsecase
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without retriever",
+			args: args{
+				retriever: nil,
+				updater:   updater,
+				templater: templater,
+				senders:   senders,
+			},
+			want:    &notificationUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without updater",
+			args: args{
+				retriever: retriever,
+				updater:   nil,
+				templater: templater,
+				senders:   senders,
+			},
+			want:    &notificationUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without templater",
+			args: args{
+				retriever: retriever,
+				updater:   updater,
+				templater: nil,
+				senders:   senders,
+			},
+			want:    &notificationUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without senders",
+			args: args{
+				retriever: retriever,
+				updater:   updater,
+				templater: templater,
+				senders:   map[string]domain.NotificationSender{},
+			},
+			want:    &notificationUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				retriever: retriever,
+				updater:   updater,
+				templater: templater,
+				senders:   senders,
+			},
+			want: &notificationUseCase{
+				retriever: retriever,
+				updater:   updater,
+				templater: templater,
+				senders:   senders,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNotification(tt.args.retriever, tt.args.updater, tt.args.templater, tt.args.senders)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNotification() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_notificationUseCase_Notify(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockNotificationPreferenceRetriever
+		templater *domain.MockNotificationTemplater
+		email     *domain.MockNotificationSender
+		sms       *domain.MockNotificationSender
+	}
+
+	type args struct {
+		ctx    context.Context
+		event  string
+		userID int64
+		data   map[string]string
+	}
+
+	var (
+		data       = map[string]string{"task_id": "1"}
+		message    = domain.Message{Subject: "subject", Body: "body"}
+		preference = domain.NotificationPreference{
+			UserID:   2,
+			Channels: []string{domain.EmailChannel, domain.SMSChannel},
+			Locale:   "pt-BR",
+			Email:    "joe.doe@example.com",
+			Phone:    "+5511999999999",
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		setDependencies func(d *dependencies)
+		wantErr         bool
+	}{
+		{
+			name: "Expect error when user ID is missing",
+			args: args{
+				ctx:   context.Background(),
+				event: domain.EventTaskAssigned,
+				data:  data,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by ListByUserID",
+			args: args{
+				ctx:    context.Background(),
+				event:  domain.EventTaskAssigned,
+				userID: preference.UserID,
+				data:   data,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByUserID(context.Background(), preference.UserID).Return(domain.NotificationPreference{}, errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by Render",
+			args: args{
+				ctx:    context.Background(),
+				event:  domain.EventTaskAssigned,
+				userID: preference.UserID,
+				data:   data,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByUserID(context.Background(), preference.UserID).Return(preference, nil)
+				d.templater.EXPECT().Render(domain.EventTaskAssigned, preference.Locale, data).Return(domain.Message{}, domain.ErrNotificationTemplateNotFound)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when one of the channels fails",
+			args: args{
+				ctx:    context.Background(),
+				event:  domain.EventTaskAssigned,
+				userID: preference.UserID,
+				data:   data,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByUserID(context.Background(), preference.UserID).Return(preference, nil)
+				d.templater.EXPECT().Render(domain.EventTaskAssigned, preference.Locale, data).Return(message, nil)
+				d.email.EXPECT().Send(context.Background(), preference.Email, message).Return(errors.New("err"))
+				d.sms.EXPECT().Send(context.Background(), preference.Phone, message).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				ctx:    context.Background(),
+				event:  domain.EventTaskAssigned,
+				userID: preference.UserID,
+				data:   data,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByUserID(context.Background(), preference.UserID).Return(preference, nil)
+				d.templater.EXPECT().Render(domain.EventTaskAssigned, preference.Locale, data).Return(message, nil)
+				d.email.EXPECT().Send(context.Background(), preference.Email, message).Return(nil)
+				d.sms.EXPECT().Send(context.Background(), preference.Phone, message).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockNotificationPreferenceRetriever(ctrl),
+				templater: domain.NewMockNotificationTemplater(ctrl),
+				email:     domain.NewMockNotificationSender(ctrl),
+				sms:       domain.NewMockNotificationSender(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &notificationUseCase{
+				retriever: d.retriever,
+				templater: d.templater,
+				senders: map[string]domain.NotificationSender{
+					domain.EmailChannel: d.email,
+					domain.SMSChannel:   d.sms,
+				},
+			}
+
+			err := u.Notify(tt.args.ctx, tt.args.event, tt.args.userID, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_notificationUseCase_UpdatePreference(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockNotificationPreferenceRetriever
+		updater   *domain.MockNotificationPreferenceUpdater
+	}
+
+	type args struct {
+		ctx        context.Context
+		preference domain.NotificationPreference
+		user       domain.User
+	}
+
+	var (
+		preference = domain.NotificationPreference{
+			UserID:   2,
+			Channels: []string{domain.EmailChannel},
+			Locale:   domain.DefaultLocale,
+		}
+		technicalUser = domain.User{
+			ID:     2,
+			RoleID: 2,
+		}
+		technicalUser2 = domain.User{
+			ID:     3,
+			RoleID: 2,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		setDependencies func(d *dependencies)
+		want            domain.NotificationPreference
+		wantErr         bool
+	}{
+		{
+			name: "Expect error when user ID is missing",
+			args: args{
+				ctx:        context.Background(),
+				preference: preference,
+			},
+			want:    domain.NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect forbidden error when user updates somebody else preference",
+			args: args{
+				ctx:        context.Background(),
+				preference: preference,
+				user:       technicalUser2,
+			},
+			want:    domain.NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by Upsert",
+			args: args{
+				ctx:        context.Background(),
+				preference: preference,
+				user:       technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.updater.EXPECT().Upsert(context.Background(), preference).Return(errors.New("err"))
+			},
+			want:    domain.NotificationPreference{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				ctx:        context.Background(),
+				preference: preference,
+				user:       technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.updater.EXPECT().Upsert(context.Background(), preference).Return(nil)
+				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID).Return(preference, nil)
+			},
+			want:    preference,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockNotificationPreferenceRetriever(ctrl),
+				updater:   domain.NewMockNotificationPreferenceUpdater(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &notificationUseCase{
+				retriever: d.retriever,
+				updater:   d.updater,
+			}
+
+			got, err := u.UpdatePreference(tt.args.ctx, tt.args.preference, tt.args.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdatePreference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_localizeDates(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	got := localizeDates(map[string]string{
+		"task_id": "1",
+		"date":    "2023-11-15T18:04:00Z",
+	}, loc)
+
+	assert.Equal(t, map[string]string{
+		"task_id": "1",
+		"date":    "2023-11-15 15:04 -03",
+	}, got)
+}
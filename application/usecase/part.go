@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+)
+
+type partUseCase struct {
+	creator   domain.PartCreator
+	retriever domain.PartRetriever
+	updater   domain.PartUpdater
+	remover   domain.PartRemover
+}
+
+func NewPart(
+	creator domain.PartCreator,
+	retriever domain.PartRetriever,
+	updater domain.PartUpdater,
+	remover domain.PartRemover,
+) (domain.PartUsecase, error) {
+	if creator == nil {
+		return &partUseCase{}, errors.New("part creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &partUseCase{}, errors.New("part retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &partUseCase{}, errors.New("part updater must not be nil")
+	}
+
+	if remover == nil {
+		return &partUseCase{}, errors.New("part remover must not be nil")
+	}
+
+	return &partUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+	}, nil
+}
+
+func (u *partUseCase) Add(ctx context.Context, part domain.Part, user domain.User) (domain.Part, error) {
+	if err := validateManager(user); err != nil {
+		return domain.Part{}, err
+	}
+
+	id, err := u.creator.Add(ctx, part)
+	if err != nil {
+		return domain.Part{}, err
+	}
+	part.ID = id
+
+	return part, nil
+}
+
+func (u *partUseCase) List(ctx context.Context, user domain.User) ([]domain.Part, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.Part{}, err
+	}
+
+	return u.retriever.List(ctx)
+}
+
+func (u *partUseCase) Update(ctx context.Context, part domain.Part, user domain.User) (domain.Part, error) {
+	if part.ID == 0 {
+		return domain.Part{}, errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return domain.Part{}, err
+	}
+
+	current, err := u.retriever.ListByID(ctx, part.ID)
+	if err != nil {
+		return domain.Part{}, err
+	}
+
+	if part.SKU != "" {
+		current.SKU = part.SKU
+	}
+
+	if part.Name != "" {
+		current.Name = part.Name
+	}
+
+	if part.Unit != "" {
+		current.Unit = part.Unit
+	}
+
+	if _, err := domain.NewPart(current.SKU, current.Name, current.Unit); err != nil {
+		return domain.Part{}, err
+	}
+
+	if err := u.updater.Update(ctx, current); err != nil {
+		return domain.Part{}, err
+	}
+
+	return current, nil
+}
+
+func (u *partUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+	if id == 0 {
+		return errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return err
+	}
+
+	return u.remover.Remove(ctx, id)
+}
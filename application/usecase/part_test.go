@@ -0,0 +1,126 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockPartCreator(ctrl)
+	retriever := domain.NewMockPartRetriever(ctrl)
+	updater := domain.NewMockPartUpdater(ctrl)
+	remover := domain.NewMockPartRemover(ctrl)
+
+	type args struct {
+		creator   domain.PartCreator
+		retriever domain.PartRetriever
+		updater   domain.PartUpdater
+		remover   domain.PartRemover
+	}
+
+	valid := args{creator, retriever, updater, remover}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewPart(a.creator, a.retriever, a.updater, a.remover)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_partUseCase_Update(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockPartRetriever
+		updater   *domain.MockPartUpdater
+	}
+
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		current    = domain.Part{ID: 3, SKU: "BRK-20", Name: "Breaker", Unit: "unit"}
+	)
+
+	tests := []struct {
+		name            string
+		part            domain.Part
+		user            domain.User
+		setDependencies func(d *dependencies)
+		want            domain.Part
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to update a part",
+			part:    domain.Part{ID: 3, Name: "Breaker 20A"},
+			user:    technician,
+			want:    domain.Part{},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect error when part does not exist",
+			part: domain.Part{ID: 3, Name: "Breaker 20A"},
+			user: manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.Part{}, domain.ErrPartNotFound)
+			},
+			want:    domain.Part{},
+			wantErr: domain.ErrPartNotFound,
+		},
+		{
+			name: "Expect only the given fields to change",
+			part: domain.Part{ID: 3, Unit: "box"},
+			user: manager,
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(3)).Return(current, nil)
+				d.updater.EXPECT().Update(context.Background(), domain.Part{ID: 3, SKU: "BRK-20", Name: "Breaker", Unit: "box"}).Return(nil)
+			},
+			want: domain.Part{ID: 3, SKU: "BRK-20", Name: "Breaker", Unit: "box"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockPartRetriever(ctrl),
+				updater:   domain.NewMockPartUpdater(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &partUseCase{retriever: d.retriever, updater: d.updater}
+
+			got, err := u.Update(context.Background(), tt.part, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"strconv"
+	"time"
+)
+
+const reminderBatchSize = 100
+
+type reminderUseCase struct {
+	creator  domain.ReminderCreator
+	remover  domain.ReminderRemover
+	claimer  domain.ReminderClaimer
+	notifier domain.UserNotifier
+	offsets  []time.Duration
+	owner    string
+	now      func() time.Time
+}
+
+func NewReminder(
+	creator domain.ReminderCreator,
+	remover domain.ReminderRemover,
+	claimer domain.ReminderClaimer,
+	notifier domain.UserNotifier,
+	offsets []time.Duration,
+	owner string,
+) (domain.ReminderUsecase, error) {
+	if creator == nil {
+		return &reminderUseCase{}, errors.New("reminder creator must not be nil")
+	}
+
+	if remover == nil {
+		return &reminderUseCase{}, errors.New("reminder remover must not be nil")
+	}
+
+	if claimer == nil {
+		return &reminderUseCase{}, errors.New("reminder claimer must not be nil")
+	}
+
+	if notifier == nil {
+		return &reminderUseCase{}, errors.New("notifier must not be nil")
+	}
+
+	if len(offsets) == 0 {
+		return &reminderUseCase{}, errors.New("offsets must not be empty")
+	}
+
+	for _, o := range offsets {
+		if o <= 0 {
+			return &reminderUseCase{}, errors.New("offsets must be positive")
+		}
+	}
+
+	if owner == "" {
+		return &reminderUseCase{}, errors.New("owner must not be empty")
+	}
+
+	return &reminderUseCase{
+		creator:  creator,
+		remover:  remover,
+		claimer:  claimer,
+		notifier: notifier,
+		offsets:  offsets,
+		owner:    owner,
+		now:      time.Now,
+	}, nil
+}
+
+func (u *reminderUseCase) Schedule(ctx context.Context, task domain.Task) error {
+	if task.ID == 0 {
+		return errors.New("task ID must not be 0")
+	}
+
+	if err := u.remover.RemovePendingByTaskID(ctx, task.ID); err != nil {
+		return err
+	}
+
+	reminders := domain.NewReminders(task, u.offsets, u.now())
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	return u.creator.Add(ctx, reminders)
+}
+
+func (u *reminderUseCase) Cancel(ctx context.Context, taskID int64) error {
+	if taskID == 0 {
+		return errors.New("task ID must not be 0")
+	}
+
+	return u.remover.RemovePendingByTaskID(ctx, taskID)
+}
+
+func (u *reminderUseCase) Dispatch(ctx context.Context) (int, error) {
+	now := u.now()
+
+	owner := u.claimToken()
+
+	reminders, err := u.claimer.Claim(ctx, owner, now, reminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+
+	for _, r := range reminders {
+		if r.TaskDate.Before(now) {
+			if err := u.claimer.MarkSent(ctx, r.ID, owner); err != nil {
+				log.Printf("error skipping expired reminder %d: %v", r.ID, err) // Later: send to metrics/observability
+			}
+
+			continue
+		}
+
+		data := map[string]string{
+			"task_id": strconv.FormatInt(r.TaskID, 10),
+			"date":    r.TaskDate.UTC().Format(domain.DateTimeLayout),
+		}
+
+		if err := u.notifier.Notify(ctx, domain.EventTaskReminder, r.UserID, data); err != nil {
+			log.Printf("error sending reminder %d: %v", r.ID, err) // Later: send to metrics/observability
+
+			if err := u.claimer.Release(ctx, r.ID, owner); err != nil {
+				log.Printf("error releasing reminder %d: %v", r.ID, err) // Later: send to metrics/observability
+			}
+
+			continue
+		}
+
+		if err := u.claimer.MarkSent(ctx, r.ID, owner); err != nil {
+			log.Printf("error marking reminder %d as sent: %v", r.ID, err) // Later: send to metrics/observability
+			continue
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (u *reminderUseCase) claimToken() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%s:%d", u.owner, u.now().UnixNano())
+	}
+
+	return fmt.Sprintf("%s:%s", u.owner, hex.EncodeToString(b))
+}
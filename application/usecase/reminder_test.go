@@ -0,0 +1,359 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockReminderCreator(ctrl)
+	remover := domain.NewMockReminderRemover(ctrl)
+	claimer := domain.NewMockReminderClaimer(ctrl)
+	notifier := domain.NewMockUserNotifier(ctrl)
+	offsets := []time.Duration{24 * time.Hour, time.Hour}
+
+	type args struct {
+		creator  domain.ReminderCreator
+		remover  domain.ReminderRemover
+		claimer  domain.ReminderClaimer
+		notifier domain.UserNotifier
+		offsets  []time.Duration
+		owner    string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without creator",
+			args: args{
+				creator:  nil,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  offsets,
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without remover",
+			args: args{
+				creator:  creator,
+				remover:  nil,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  offsets,
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without claimer",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  nil,
+				notifier: notifier,
+				offsets:  offsets,
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without notifier",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: nil,
+				offsets:  offsets,
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without offsets",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  nil,
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing with negative offset",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  []time.Duration{-time.Hour},
+				owner:    "pod-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without owner",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  offsets,
+				owner:    "",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				creator:  creator,
+				remover:  remover,
+				claimer:  claimer,
+				notifier: notifier,
+				offsets:  offsets,
+				owner:    "pod-1",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReminder(tt.args.creator, tt.args.remover, tt.args.claimer, tt.args.notifier, tt.args.offsets, tt.args.owner)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewReminder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_reminderUseCase_Schedule(t *testing.T) {
+	type dependencies struct {
+		creator *domain.MockReminderCreator
+		remover *domain.MockReminderRemover
+	}
+
+	var (
+		now  = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		date = now.Add(48 * time.Hour)
+		past = now.Add(-time.Hour)
+		task = domain.Task{
+			ID:     1,
+			Date:   &date,
+			UserID: 2,
+		}
+		offsets = []time.Duration{24 * time.Hour}
+	)
+
+	tests := []struct {
+		name            string
+		task            domain.Task
+		setDependencies func(d *dependencies)
+		wantErr         bool
+	}{
+		{
+			name:    "Expect error when task ID is missing",
+			task:    domain.Task{Date: &date},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by RemovePendingByTaskID",
+			task: task,
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().RemovePendingByTaskID(context.Background(), task.ID).Return(errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect pending reminders to be cancelled when task is in the past",
+			task: domain.Task{ID: 1, Date: &past, UserID: 2},
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().RemovePendingByTaskID(context.Background(), task.ID).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect success",
+			task: task,
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().RemovePendingByTaskID(context.Background(), task.ID).Return(nil)
+				d.creator.EXPECT().Add(context.Background(), []domain.Reminder{
+					{TaskID: 1, UserID: 2, Offset: 24 * time.Hour, RemindAt: date.Add(-24 * time.Hour), TaskDate: date},
+				}).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				creator: domain.NewMockReminderCreator(ctrl),
+				remover: domain.NewMockReminderRemover(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &reminderUseCase{
+				creator: d.creator,
+				remover: d.remover,
+				offsets: offsets,
+				now:     func() time.Time { return now },
+			}
+
+			err := u.Schedule(context.Background(), tt.task)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Schedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_reminderUseCase_Dispatch(t *testing.T) {
+	type dependencies struct {
+		claimer  *domain.MockReminderClaimer
+		notifier *domain.MockUserNotifier
+	}
+
+	var (
+		now      = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		date     = now.Add(time.Hour)
+		reminder = domain.Reminder{
+			ID:       10,
+			TaskID:   1,
+			UserID:   2,
+			Offset:   time.Hour,
+			RemindAt: now,
+			TaskDate: date,
+		}
+		expired = domain.Reminder{
+			ID:       11,
+			TaskID:   3,
+			UserID:   2,
+			Offset:   time.Hour,
+			RemindAt: now.Add(-2 * time.Hour),
+			TaskDate: now.Add(-time.Hour),
+		}
+		data = map[string]string{
+			"task_id": "1",
+			"date":    date.UTC().Format(domain.DateTimeLayout),
+		}
+		owner string
+		claim = func(reminders ...domain.Reminder) func(context.Context, string, time.Time, int) ([]domain.Reminder, error) {
+			return func(_ context.Context, claimOwner string, _ time.Time, _ int) ([]domain.Reminder, error) {
+				owner = claimOwner
+				return reminders, nil
+			}
+		}
+		claimedBy = func(id int64, err error) func(context.Context, int64, string) error {
+			return func(_ context.Context, gotID int64, gotOwner string) error {
+				assert.Equal(t, id, gotID)
+				assert.Equal(t, owner, gotOwner)
+				return err
+			}
+		}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *dependencies)
+		want            int
+		wantErr         bool
+	}{
+		{
+			name: "Expect error thrown by Claim",
+			setDependencies: func(d *dependencies) {
+				d.claimer.EXPECT().Claim(context.Background(), gomock.Any(), now, reminderBatchSize).Return(nil, errors.New("err"))
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Expect reminder to be released when notification fails",
+			setDependencies: func(d *dependencies) {
+				d.claimer.EXPECT().Claim(context.Background(), gomock.Any(), now, reminderBatchSize).DoAndReturn(claim(reminder))
+				d.notifier.EXPECT().Notify(context.Background(), domain.EventTaskReminder, reminder.UserID, data).Return(errors.New("err"))
+				d.claimer.EXPECT().Release(context.Background(), reminder.ID, gomock.Any()).DoAndReturn(claimedBy(reminder.ID, nil))
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Expect expired reminder to be skipped",
+			setDependencies: func(d *dependencies) {
+				d.claimer.EXPECT().Claim(context.Background(), gomock.Any(), now, reminderBatchSize).DoAndReturn(claim(expired))
+				d.claimer.EXPECT().MarkSent(context.Background(), expired.ID, gomock.Any()).DoAndReturn(claimedBy(expired.ID, nil))
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Expect success",
+			setDependencies: func(d *dependencies) {
+				d.claimer.EXPECT().Claim(context.Background(), gomock.Any(), now, reminderBatchSize).DoAndReturn(claim(reminder))
+				d.notifier.EXPECT().Notify(context.Background(), domain.EventTaskReminder, reminder.UserID, data).Return(nil)
+				d.claimer.EXPECT().MarkSent(context.Background(), reminder.ID, gomock.Any()).DoAndReturn(claimedBy(reminder.ID, nil))
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Expect reminder whose claim was lost not to be counted",
+			setDependencies: func(d *dependencies) {
+				d.claimer.EXPECT().Claim(context.Background(), gomock.Any(), now, reminderBatchSize).DoAndReturn(claim(reminder))
+				d.notifier.EXPECT().Notify(context.Background(), domain.EventTaskReminder, reminder.UserID, data).Return(nil)
+				d.claimer.EXPECT().MarkSent(context.Background(), reminder.ID, gomock.Any()).DoAndReturn(claimedBy(reminder.ID, domain.ErrReminderClaimLost))
+			},
+			want:    0,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				claimer:  domain.NewMockReminderClaimer(ctrl),
+				notifier: domain.NewMockUserNotifier(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &reminderUseCase{
+				claimer:  d.claimer,
+				notifier: d.notifier,
+				owner:    "pod-1",
+				now:      func() time.Time { return now },
+			}
+
+			got, err := u.Dispatch(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Dispatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
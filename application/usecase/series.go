@@ -0,0 +1,504 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+type taskSeriesUseCase struct {
+	creator     domain.TaskSeriesCreator
+	retriever   domain.TaskSeriesRetriever
+	updater     domain.TaskSeriesUpdater
+	remover     domain.TaskSeriesRemover
+	occurrences domain.TaskOccurrenceWriter
+	tasks       domain.TaskRetriever
+	encryptor   domain.SummaryEncryptor
+	reminders   domain.ReminderScheduler
+	sites       domain.SiteRetriever
+	transactor  domain.TaskTransactor
+	horizon     time.Duration
+	now         func() time.Time
+}
+
+func NewTaskSeries(
+	creator domain.TaskSeriesCreator,
+	retriever domain.TaskSeriesRetriever,
+	updater domain.TaskSeriesUpdater,
+	remover domain.TaskSeriesRemover,
+	occurrences domain.TaskOccurrenceWriter,
+	tasks domain.TaskRetriever,
+	encryptor domain.SummaryEncryptor,
+	reminders domain.ReminderScheduler,
+	sites domain.SiteRetriever,
+	transactor domain.TaskTransactor,
+	horizon time.Duration,
+) (domain.TaskSeriesUsecase, error) {
+	if creator == nil {
+		return &taskSeriesUseCase{}, errors.New("task series creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &taskSeriesUseCase{}, errors.New("task series retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &taskSeriesUseCase{}, errors.New("task series updater must not be nil")
+	}
+
+	if remover == nil {
+		return &taskSeriesUseCase{}, errors.New("task series remover must not be nil")
+	}
+
+	if occurrences == nil {
+		return &taskSeriesUseCase{}, errors.New("task occurrence writer must not be nil")
+	}
+
+	if tasks == nil {
+		return &taskSeriesUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if encryptor == nil {
+		return &taskSeriesUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	if reminders == nil {
+		return &taskSeriesUseCase{}, errors.New("reminder scheduler must not be nil")
+	}
+
+	if sites == nil {
+		return &taskSeriesUseCase{}, errors.New("site retriever must not be nil")
+	}
+
+	if transactor == nil {
+		return &taskSeriesUseCase{}, errors.New("transactor must not be nil")
+	}
+
+	if horizon <= 0 {
+		return &taskSeriesUseCase{}, errors.New("horizon must be positive")
+	}
+
+	return &taskSeriesUseCase{
+		creator:     creator,
+		retriever:   retriever,
+		updater:     updater,
+		remover:     remover,
+		occurrences: occurrences,
+		tasks:       tasks,
+		encryptor:   encryptor,
+		reminders:   reminders,
+		sites:       sites,
+		transactor:  transactor,
+		horizon:     horizon,
+		now:         time.Now,
+	}, nil
+}
+
+func (u *taskSeriesUseCase) Add(ctx context.Context, series domain.TaskSeries, user domain.User) (domain.TaskSeries, error) {
+	if series.UserID == 0 {
+		return domain.TaskSeries{}, errors.New("UserID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if user.GetRole() != domain.Manager && user.ID != series.UserID {
+		return domain.TaskSeries{}, domain.ErrUserNotAllowed
+	}
+
+	if _, err := domain.ParseRRule(series.RRule); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if err := u.checkSchedule(ctx, series); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	summary := series.Summary
+
+	summaryEncrypted, err := u.encryptor.Encrypt(series.Summary)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+	series.Summary = summaryEncrypted
+
+	id, err := u.creator.Add(ctx, series)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+	series.ID = id
+
+	if _, err := u.generate(ctx, series, u.now().Add(u.horizon)); err != nil {
+		log.Printf("error generating task series %d occurrences (add): %v", series.ID, err) // Later: send to metrics/observability
+	}
+
+	series.Summary = summary
+
+	return series, nil
+}
+
+func (u *taskSeriesUseCase) ListByUser(ctx context.Context, user domain.User) ([]domain.TaskSeries, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.TaskSeries{}, err
+	}
+
+	var (
+		result []domain.TaskSeries
+		err    error
+	)
+
+	if user.GetRole() == domain.Manager {
+		result, err = u.retriever.List(ctx)
+	}
+
+	if user.GetRole() == domain.Technician {
+		result, err = u.retriever.ListByUserID(ctx, user.ID)
+	}
+
+	if err != nil {
+		return []domain.TaskSeries{}, err
+	}
+
+	var decrypted []domain.TaskSeries
+
+	for _, s := range result {
+		summaryDecrypt, err := u.encryptor.Decrypt(s.Summary)
+		if err != nil {
+			log.Printf("error decrypting task series summary: %v", err) // Later: send to metrics/observability
+			continue
+		}
+
+		s.Summary = summaryDecrypt
+		decrypted = append(decrypted, s)
+	}
+
+	return decrypted, nil
+}
+
+func (u *taskSeriesUseCase) Update(ctx context.Context, series domain.TaskSeries, user domain.User) (domain.TaskSeries, error) {
+	if series.ID == 0 {
+		return domain.TaskSeries{}, errors.New("ID must not be 0")
+	}
+
+	current, err := u.authorize(ctx, series.ID, user)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	now := u.now()
+
+	updated, summary, err := u.merge(current, series)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+	updated.GeneratedUntil = &now
+
+	if err := u.checkSchedule(ctx, updated); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.occurrences.RemoveOpenOccurrences(ctx, updated.ID, now); err != nil {
+			return err
+		}
+
+		return u.updater.Update(ctx, updated)
+	})
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if _, err := u.generate(ctx, updated, now.Add(u.horizon)); err != nil {
+		log.Printf("error generating task series %d occurrences (update): %v", updated.ID, err) // Later: send to metrics/observability
+	}
+
+	updated.Summary = summary
+
+	return updated, nil
+}
+
+func (u *taskSeriesUseCase) UpdateFromOccurrence(ctx context.Context, taskID int64, series domain.TaskSeries, user domain.User) (domain.TaskSeries, error) {
+	if taskID == 0 {
+		return domain.TaskSeries{}, errors.New("task ID must not be 0")
+	}
+
+	if series.ID == 0 {
+		return domain.TaskSeries{}, errors.New("ID must not be 0")
+	}
+
+	current, err := u.authorize(ctx, series.ID, user)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	task, err := u.tasks.ListByIDAndUserID(ctx, taskID, current.UserID)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if task.SeriesID != current.ID || task.OccurrenceAt == nil {
+		return domain.TaskSeries{}, domain.ErrTaskNotInTaskSeries
+	}
+
+	from := *task.OccurrenceAt
+
+	rule, err := domain.ParseRRule(current.RRule)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	before := len(rule.Occurrences(current.LocalStart(), current.Start, from))
+	if before == 0 {
+		return u.Update(ctx, series, user)
+	}
+
+	remaining := rule
+	if rule.Count > 0 {
+		remaining.Count = rule.Count - before
+		rule.Count = before
+	} else {
+		until := from.Add(-time.Second)
+		rule.Until = &until
+	}
+
+	if series.RRule == "" {
+		series.RRule = remaining.String()
+	}
+
+	if series.Start.IsZero() {
+		series.Start = from
+	}
+
+	next, summary, err := u.merge(current, series)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+	next.ID = 0
+	next.GeneratedUntil = nil
+
+	if err := u.checkSchedule(ctx, next); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	current.RRule = rule.String()
+
+	// Occurrences from the split on that were already started or completed
+	// move to the new series, so generating it does not schedule them again.
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.occurrences.RemoveOpenOccurrences(ctx, current.ID, from); err != nil {
+			return err
+		}
+
+		if err := u.updater.Update(ctx, current); err != nil {
+			return err
+		}
+
+		id, err := u.creator.Add(ctx, next)
+		if err != nil {
+			return err
+		}
+		next.ID = id
+
+		return u.occurrences.MoveOccurrences(ctx, current.ID, next.ID, from)
+	})
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if _, err := u.generate(ctx, next, u.now().Add(u.horizon)); err != nil {
+		log.Printf("error generating task series %d occurrences (split): %v", next.ID, err) // Later: send to metrics/observability
+	}
+
+	next.Summary = summary
+
+	return next, nil
+}
+
+func (u *taskSeriesUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+	if id == 0 {
+		return errors.New("ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return err
+	}
+
+	if user.GetRole() == domain.Technician {
+		return domain.ErrUserNotAllowed
+	}
+
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.occurrences.RemoveOpenOccurrences(ctx, id, u.now()); err != nil {
+			return err
+		}
+
+		return u.remover.Remove(ctx, id)
+	})
+}
+
+func (u *taskSeriesUseCase) Generate(ctx context.Context) (int, error) {
+	until := u.now().Add(u.horizon)
+
+	pending, err := u.retriever.ListPending(ctx, until)
+	if err != nil {
+		return 0, err
+	}
+
+	var generated int
+
+	for _, s := range pending {
+		n, err := u.generate(ctx, s, until)
+		if err != nil {
+			log.Printf("error generating task series %d occurrences: %v", s.ID, err) // Later: send to metrics/observability
+		}
+
+		generated += n
+	}
+
+	return generated, nil
+}
+
+func (u *taskSeriesUseCase) generate(ctx context.Context, series domain.TaskSeries, until time.Time) (int, error) {
+	rule, err := domain.ParseRRule(series.RRule)
+	if err != nil {
+		return 0, err
+	}
+
+	from := series.Start
+	if series.GeneratedUntil != nil && series.GeneratedUntil.After(from) {
+		from = *series.GeneratedUntil
+	}
+
+	var generated int
+
+	for _, occurrence := range rule.Occurrences(series.LocalStart(), from, until) {
+		task := series.Occurrence(occurrence.UTC())
+
+		id, inserted, err := u.occurrences.AddOccurrence(ctx, task)
+		if err != nil {
+			return generated, err
+		}
+
+		if !inserted {
+			continue
+		}
+
+		task.ID = id
+		generated++
+
+		if err := u.reminders.Schedule(ctx, task); err != nil {
+			log.Printf("error scheduling reminders (series): %v", err) // Later: send to metrics/observability
+		}
+	}
+
+	return generated, u.updater.MarkGenerated(ctx, series.ID, until)
+}
+
+func (u *taskSeriesUseCase) authorize(ctx context.Context, id int64, user domain.User) (domain.TaskSeries, error) {
+	if err := validateUser(user); err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	series, err := u.retriever.ListByID(ctx, id)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	if user.GetRole() != domain.Manager && user.ID != series.UserID {
+		return domain.TaskSeries{}, domain.ErrUserNotAllowed
+	}
+
+	return series, nil
+}
+
+func (u *taskSeriesUseCase) merge(current, changes domain.TaskSeries) (domain.TaskSeries, string, error) {
+	if changes.RRule != "" {
+		if _, err := domain.ParseRRule(changes.RRule); err != nil {
+			return domain.TaskSeries{}, "", err
+		}
+
+		current.RRule = changes.RRule
+	}
+
+	if !changes.Start.IsZero() {
+		current.Start = changes.Start
+	}
+
+	if changes.Type != "" {
+		current.Type = changes.Type
+	}
+
+	if changes.Priority != "" {
+		if !domain.IsValidTaskPriority(changes.Priority) {
+			return domain.TaskSeries{}, "", fmt.Errorf("%w: priority must be one of P1, P2, P3, P4", domain.ErrInvalidTaskSeries)
+		}
+
+		current.Priority = changes.Priority
+	}
+
+	if changes.SiteID != 0 {
+		current.SiteID = changes.SiteID
+	}
+
+	if changes.Duration != 0 {
+		current.Duration = changes.Duration
+	}
+
+	if changes.Window != 0 {
+		current.Window = changes.Window
+	}
+
+	if changes.Timezone != "" {
+		if err := domain.ValidateTimezone(changes.Timezone); err != nil {
+			return domain.TaskSeries{}, "", err
+		}
+
+		current.Timezone = changes.Timezone
+	}
+
+	if changes.Summary != "" {
+		summaryEncrypted, err := u.encryptor.Encrypt(changes.Summary)
+		if err != nil {
+			return domain.TaskSeries{}, "", err
+		}
+
+		current.Summary = summaryEncrypted
+	}
+
+	summary, err := u.encryptor.Decrypt(current.Summary)
+	if err != nil {
+		return domain.TaskSeries{}, "", err
+	}
+
+	return current, summary, nil
+}
+
+func (u *taskSeriesUseCase) checkSchedule(ctx context.Context, series domain.TaskSeries) error {
+	if err := series.ValidateSchedule(); err != nil {
+		return err
+	}
+
+	if series.SiteID != 0 {
+		if _, err := u.sites.ListByID(ctx, series.SiteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateUser(user domain.User) error {
+	if user.ID == 0 {
+		return errors.New("user ID must not be empty")
+	}
+
+	if user.RoleID == 0 {
+		return errors.New("user RoleID must not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,381 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewTaskSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockTaskSeriesCreator(ctrl)
+	retriever := domain.NewMockTaskSeriesRetriever(ctrl)
+	updater := domain.NewMockTaskSeriesUpdater(ctrl)
+	remover := domain.NewMockTaskSeriesRemover(ctrl)
+	occurrences := domain.NewMockTaskOccurrenceWriter(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+	reminders := domain.NewMockReminderScheduler(ctrl)
+	sites := domain.NewMockSiteRetriever(ctrl)
+	transactor := domain.NewMockTaskTransactor(ctrl)
+
+	type args struct {
+		creator     domain.TaskSeriesCreator
+		retriever   domain.TaskSeriesRetriever
+		updater     domain.TaskSeriesUpdater
+		remover     domain.TaskSeriesRemover
+		occurrences domain.TaskOccurrenceWriter
+		tasks       domain.TaskRetriever
+		encryptor   domain.SummaryEncryptor
+		reminders   domain.ReminderScheduler
+		sites       domain.SiteRetriever
+		transactor  domain.TaskTransactor
+		horizon     time.Duration
+	}
+
+	valid := args{creator, retriever, updater, remover, occurrences, tasks, encryptor, reminders, sites, transactor, time.Hour}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without occurrence writer", args: func() args { a := valid; a.occurrences = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without reminders", args: func() args { a := valid; a.reminders = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without sites", args: func() args { a := valid; a.sites = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without transactor", args: func() args { a := valid; a.transactor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without horizon", args: func() args { a := valid; a.horizon = 0; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewTaskSeries(a.creator, a.retriever, a.updater, a.remover, a.occurrences, a.tasks, a.encryptor, a.reminders, a.sites, a.transactor, a.horizon)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaskSeries() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type seriesDependencies struct {
+	creator     *domain.MockTaskSeriesCreator
+	retriever   *domain.MockTaskSeriesRetriever
+	updater     *domain.MockTaskSeriesUpdater
+	remover     *domain.MockTaskSeriesRemover
+	occurrences *domain.MockTaskOccurrenceWriter
+	tasks       *domain.MockTaskRetriever
+	encryptor   *domain.MockSummaryEncryptor
+	reminders   *domain.MockReminderScheduler
+	sites       *domain.MockSiteRetriever
+	transactor  *domain.MockTaskTransactor
+}
+
+// runTransaction stands in for a transactor, running fn in the same context.
+func runTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newSeriesUseCase(ctrl *gomock.Controller, now time.Time) (*taskSeriesUseCase, seriesDependencies) {
+	d := seriesDependencies{
+		creator:     domain.NewMockTaskSeriesCreator(ctrl),
+		retriever:   domain.NewMockTaskSeriesRetriever(ctrl),
+		updater:     domain.NewMockTaskSeriesUpdater(ctrl),
+		remover:     domain.NewMockTaskSeriesRemover(ctrl),
+		occurrences: domain.NewMockTaskOccurrenceWriter(ctrl),
+		tasks:       domain.NewMockTaskRetriever(ctrl),
+		encryptor:   domain.NewMockSummaryEncryptor(ctrl),
+		reminders:   domain.NewMockReminderScheduler(ctrl),
+		sites:       domain.NewMockSiteRetriever(ctrl),
+		transactor:  domain.NewMockTaskTransactor(ctrl),
+	}
+
+	return &taskSeriesUseCase{
+		creator:     d.creator,
+		retriever:   d.retriever,
+		updater:     d.updater,
+		remover:     d.remover,
+		occurrences: d.occurrences,
+		tasks:       d.tasks,
+		encryptor:   d.encryptor,
+		reminders:   d.reminders,
+		sites:       d.sites,
+		transactor:  d.transactor,
+		horizon:     72 * time.Hour,
+		now:         func() time.Time { return now },
+	}, d
+}
+
+func Test_taskSeriesUseCase_Generate(t *testing.T) {
+	var (
+		now    = time.Date(2023, 11, 15, 8, 0, 0, 0, time.UTC)
+		until  = now.Add(72 * time.Hour)
+		start  = time.Date(2023, 11, 15, 9, 0, 0, 0, time.UTC)
+		day2   = start.AddDate(0, 0, 1)
+		day3   = start.AddDate(0, 0, 2)
+		series = domain.TaskSeries{ID: 7, Summary: "encrypted", RRule: "FREQ=DAILY", Start: start, UserID: 2, Type: domain.DefaultTaskType}
+	)
+
+	occurrence := func(at time.Time) domain.Task {
+		return domain.Task{
+			Summary:      "encrypted",
+			Date:         &at,
+			UserID:       2,
+			Type:         domain.DefaultTaskType,
+			Priority:     domain.DefaultTaskPriority,
+			Status:       domain.TaskStatusOpen,
+			DueAt:        &at,
+			SeriesID:     7,
+			OccurrenceAt: &at,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *seriesDependencies)
+		want            int
+		wantErr         bool
+	}{
+		{
+			name: "Expect error thrown by ListPending",
+			setDependencies: func(d *seriesDependencies) {
+				d.retriever.EXPECT().ListPending(context.Background(), until).Return(nil, errors.New("err"))
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Expect existing occurrences to be skipped",
+			setDependencies: func(d *seriesDependencies) {
+				d.retriever.EXPECT().ListPending(context.Background(), until).Return([]domain.TaskSeries{series}, nil)
+				d.occurrences.EXPECT().AddOccurrence(context.Background(), occurrence(start)).Return(int64(0), false, nil)
+				d.occurrences.EXPECT().AddOccurrence(context.Background(), occurrence(day2)).Return(int64(0), false, nil)
+				d.occurrences.EXPECT().AddOccurrence(context.Background(), occurrence(day3)).Return(int64(0), false, nil)
+				d.updater.EXPECT().MarkGenerated(context.Background(), series.ID, until).Return(nil)
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Expect occurrences after the generated window only",
+			setDependencies: func(d *seriesDependencies) {
+				generated := series
+				generated.GeneratedUntil = &day2
+
+				created := occurrence(day2)
+				created.ID = 11
+
+				createdLast := occurrence(day3)
+				createdLast.ID = 12
+
+				d.retriever.EXPECT().ListPending(context.Background(), until).Return([]domain.TaskSeries{generated}, nil)
+				d.occurrences.EXPECT().AddOccurrence(context.Background(), occurrence(day2)).Return(int64(11), true, nil)
+				d.reminders.EXPECT().Schedule(context.Background(), created).Return(nil)
+				d.occurrences.EXPECT().AddOccurrence(context.Background(), occurrence(day3)).Return(int64(12), true, nil)
+				d.reminders.EXPECT().Schedule(context.Background(), createdLast).Return(nil)
+				d.updater.EXPECT().MarkGenerated(context.Background(), series.ID, until).Return(nil)
+			},
+			want:    2,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newSeriesUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Generate(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskSeriesUseCase_UpdateFromOccurrence(t *testing.T) {
+	var (
+		errAddSeries = errors.New("err")
+		now          = time.Date(2023, 11, 1, 8, 0, 0, 0, time.UTC)
+		start        = time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+		occurrence   = start.AddDate(0, 0, 14)
+		manager      = domain.User{ID: 1, RoleID: 1}
+		technician   = domain.User{ID: 3, RoleID: 2}
+		series       = domain.TaskSeries{ID: 7, Summary: "encrypted", RRule: "FREQ=WEEKLY;COUNT=5", Start: start, UserID: 2, Type: domain.DefaultTaskType}
+		task         = domain.Task{ID: 40, UserID: 2, SeriesID: 7, OccurrenceAt: &occurrence}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		changes         domain.TaskSeries
+		setDependencies func(d *seriesDependencies)
+		want            domain.TaskSeries
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to edit another user series",
+			user:    technician,
+			changes: domain.TaskSeries{ID: 7, Summary: "new"},
+			setDependencies: func(d *seriesDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), series.ID).Return(series, nil)
+			},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect error when task belongs to another series",
+			user:    manager,
+			changes: domain.TaskSeries{ID: 7, Summary: "new"},
+			setDependencies: func(d *seriesDependencies) {
+				other := task
+				other.SeriesID = 8
+
+				d.retriever.EXPECT().ListByID(context.Background(), series.ID).Return(series, nil)
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), task.ID, series.UserID).Return(other, nil)
+			},
+			wantErr: domain.ErrTaskNotInTaskSeries,
+		},
+		{
+			name:    "Expect series to be split at the occurrence",
+			user:    manager,
+			changes: domain.TaskSeries{ID: 7, Summary: "new"},
+			setDependencies: func(d *seriesDependencies) {
+				head := series
+				head.RRule = "FREQ=WEEKLY;COUNT=2"
+
+				tail := domain.TaskSeries{Summary: "new-encrypted", RRule: "FREQ=WEEKLY;COUNT=3", Start: occurrence, UserID: 2, Type: domain.DefaultTaskType}
+
+				d.retriever.EXPECT().ListByID(context.Background(), series.ID).Return(series, nil)
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), task.ID, series.UserID).Return(task, nil)
+				d.encryptor.EXPECT().Encrypt("new").Return("new-encrypted", nil)
+				d.encryptor.EXPECT().Decrypt("new-encrypted").Return("new", nil)
+				gomock.InOrder(
+					d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction),
+					d.occurrences.EXPECT().RemoveOpenOccurrences(context.Background(), series.ID, occurrence).Return(nil),
+					d.updater.EXPECT().Update(context.Background(), head).Return(nil),
+					d.creator.EXPECT().Add(context.Background(), tail).Return(int64(9), nil),
+					d.occurrences.EXPECT().MoveOccurrences(context.Background(), series.ID, int64(9), occurrence).Return(nil),
+					d.updater.EXPECT().MarkGenerated(context.Background(), int64(9), now.Add(72*time.Hour)).Return(nil),
+				)
+			},
+			want: domain.TaskSeries{ID: 9, Summary: "new", RRule: "FREQ=WEEKLY;COUNT=3", Start: occurrence, UserID: 2, Type: domain.DefaultTaskType},
+		},
+		{
+			name:    "Expect error when the split cannot be saved",
+			user:    manager,
+			changes: domain.TaskSeries{ID: 7, Summary: "new"},
+			setDependencies: func(d *seriesDependencies) {
+				head := series
+				head.RRule = "FREQ=WEEKLY;COUNT=2"
+
+				tail := domain.TaskSeries{Summary: "new-encrypted", RRule: "FREQ=WEEKLY;COUNT=3", Start: occurrence, UserID: 2, Type: domain.DefaultTaskType}
+
+				d.retriever.EXPECT().ListByID(context.Background(), series.ID).Return(series, nil)
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), task.ID, series.UserID).Return(task, nil)
+				d.encryptor.EXPECT().Encrypt("new").Return("new-encrypted", nil)
+				d.encryptor.EXPECT().Decrypt("new-encrypted").Return("new", nil)
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.occurrences.EXPECT().RemoveOpenOccurrences(context.Background(), series.ID, occurrence).Return(nil)
+				d.updater.EXPECT().Update(context.Background(), head).Return(nil)
+				d.creator.EXPECT().Add(context.Background(), tail).Return(int64(0), errAddSeries)
+			},
+			wantErr: errAddSeries,
+		},
+		{
+			name:    "Expect error when the new site does not exist",
+			user:    manager,
+			changes: domain.TaskSeries{ID: 7, SiteID: 4},
+			setDependencies: func(d *seriesDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), series.ID).Return(series, nil)
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), task.ID, series.UserID).Return(task, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("summary", nil)
+				d.sites.EXPECT().ListByID(context.Background(), int64(4)).Return(domain.Site{}, domain.ErrSiteNotFound)
+			},
+			wantErr: domain.ErrSiteNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newSeriesUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.UpdateFromOccurrence(context.Background(), task.ID, tt.changes, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateFromOccurrence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskSeriesUseCase_Remove(t *testing.T) {
+	now := time.Date(2023, 11, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *seriesDependencies)
+		wantErr         bool
+	}{
+		{
+			name:    "Expect technician not to remove a series",
+			user:    domain.User{ID: 2, RoleID: 2},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			user: domain.User{ID: 1, RoleID: 1},
+			setDependencies: func(d *seriesDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.occurrences.EXPECT().RemoveOpenOccurrences(context.Background(), int64(7), now).Return(nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(7)).Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newSeriesUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			if err := u.Remove(context.Background(), 7, tt.user); (err != nil) != tt.wantErr {
+				t.Errorf("Remove() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
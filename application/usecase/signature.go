@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"time"
+)
+
+type signatureUseCase struct {
+	creator    domain.SignatureCreator
+	retriever  domain.SignatureRetriever
+	tasks      domain.TaskRetriever
+	updater    domain.TaskUpdater
+	checklists domain.ChecklistItemRetriever
+	encryptor  domain.SummaryEncryptor
+	transactor domain.TaskTransactor
+	now        func() time.Time
+}
+
+func NewSignature(
+	creator domain.SignatureCreator,
+	retriever domain.SignatureRetriever,
+	tasks domain.TaskRetriever,
+	updater domain.TaskUpdater,
+	checklists domain.ChecklistItemRetriever,
+	encryptor domain.SummaryEncryptor,
+	transactor domain.TaskTransactor,
+) (domain.SignatureUsecase, error) {
+	if creator == nil {
+		return &signatureUseCase{}, errors.New("signature creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &signatureUseCase{}, errors.New("signature retriever must not be nil")
+	}
+
+	if tasks == nil {
+		return &signatureUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &signatureUseCase{}, errors.New("task updater must not be nil")
+	}
+
+	if checklists == nil {
+		return &signatureUseCase{}, errors.New("checklist item retriever must not be nil")
+	}
+
+	if encryptor == nil {
+		return &signatureUseCase{}, errors.New("encryptor must not be nil")
+	}
+
+	if transactor == nil {
+		return &signatureUseCase{}, errors.New("transactor must not be nil")
+	}
+
+	return &signatureUseCase{
+		creator:    creator,
+		retriever:  retriever,
+		tasks:      tasks,
+		updater:    updater,
+		checklists: checklists,
+		encryptor:  encryptor,
+		transactor: transactor,
+		now:        time.Now,
+	}, nil
+}
+
+func (u *signatureUseCase) Sign(ctx context.Context, signature domain.Signature, user domain.User) (domain.Signature, error) {
+	if err := validateUser(user); err != nil {
+		return domain.Signature{}, err
+	}
+
+	// The task is locked until the signature is saved, and the hash is taken
+	// from the stored row, which is what Verify compares it with later.
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := u.tasks.LockByIDAndUserID(ctx, signature.TaskID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.retriever.ListByTaskID(ctx, task.ID)
+		if err == nil {
+			return domain.ErrTaskAlreadySigned
+		}
+
+		if !errors.Is(err, domain.ErrSignatureNotFound) {
+			return err
+		}
+
+		if task.Status != domain.TaskStatusCompleted {
+			checklist, err := u.checklists.ListByTaskID(ctx, task.ID)
+			if err != nil {
+				return err
+			}
+
+			if err := task.Complete(checklist); err != nil {
+				return err
+			}
+
+			if err := u.updater.Update(ctx, task); err != nil {
+				return err
+			}
+
+			if task, err = u.tasks.ListByIDAndUserID(ctx, task.ID, user.ID); err != nil {
+				return err
+			}
+		}
+
+		task, err = u.decrypt(task)
+		if err != nil {
+			return err
+		}
+
+		signature.SignedBy = user.ID
+		signature.SignedAt = u.now().UTC().Truncate(time.Second)
+		signature.TaskHash = signature.Digest(task)
+
+		id, err := u.creator.Add(ctx, signature)
+		if err != nil {
+			return err
+		}
+		signature.ID = id
+
+		return nil
+	})
+	if err != nil {
+		return domain.Signature{}, err
+	}
+
+	return signature, nil
+}
+
+func (u *signatureUseCase) Get(ctx context.Context, taskID int64, user domain.User) (domain.Signature, error) {
+	if _, err := u.task(ctx, taskID, user); err != nil {
+		return domain.Signature{}, err
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *signatureUseCase) Verify(ctx context.Context, taskID int64, user domain.User) (domain.SignatureVerification, error) {
+	task, err := u.task(ctx, taskID, user)
+	if err != nil {
+		return domain.SignatureVerification{}, err
+	}
+
+	signature, err := u.retriever.ListByTaskID(ctx, taskID)
+	if err != nil {
+		return domain.SignatureVerification{}, err
+	}
+
+	task, err = u.decrypt(task)
+	if err != nil {
+		return domain.SignatureVerification{}, err
+	}
+
+	return signature.Verify(task), nil
+}
+
+func (u *signatureUseCase) task(ctx context.Context, taskID int64, user domain.User) (domain.Task, error) {
+	if taskID == 0 {
+		return domain.Task{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.Task{}, err
+	}
+
+	if user.GetRole() == domain.Manager {
+		return u.tasks.ListByID(ctx, taskID)
+	}
+
+	return u.tasks.ListByIDAndUserID(ctx, taskID, user.ID)
+}
+
+func (u *signatureUseCase) decrypt(task domain.Task) (domain.Task, error) {
+	summary, err := u.encryptor.Decrypt(task.Summary)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task.Summary = summary
+
+	return task, nil
+}
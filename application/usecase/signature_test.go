@@ -0,0 +1,289 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockSignatureCreator(ctrl)
+	retriever := domain.NewMockSignatureRetriever(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	updater := domain.NewMockTaskUpdater(ctrl)
+	checklists := domain.NewMockChecklistItemRetriever(ctrl)
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+	transactor := domain.NewMockTaskTransactor(ctrl)
+
+	type args struct {
+		creator    domain.SignatureCreator
+		retriever  domain.SignatureRetriever
+		tasks      domain.TaskRetriever
+		updater    domain.TaskUpdater
+		checklists domain.ChecklistItemRetriever
+		encryptor  domain.SummaryEncryptor
+		transactor domain.TaskTransactor
+	}
+
+	valid := args{creator, retriever, tasks, updater, checklists, encryptor, transactor}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without checklist item retriever", args: func() args { a := valid; a.checklists = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without encryptor", args: func() args { a := valid; a.encryptor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without transactor", args: func() args { a := valid; a.transactor = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewSignature(a.creator, a.retriever, a.tasks, a.updater, a.checklists, a.encryptor, a.transactor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type signatureDependencies struct {
+	creator    *domain.MockSignatureCreator
+	retriever  *domain.MockSignatureRetriever
+	tasks      *domain.MockTaskRetriever
+	updater    *domain.MockTaskUpdater
+	checklists *domain.MockChecklistItemRetriever
+	encryptor  *domain.MockSummaryEncryptor
+	transactor *domain.MockTaskTransactor
+}
+
+func newSignatureUseCase(ctrl *gomock.Controller, now time.Time) (*signatureUseCase, signatureDependencies) {
+	d := signatureDependencies{
+		creator:    domain.NewMockSignatureCreator(ctrl),
+		retriever:  domain.NewMockSignatureRetriever(ctrl),
+		tasks:      domain.NewMockTaskRetriever(ctrl),
+		updater:    domain.NewMockTaskUpdater(ctrl),
+		checklists: domain.NewMockChecklistItemRetriever(ctrl),
+		encryptor:  domain.NewMockSummaryEncryptor(ctrl),
+		transactor: domain.NewMockTaskTransactor(ctrl),
+	}
+
+	return &signatureUseCase{
+		creator:    d.creator,
+		retriever:  d.retriever,
+		tasks:      d.tasks,
+		updater:    d.updater,
+		checklists: d.checklists,
+		encryptor:  d.encryptor,
+		transactor: d.transactor,
+		now:        func() time.Time { return now },
+	}, d
+}
+
+func Test_signatureUseCase_Sign(t *testing.T) {
+	var (
+		now        = time.Date(2023, 12, 2, 10, 0, 0, 500, time.UTC)
+		technician = domain.User{ID: 2, RoleID: 2}
+		task       = domain.Task{ID: 1, UserID: 2, Summary: "encrypted", Status: domain.TaskStatusInProgress}
+		checkedAt  = now.Add(-time.Hour)
+		errSave    = errors.New("err")
+	)
+
+	signature, err := domain.NewSignature(1, "Jane Doe", domain.SignatureFormatStrokes, []byte(`[[{"x":1,"y":2}]]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	completed := task
+	completed.Status = domain.TaskStatusCompleted
+
+	// The stored row carries what the database sets on update.
+	stored := completed
+	stored.CreatedAt = now.Add(-24 * time.Hour).Truncate(time.Second)
+	stored.Version = 2
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *signatureDependencies)
+		want            domain.Task
+		wantErr         error
+	}{
+		{
+			name: "Expect error when task is not assigned to the technician",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name: "Expect error when task is already signed",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{ID: 3}, nil)
+			},
+			wantErr: domain.ErrTaskAlreadySigned,
+		},
+		{
+			name: "Expect error when required checklist items are unchecked",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{}, domain.ErrSignatureNotFound)
+				d.checklists.EXPECT().ListByTaskID(context.Background(), int64(1)).Return([]domain.ChecklistItem{{Label: "Isolate power", Required: true}}, nil)
+			},
+			wantErr: domain.ErrChecklistIncomplete,
+		},
+		{
+			name: "Expect task to be completed before signing",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{}, domain.ErrSignatureNotFound)
+				d.checklists.EXPECT().ListByTaskID(context.Background(), int64(1)).Return([]domain.ChecklistItem{{Label: "Isolate power", Required: true, CheckedBy: 2, CheckedAt: &checkedAt}}, nil)
+				d.updater.EXPECT().Update(context.Background(), completed).Return(nil)
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), technician.ID).Return(stored, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Replace the breaker", nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(5), nil)
+			},
+			want: stored,
+		},
+		{
+			name: "Expect completed task to be signed without being updated",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(completed, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{}, domain.ErrSignatureNotFound)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Replace the breaker", nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(5), nil)
+			},
+			want: completed,
+		},
+		{
+			name: "Expect error when the signature cannot be saved",
+			setDependencies: func(d *signatureDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), technician.ID).Return(completed, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{}, domain.ErrSignatureNotFound)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Replace the breaker", nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(0), errSave)
+			},
+			wantErr: errSave,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newSignatureUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Sign(context.Background(), signature, technician)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Sign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			plain := tt.want
+			plain.Summary = "Replace the breaker"
+
+			assert.Equal(t, int64(5), got.ID)
+			assert.Equal(t, technician.ID, got.SignedBy)
+			assert.Equal(t, now.Truncate(time.Second), got.SignedAt)
+			assert.True(t, got.Verify(plain).Valid)
+		})
+	}
+}
+
+func Test_signatureUseCase_Verify(t *testing.T) {
+	var (
+		manager = domain.User{ID: 1, RoleID: 1}
+		task    = domain.Task{ID: 1, UserID: 2, Summary: "encrypted", Status: domain.TaskStatusCompleted}
+	)
+
+	signature, err := domain.NewSignature(1, "Jane Doe", domain.SignatureFormatStrokes, []byte(`[[{"x":1,"y":2}]]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature.ID = 5
+	signature.SignedBy = 2
+	signature.SignedAt = time.Date(2023, 12, 2, 10, 0, 0, 0, time.UTC)
+	signature.TaskHash = signature.Digest(domain.Task{ID: 1, UserID: 2, Summary: "Replace the breaker", Status: domain.TaskStatusCompleted})
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *signatureDependencies)
+		want            bool
+		wantErr         error
+	}{
+		{
+			name: "Expect error when task is not signed",
+			setDependencies: func(d *signatureDependencies) {
+				d.tasks.EXPECT().ListByID(context.Background(), int64(1)).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(domain.Signature{}, domain.ErrSignatureNotFound)
+			},
+			wantErr: domain.ErrSignatureNotFound,
+		},
+		{
+			name: "Expect valid when task is unchanged",
+			setDependencies: func(d *signatureDependencies) {
+				d.tasks.EXPECT().ListByID(context.Background(), int64(1)).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(signature, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Replace the breaker", nil)
+			},
+			want: true,
+		},
+		{
+			name: "Expect invalid when task was altered after signing",
+			setDependencies: func(d *signatureDependencies) {
+				d.tasks.EXPECT().ListByID(context.Background(), int64(1)).Return(task, nil)
+				d.retriever.EXPECT().ListByTaskID(context.Background(), int64(1)).Return(signature, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Replace the fuse", nil)
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newSignatureUseCase(ctrl, time.Now())
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Verify(context.Background(), 1, manager)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got.Valid)
+		})
+	}
+}
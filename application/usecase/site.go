@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+)
+
+type siteUseCase struct {
+	creator   domain.SiteCreator
+	retriever domain.SiteRetriever
+	updater   domain.SiteUpdater
+	remover   domain.SiteRemover
+	customers domain.CustomerRetriever
+}
+
+func NewSite(
+	creator domain.SiteCreator,
+	retriever domain.SiteRetriever,
+	updater domain.SiteUpdater,
+	remover domain.SiteRemover,
+	customers domain.CustomerRetriever,
+) (domain.SiteUsecase, error) {
+	if creator == nil {
+		return &siteUseCase{}, errors.New("site creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &siteUseCase{}, errors.New("site retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &siteUseCase{}, errors.New("site updater must not be nil")
+	}
+
+	if remover == nil {
+		return &siteUseCase{}, errors.New("site remover must not be nil")
+	}
+
+	if customers == nil {
+		return &siteUseCase{}, errors.New("customer retriever must not be nil")
+	}
+
+	return &siteUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		remover:   remover,
+		customers: customers,
+	}, nil
+}
+
+func (u *siteUseCase) Add(ctx context.Context, site domain.Site, user domain.User) (domain.Site, error) {
+	if err := validateManager(user); err != nil {
+		return domain.Site{}, err
+	}
+
+	if _, err := u.customers.ListByID(ctx, site.CustomerID); err != nil {
+		return domain.Site{}, err
+	}
+
+	id, err := u.creator.Add(ctx, site)
+	if err != nil {
+		return domain.Site{}, err
+	}
+	site.ID = id
+
+	return site, nil
+}
+
+func (u *siteUseCase) List(ctx context.Context, user domain.User, customerID int64) ([]domain.Site, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.Site{}, err
+	}
+
+	return u.retriever.List(ctx, customerID)
+}
+
+func (u *siteUseCase) ListByID(ctx context.Context, id int64, user domain.User) (domain.Site, error) {
+	if id == 0 {
+		return domain.Site{}, errors.New("ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.Site{}, err
+	}
+
+	return u.retriever.ListByID(ctx, id)
+}
+
+func (u *siteUseCase) Update(ctx context.Context, site domain.Site, user domain.User) (domain.Site, error) {
+	if site.ID == 0 {
+		return domain.Site{}, errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return domain.Site{}, err
+	}
+
+	current, err := u.retriever.ListByID(ctx, site.ID)
+	if err != nil {
+		return domain.Site{}, err
+	}
+
+	if site.CustomerID != 0 && site.CustomerID != current.CustomerID {
+		if _, err := u.customers.ListByID(ctx, site.CustomerID); err != nil {
+			return domain.Site{}, err
+		}
+
+		current.CustomerID = site.CustomerID
+	}
+
+	if site.Name != "" {
+		current.Name = site.Name
+	}
+
+	if site.Address != "" {
+		current.Address = site.Address
+	}
+
+	if site.Coordinates != nil {
+		current.Coordinates = site.Coordinates
+	}
+
+	if _, err := domain.NewSite(current.CustomerID, current.Name, current.Address, current.Coordinates); err != nil {
+		return domain.Site{}, err
+	}
+
+	if err := u.updater.Update(ctx, current); err != nil {
+		return domain.Site{}, err
+	}
+
+	return current, nil
+}
+
+func (u *siteUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+	if id == 0 {
+		return errors.New("ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return err
+	}
+
+	return u.remover.Remove(ctx, id)
+}
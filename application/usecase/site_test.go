@@ -0,0 +1,136 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockSiteCreator(ctrl)
+	retriever := domain.NewMockSiteRetriever(ctrl)
+	updater := domain.NewMockSiteUpdater(ctrl)
+	remover := domain.NewMockSiteRemover(ctrl)
+	customers := domain.NewMockCustomerRetriever(ctrl)
+
+	type args struct {
+		creator   domain.SiteCreator
+		retriever domain.SiteRetriever
+		updater   domain.SiteUpdater
+		remover   domain.SiteRemover
+		customers domain.CustomerRetriever
+	}
+
+	valid := args{creator, retriever, updater, remover, customers}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without remover", args: func() args { a := valid; a.remover = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without customer retriever", args: func() args { a := valid; a.customers = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewSite(a.creator, a.retriever, a.updater, a.remover, a.customers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_siteUseCase_Add(t *testing.T) {
+	type dependencies struct {
+		creator   *domain.MockSiteCreator
+		customers *domain.MockCustomerRetriever
+	}
+
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		site       = domain.Site{
+			CustomerID:  3,
+			Name:        "Headquarters",
+			Address:     "Av. Paulista, 1000",
+			Coordinates: &domain.Coordinates{Latitude: -23.5613, Longitude: -46.6565},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *dependencies)
+		want            domain.Site
+		wantErr         error
+	}{
+		{
+			name:    "Expect technician not to add a site",
+			user:    technician,
+			want:    domain.Site{},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect error when customer does not exist",
+			user: manager,
+			setDependencies: func(d *dependencies) {
+				d.customers.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.Customer{}, domain.ErrCustomerNotFound)
+			},
+			want:    domain.Site{},
+			wantErr: domain.ErrCustomerNotFound,
+		},
+		{
+			name: "Expect success",
+			user: manager,
+			setDependencies: func(d *dependencies) {
+				d.customers.EXPECT().ListByID(context.Background(), int64(3)).Return(domain.Customer{ID: 3}, nil)
+				d.creator.EXPECT().Add(context.Background(), site).Return(int64(5), nil)
+			},
+			want: domain.Site{
+				ID:          5,
+				CustomerID:  site.CustomerID,
+				Name:        site.Name,
+				Address:     site.Address,
+				Coordinates: site.Coordinates,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				creator:   domain.NewMockSiteCreator(ctrl),
+				customers: domain.NewMockCustomerRetriever(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &siteUseCase{creator: d.creator, customers: d.customers}
+
+			got, err := u.Add(context.Background(), site, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
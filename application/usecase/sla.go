@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+const overdueMessage = "%s: the task %d of tech %d is overdue since %s"
+
+type overdueUseCase struct {
+	flagger  domain.TaskOverdueFlagger
+	notifier domain.TaskNotifier
+	now      func() time.Time
+}
+
+func NewOverdue(flagger domain.TaskOverdueFlagger, notifier domain.TaskNotifier) (domain.OverdueUsecase, error) {
+	if flagger == nil {
+		return &overdueUseCase{}, errors.New("overdue flagger must not be nil")
+	}
+
+	if notifier == nil {
+		return &overdueUseCase{}, errors.New("notifier must not be nil")
+	}
+
+	return &overdueUseCase{
+		flagger:  flagger,
+		notifier: notifier,
+		now:      time.Now,
+	}, nil
+}
+
+func (u *overdueUseCase) Detect(ctx context.Context) (int, error) {
+	now := u.now()
+
+	tasks, err := u.flagger.ListOverdue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var flagged int
+
+	for _, t := range tasks {
+		ok, err := u.flagger.FlagOverdue(ctx, t.ID, now)
+		if err != nil {
+			log.Printf("error flagging task %d as overdue: %v", t.ID, err) // Later: send to metrics/observability
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		flagged++
+
+		err = u.notifier.SendNotification(ctx, fmt.Sprintf(overdueMessage, domain.EventTaskOverdue, t.ID, t.UserID, t.DueAt.UTC().Format(domain.DateTimeLayout)))
+		if err != nil {
+			log.Printf("error producing notification (overdue): %v", err) // Later: send to metrics/observability
+		}
+	}
+
+	return flagged, nil
+}
@@ -0,0 +1,145 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewOverdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	flagger := domain.NewMockTaskOverdueFlagger(ctrl)
+	notifier := domain.NewMockTaskNotifier(ctrl)
+
+	type args struct {
+		flagger  domain.TaskOverdueFlagger
+		notifier domain.TaskNotifier
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without flagger",
+			args: args{
+				flagger:  nil,
+				notifier: notifier,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without notifier",
+			args: args{
+				flagger:  flagger,
+				notifier: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				flagger:  flagger,
+				notifier: notifier,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOverdue(tt.args.flagger, tt.args.notifier)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOverdue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_overdueUseCase_Detect(t *testing.T) {
+	type dependencies struct {
+		flagger  *domain.MockTaskOverdueFlagger
+		notifier *domain.MockTaskNotifier
+	}
+
+	var (
+		now   = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		due   = now.Add(-time.Hour)
+		tasks = []domain.Task{
+			{ID: 1, UserID: 2, Status: domain.TaskStatusOpen, DueAt: &due},
+			{ID: 2, UserID: 3, Status: domain.TaskStatusInProgress, DueAt: &due},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *dependencies)
+		want            int
+		wantErr         bool
+	}{
+		{
+			name: "Expect error thrown by ListOverdue",
+			setDependencies: func(d *dependencies) {
+				d.flagger.EXPECT().ListOverdue(context.Background(), now).Return(nil, errors.New("err"))
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Expect task flagged by another replica not to be notified twice",
+			setDependencies: func(d *dependencies) {
+				d.flagger.EXPECT().ListOverdue(context.Background(), now).Return(tasks[:1], nil)
+				d.flagger.EXPECT().FlagOverdue(context.Background(), tasks[0].ID, now).Return(false, nil)
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Expect success",
+			setDependencies: func(d *dependencies) {
+				d.flagger.EXPECT().ListOverdue(context.Background(), now).Return(tasks, nil)
+				d.flagger.EXPECT().FlagOverdue(context.Background(), tasks[0].ID, now).Return(true, nil)
+				d.notifier.EXPECT().SendNotification(context.Background(), "task.overdue: the task 1 of tech 2 is overdue since 2023-11-15T09:00:00Z").Return(nil)
+				d.flagger.EXPECT().FlagOverdue(context.Background(), tasks[1].ID, now).Return(true, nil)
+				d.notifier.EXPECT().SendNotification(context.Background(), "task.overdue: the task 2 of tech 3 is overdue since 2023-11-15T09:00:00Z").Return(nil)
+			},
+			want:    2,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				flagger:  domain.NewMockTaskOverdueFlagger(ctrl),
+				notifier: domain.NewMockTaskNotifier(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &overdueUseCase{
+				flagger:  d.flagger,
+				notifier: d.notifier,
+				now:      func() time.Time { return now },
+			}
+
+			got, err := u.Detect(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Detect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,17 +6,33 @@ import (
 	"fmt"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"log"
+	"strconv"
+	"time"
 )
 
-const notificationMessage = "The tech %d performed the task %d on date %s"
+const (
+	notificationMessage = "The tech %d performed the task %d on date %s"
+
+	backgroundTimeout = 30 * time.Second
+
+	importBatchSize = 100
+)
 
 type taskUseCase struct {
-	creator   domain.TaskCreator
-	retriever domain.TaskRetriever
-	updater   domain.TaskUpdater
-	remover   domain.TaskRemover
-	encryptor domain.SummaryEncryptor
-	notifier  domain.TaskNotifier
+	creator      domain.TaskCreator
+	retriever    domain.TaskRetriever
+	updater      domain.TaskUpdater
+	remover      domain.TaskRemover
+	encryptor    domain.SummaryEncryptor
+	notifier     domain.TaskNotifier
+	userNotifier domain.UserNotifier
+	reminders    domain.ReminderScheduler
+	slaPolicies  domain.SLAPolicyRetriever
+	sites        domain.SiteRetriever
+	checklists   domain.ChecklistItemRetriever
+	transactor   domain.TaskTransactor
+	users        domain.UserRetriever
+	now          func() time.Time
 }
 
 func NewTask(
@@ -26,6 +42,13 @@ func NewTask(
 	remover domain.TaskRemover,
 	encryptor domain.SummaryEncryptor,
 	notifier domain.TaskNotifier,
+	userNotifier domain.UserNotifier,
+	reminders domain.ReminderScheduler,
+	slaPolicies domain.SLAPolicyRetriever,
+	sites domain.SiteRetriever,
+	checklists domain.ChecklistItemRetriever,
+	transactor domain.TaskTransactor,
+	users domain.UserRetriever,
 ) (domain.TaskUsecase, error) {
 	if creator == nil {
 		return &taskUseCase{}, errors.New("task creator must not be nil")
@@ -51,17 +74,52 @@ func NewTask(
 		return &taskUseCase{}, errors.New("notifier must not be nil")
 	}
 
+	if userNotifier == nil {
+		return &taskUseCase{}, errors.New("user notifier must not be nil")
+	}
+
+	if reminders == nil {
+		return &taskUseCase{}, errors.New("reminder scheduler must not be nil")
+	}
+
+	if slaPolicies == nil {
+		return &taskUseCase{}, errors.New("sla policy retriever must not be nil")
+	}
+
+	if sites == nil {
+		return &taskUseCase{}, errors.New("site retriever must not be nil")
+	}
+
+	if checklists == nil {
+		return &taskUseCase{}, errors.New("checklist item retriever must not be nil")
+	}
+
+	if transactor == nil {
+		return &taskUseCase{}, errors.New("task transactor must not be nil")
+	}
+
+	if users == nil {
+		return &taskUseCase{}, errors.New("user retriever must not be nil")
+	}
+
 	return &taskUseCase{
-		creator:   creator,
-		retriever: retriever,
-		updater:   updater,
-		remover:   remover,
-		encryptor: encryptor,
-		notifier:  notifier,
+		creator:      creator,
+		retriever:    retriever,
+		updater:      updater,
+		remover:      remover,
+		encryptor:    encryptor,
+		notifier:     notifier,
+		userNotifier: userNotifier,
+		reminders:    reminders,
+		slaPolicies:  slaPolicies,
+		sites:        sites,
+		checklists:   checklists,
+		transactor:   transactor,
+		users:        users,
 	}, nil
 }
 
-func (u *taskUseCase) ListByUser(ctx context.Context, user domain.User) ([]domain.Task, error) {
+func (u *taskUseCase) ListByUser(ctx context.Context, user domain.User, filter domain.TaskFilter) ([]domain.Task, error) {
 	if user.ID == 0 {
 		return []domain.Task{}, errors.New("user ID must not be empty")
 	}
@@ -70,15 +128,21 @@ func (u *taskUseCase) ListByUser(ctx context.Context, user domain.User) ([]domai
 		return []domain.Task{}, errors.New("user RoleID must not be empty")
 	}
 
+	if err := validateFilter(filter); err != nil {
+		return []domain.Task{}, err
+	}
+
+	filter.Now = u.currentTime()
+
 	var err error
 	var tasks []domain.Task
 
 	if user.GetRole() == domain.Manager {
-		tasks, err = u.retriever.List(ctx)
+		tasks, err = u.retriever.List(ctx, filter)
 	}
 
 	if user.GetRole() == domain.Technician {
-		tasks, err = u.retriever.ListByUserID(ctx, user.ID)
+		tasks, err = u.retriever.ListByUserID(ctx, user.ID, filter)
 	}
 
 	if err != nil {
@@ -101,6 +165,98 @@ func (u *taskUseCase) ListByUser(ctx context.Context, user domain.User) ([]domai
 	return decryptedTasks, nil
 }
 
+// Export streams the tasks matching filter to fn with their summaries
+// decrypted, one at a time, so large exports are never held in memory.
+func (u *taskUseCase) Export(ctx context.Context, user domain.User, filter domain.TaskFilter, fn func(task domain.Task) error) error {
+	if user.ID == 0 {
+		return errors.New("user ID must not be empty")
+	}
+
+	if user.RoleID == 0 {
+		return errors.New("user RoleID must not be empty")
+	}
+
+	if user.GetRole() != domain.Manager {
+		return domain.ErrUserNotAllowed
+	}
+
+	if err := validateFilter(filter); err != nil {
+		return err
+	}
+
+	filter.Now = u.currentTime()
+
+	return u.retriever.Stream(ctx, filter, func(task domain.Task) error {
+		summary, err := u.encryptor.Decrypt(task.Summary)
+		if err != nil {
+			log.Printf("error decrypting task summary: %v", err) // Later: send to metrics/observability
+			return nil
+		}
+
+		task.Summary = summary
+
+		return fn(task)
+	})
+}
+
+func (u *taskUseCase) Get(ctx context.Context, id int64, user domain.User) (domain.Task, error) {
+	if id == 0 {
+		return domain.Task{}, errors.New("ID must not be 0")
+	}
+
+	if user.ID == 0 {
+		return domain.Task{}, errors.New("user ID must not be empty")
+	}
+
+	if user.RoleID == 0 {
+		return domain.Task{}, errors.New("user RoleID must not be empty")
+	}
+
+	var (
+		task domain.Task
+		err  error
+	)
+
+	if user.GetRole() == domain.Manager {
+		task, err = u.retriever.ListByID(ctx, id)
+	} else {
+		task, err = u.retriever.ListByIDAndUserID(ctx, id, user.ID)
+	}
+
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	summaryDecrypt, err := u.encryptor.Decrypt(task.Summary)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task.Summary = summaryDecrypt
+
+	return task, nil
+}
+
+func (u *taskUseCase) Next(ctx context.Context, user domain.User) (domain.Task, error) {
+	if user.ID == 0 {
+		return domain.Task{}, errors.New("user ID must not be empty")
+	}
+
+	task, err := u.retriever.ListNextByUserID(ctx, user.ID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	summaryDecrypt, err := u.encryptor.Decrypt(task.Summary)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task.Summary = summaryDecrypt
+
+	return task, nil
+}
+
 func (u *taskUseCase) Add(ctx context.Context, task domain.Task, user domain.User) (domain.Task, error) {
 	if task.UserID == 0 {
 		return domain.Task{}, errors.New("UserID must not be 0")
@@ -118,6 +274,60 @@ func (u *taskUseCase) Add(ctx context.Context, task domain.Task, user domain.Use
 		return domain.Task{}, domain.ErrUserNotAllowed
 	}
 
+	task, err := u.prepare(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	return u.insert(ctx, task, user)
+}
+
+// prepare fills the defaults of a new task and checks it against its site,
+// the technician's other bookings and its SLA policy.
+func (u *taskUseCase) prepare(ctx context.Context, task domain.Task) (domain.Task, error) {
+	if task.Status == "" {
+		task.Status = domain.TaskStatusOpen
+	}
+
+	if task.Priority == "" {
+		task.Priority = domain.DefaultTaskPriority
+	}
+
+	if !domain.IsValidTaskPriority(task.Priority) {
+		return domain.Task{}, fmt.Errorf("%w: priority %q is not supported", domain.ErrInvalidTask, task.Priority)
+	}
+
+	task.CreatedAt = u.currentTime()
+
+	if err := task.ValidateSchedule(); err != nil {
+		return domain.Task{}, err
+	}
+
+	if task.SiteID != 0 {
+		if _, err := u.sites.ListByID(ctx, task.SiteID); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	if err := u.checkConflicts(ctx, task); err != nil {
+		return domain.Task{}, err
+	}
+
+	if task.DueAt == nil {
+		dueAt, err := u.deadline(ctx, task)
+		if err != nil {
+			return domain.Task{}, err
+		}
+
+		task.DueAt = dueAt
+	}
+
+	return task, nil
+}
+
+// insert stores a prepared task with its summary encrypted, then announces it
+// and schedules its reminders.
+func (u *taskUseCase) insert(ctx context.Context, task domain.Task, user domain.User) (domain.Task, error) {
 	summaryEncrypted, err := u.encryptor.Encrypt(task.Summary)
 	if err != nil {
 		return domain.Task{}, err
@@ -129,14 +339,27 @@ func (u *taskUseCase) Add(ctx context.Context, task domain.Task, user domain.Use
 		return domain.Task{}, err
 	}
 	task.ID = id
+	task.Version = domain.InitialTaskVersion
 
 	if task.Date != nil {
-		go func() {
-			err := u.notifier.SendNotification(ctx, fmt.Sprintf(notificationMessage, user.ID, task.ID, task.Date.Format(domain.TaskDateLayout)))
+		// The notifications get their own copy, as task is still changed
+		// below while they run.
+		assigned := task
+
+		detached(ctx, func(ctx context.Context) {
+			err := u.notifier.SendNotification(ctx, fmt.Sprintf(notificationMessage, user.ID, assigned.ID, assigned.Date.UTC().Format(domain.DateTimeLayout)))
 			if err != nil {
 				log.Printf("error producing notification (add): %v", err) // Later: send to metrics/observability
 			}
-		}()
+		})
+
+		detached(ctx, func(ctx context.Context) {
+			u.notifyUser(ctx, domain.EventTaskAssigned, assigned)
+		})
+
+		if err := u.reminders.Schedule(ctx, task); err != nil {
+			log.Printf("error scheduling reminders (add): %v", err) // Later: send to metrics/observability
+		}
 	}
 
 	summaryDecrypt, err := u.encryptor.Decrypt(task.Summary)
@@ -163,10 +386,84 @@ func (u *taskUseCase) Update(ctx context.Context, task domain.Task, user domain.
 		return domain.Task{}, err
 	}
 
+	if task.Version != 0 && task.Version != tsk.Version {
+		return domain.Task{}, &domain.TaskVersionError{TaskID: tsk.ID, Expected: task.Version, Current: tsk.Version}
+	}
+
+	rescheduled := task.Date != nil && (tsk.Date == nil || !tsk.Date.Equal(*task.Date))
+
 	if task.Date != nil {
 		tsk.Date = task.Date
 	}
 
+	resized := task.Duration > 0 && task.Duration != tsk.Duration
+	if task.Duration > 0 {
+		tsk.Duration = task.Duration
+	}
+
+	rewindowed := task.WindowStart != nil || task.WindowEnd != nil
+	if rewindowed {
+		tsk.WindowStart = task.WindowStart
+		tsk.WindowEnd = task.WindowEnd
+	}
+
+	if err := tsk.ValidateSchedule(); err != nil {
+		return domain.Task{}, err
+	}
+
+	if rescheduled || resized || rewindowed {
+		if err := u.checkConflicts(ctx, tsk); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	if task.SiteID != 0 && task.SiteID != tsk.SiteID {
+		if _, err := u.sites.ListByID(ctx, task.SiteID); err != nil {
+			return domain.Task{}, err
+		}
+
+		tsk.SiteID = task.SiteID
+	}
+
+	reprioritized := task.Priority != "" && task.Priority != tsk.Priority
+	if task.Priority != "" {
+		if !domain.IsValidTaskPriority(task.Priority) {
+			return domain.Task{}, fmt.Errorf("%w: priority %q is not supported", domain.ErrInvalidTask, task.Priority)
+		}
+
+		tsk.Priority = task.Priority
+	}
+
+	switch {
+	case task.Status == domain.TaskStatusCompleted && tsk.Status != domain.TaskStatusCompleted:
+		checklist, err := u.checklists.ListByTaskID(ctx, tsk.ID)
+		if err != nil {
+			return domain.Task{}, err
+		}
+
+		if err := tsk.Complete(checklist); err != nil {
+			return domain.Task{}, err
+		}
+	case task.Status != "":
+		if err := tsk.TransitionTo(task.Status); err != nil {
+			return domain.Task{}, err
+		}
+	}
+
+	switch {
+	case task.DueAt != nil:
+		tsk.DueAt = task.DueAt
+		tsk.OverdueAt = nil
+	case rescheduled, reprioritized && tsk.Date == nil:
+		dueAt, err := u.deadline(ctx, tsk)
+		if err != nil {
+			return domain.Task{}, err
+		}
+
+		tsk.DueAt = dueAt
+		tsk.OverdueAt = nil
+	}
+
 	if task.Summary != "" {
 		summaryEncrypted, err := u.encryptor.Encrypt(task.Summary)
 		if err != nil {
@@ -181,13 +478,27 @@ func (u *taskUseCase) Update(ctx context.Context, task domain.Task, user domain.
 		return domain.Task{}, err
 	}
 
+	tsk.Version++
+
 	if task.Date != nil {
-		go func() {
-			err := u.notifier.SendNotification(ctx, fmt.Sprintf(notificationMessage, user.ID, task.ID, task.Date.Format(domain.TaskDateLayout)))
+		detached(ctx, func(ctx context.Context) {
+			err := u.notifier.SendNotification(ctx, fmt.Sprintf(notificationMessage, user.ID, task.ID, task.Date.UTC().Format(domain.DateTimeLayout)))
 			if err != nil {
 				log.Printf("error producing notification (update): %v", err) // Later: send to metrics/observability
 			}
-		}()
+		})
+	}
+
+	if rescheduled {
+		scheduled := tsk
+
+		detached(ctx, func(ctx context.Context) {
+			u.notifyUser(ctx, domain.EventTaskRescheduled, scheduled)
+		})
+
+		if err := u.reminders.Schedule(ctx, tsk); err != nil {
+			log.Printf("error scheduling reminders (update): %v", err) // Later: send to metrics/observability
+		}
 	}
 
 	summaryDecrypt, err := u.encryptor.Decrypt(tsk.Summary)
@@ -200,7 +511,7 @@ func (u *taskUseCase) Update(ctx context.Context, task domain.Task, user domain.
 	return tsk, nil
 }
 
-func (u *taskUseCase) Remove(ctx context.Context, id int64, user domain.User) error {
+func (u *taskUseCase) Remove(ctx context.Context, id, version int64, user domain.User) error {
 	if id == 0 {
 		return errors.New("ID must not be 0")
 	}
@@ -217,5 +528,347 @@ func (u *taskUseCase) Remove(ctx context.Context, id int64, user domain.User) er
 		return domain.ErrUserNotAllowed
 	}
 
-	return u.remover.Remove(ctx, id)
+	if err := u.remover.Remove(ctx, id, version); err != nil {
+		return err
+	}
+
+	if err := u.reminders.Cancel(ctx, id); err != nil {
+		log.Printf("error cancelling reminders (remove): %v", err) // Later: send to metrics/observability
+	}
+
+	return nil
+}
+
+// Batch applies the operations in order with the same rules as Add, Update and
+// Remove. In atomic mode the first failing operation rolls back the whole
+// batch and every other result carries ErrTaskBatchAborted; otherwise each
+// operation succeeds or fails on its own. Notifications are only sent for
+// operations that were committed.
+func (u *taskUseCase) Batch(ctx context.Context, operations []domain.TaskOperation, atomic bool, user domain.User) ([]domain.TaskOperationResult, error) {
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("%w: operations must not be empty", domain.ErrInvalidTaskBatch)
+	}
+
+	if len(operations) > domain.MaxTaskBatchSize {
+		return nil, fmt.Errorf("%w: at most %d operations are allowed", domain.ErrInvalidTaskBatch, domain.MaxTaskBatchSize)
+	}
+
+	for i, operation := range operations {
+		switch operation.Method {
+		case domain.TaskOperationCreate, domain.TaskOperationUpdate, domain.TaskOperationDelete:
+		default:
+			return nil, fmt.Errorf("%w: operation %d has unsupported method %q", domain.ErrInvalidTaskBatch, i, operation.Method)
+		}
+	}
+
+	results := make([]domain.TaskOperationResult, len(operations))
+
+	if !atomic {
+		for i, operation := range operations {
+			results[i] = u.apply(ctx, operation, user)
+		}
+
+		return results, nil
+	}
+
+	failed := -1
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i, operation := range operations {
+			results[i] = u.apply(ctx, operation, user)
+			if results[i].Err != nil {
+				failed = i
+				return results[i].Err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if failed < 0 {
+			return nil, err
+		}
+
+		for i := range results {
+			if i != failed {
+				results[i] = domain.TaskOperationResult{Task: domain.Task{ID: operations[i].Task.ID}, Err: domain.ErrTaskBatchAborted}
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (u *taskUseCase) apply(ctx context.Context, operation domain.TaskOperation, user domain.User) domain.TaskOperationResult {
+	switch operation.Method {
+	case domain.TaskOperationCreate:
+		task, err := u.Add(ctx, operation.Task, user)
+		return domain.TaskOperationResult{Task: task, Err: err}
+	case domain.TaskOperationUpdate:
+		task, err := u.Update(ctx, operation.Task, user)
+		return domain.TaskOperationResult{Task: task, Err: err}
+	default:
+		err := u.Remove(ctx, operation.Task.ID, operation.Task.Version, user)
+		return domain.TaskOperationResult{Task: domain.Task{ID: operation.Task.ID}, Err: err}
+	}
+}
+
+// Import creates the tasks of an import file for the technicians named in its
+// rows. Every row is checked like a new task; in dry run nothing is stored,
+// otherwise valid rows are committed in batches of importBatchSize and failing
+// rows are reported without stopping the import. Rows of the same file are
+// only checked against each other's bookings once they are stored.
+func (u *taskUseCase) Import(ctx context.Context, rows []domain.TaskImportRow, dryRun bool, user domain.User) ([]domain.TaskImportResult, error) {
+	if user.ID == 0 {
+		return nil, errors.New("user ID must not be empty")
+	}
+
+	if user.RoleID == 0 {
+		return nil, errors.New("user RoleID must not be empty")
+	}
+
+	if user.GetRole() != domain.Manager {
+		return nil, domain.ErrUserNotAllowed
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: the file has no rows", domain.ErrInvalidTaskImport)
+	}
+
+	if len(rows) > domain.MaxTaskImportRows {
+		return nil, fmt.Errorf("%w: at most %d rows are allowed", domain.ErrInvalidTaskImport, domain.MaxTaskImportRows)
+	}
+
+	results := make([]domain.TaskImportResult, len(rows))
+	technicians := map[string]technicianLookup{}
+
+	for start := 0; start < len(rows); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		batch, batchResults := rows[start:end], results[start:end]
+
+		if dryRun {
+			for i, row := range batch {
+				batchResults[i] = u.importRow(ctx, row, technicians, true, user)
+			}
+
+			continue
+		}
+
+		err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			for i, row := range batch {
+				batchResults[i] = u.importRow(ctx, row, technicians, false, user)
+			}
+
+			return nil
+		})
+		if err != nil {
+			for i, row := range batch {
+				if batchResults[i].Err == nil {
+					batchResults[i] = domain.TaskImportResult{Line: row.Line, Err: err}
+				}
+			}
+		}
+	}
+
+	return results, nil
+}
+
+type technicianLookup struct {
+	id  int64
+	err error
+}
+
+func (u *taskUseCase) importRow(ctx context.Context, row domain.TaskImportRow, technicians map[string]technicianLookup, dryRun bool, user domain.User) domain.TaskImportResult {
+	result := domain.TaskImportResult{Line: row.Line}
+
+	if row.Err != nil {
+		result.Err = row.Err
+		return result
+	}
+
+	technician, ok := technicians[row.TechnicianEmail]
+	if !ok {
+		technician.id, technician.err = u.technicianID(ctx, row.TechnicianEmail)
+		technicians[row.TechnicianEmail] = technician
+	}
+
+	if technician.err != nil {
+		result.Err = technician.err
+		return result
+	}
+
+	task, err := domain.NewTask(row.Task.Summary, row.Task.Date, technician.id, row.Task.Type, row.Task.Priority)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	task.Duration = row.Task.Duration
+	task.WindowStart = row.Task.WindowStart
+	task.WindowEnd = row.Task.WindowEnd
+	task.SiteID = row.Task.SiteID
+	task.DueAt = row.Task.DueAt
+
+	task, err = u.prepare(ctx, task)
+	if err == nil && !dryRun {
+		task, err = u.insert(ctx, task, user)
+	}
+
+	result.Task = task
+	result.Err = err
+
+	return result
+}
+
+func (u *taskUseCase) technicianID(ctx context.Context, email string) (int64, error) {
+	technician, err := u.users.ListByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return 0, fmt.Errorf("%w: no user has the email %q", domain.ErrInvalidTaskImport, email)
+		}
+
+		return 0, err
+	}
+
+	if technician.GetRole() != domain.Technician {
+		return 0, fmt.Errorf("%w: %q is not a technician", domain.ErrInvalidTaskImport, email)
+	}
+
+	return technician.ID, nil
+}
+
+// Changes returns the tasks created, updated or removed after cursor, oldest
+// first, with the cursor to resume from. Managers see every task and
+// technicians their own. Changes made in the current second are left for the
+// next call, so none is skipped when several share a timestamp.
+func (u *taskUseCase) Changes(ctx context.Context, user domain.User, cursor domain.TaskCursor) ([]domain.TaskChange, domain.TaskCursor, error) {
+	if user.ID == 0 {
+		return nil, cursor, errors.New("user ID must not be empty")
+	}
+
+	if user.RoleID == 0 {
+		return nil, cursor, errors.New("user RoleID must not be empty")
+	}
+
+	var userID int64
+
+	switch user.GetRole() {
+	case domain.Manager:
+	case domain.Technician:
+		userID = user.ID
+	default:
+		return nil, cursor, domain.ErrUserNotAllowed
+	}
+
+	changes, err := u.retriever.ListChanged(ctx, userID, cursor, u.currentTime().Truncate(time.Second), domain.MaxTaskChanges)
+	if err != nil {
+		return nil, cursor, err
+	}
+
+	var decrypted []domain.TaskChange
+
+	for _, change := range changes {
+		cursor = change.Cursor()
+
+		summary, err := u.encryptor.Decrypt(change.Task.Summary)
+		if err != nil {
+			log.Printf("error decrypting task summary: %v", err) // Later: send to metrics/observability
+			continue
+		}
+
+		change.Task.Summary = summary
+		decrypted = append(decrypted, change)
+	}
+
+	return decrypted, cursor, nil
+}
+
+func (u *taskUseCase) currentTime() time.Time {
+	if u.now == nil {
+		return time.Now()
+	}
+
+	return u.now()
+}
+
+func validateFilter(filter domain.TaskFilter) error {
+	if filter.SLA != "" && filter.SLA != domain.SLAOverdue && filter.SLA != domain.SLAAtRisk {
+		return fmt.Errorf("%w: sla filter %q is not supported", domain.ErrInvalidTask, filter.SLA)
+	}
+
+	if filter.Priority != "" && !domain.IsValidTaskPriority(filter.Priority) {
+		return fmt.Errorf("%w: priority filter %q is not supported", domain.ErrInvalidTask, filter.Priority)
+	}
+
+	switch filter.Sort {
+	case "", domain.TaskSortPriority, domain.TaskSortDueAt, domain.TaskSortDate:
+	default:
+		return fmt.Errorf("%w: sort %q is not supported", domain.ErrInvalidTask, filter.Sort)
+	}
+
+	return nil
+}
+
+func (u *taskUseCase) checkConflicts(ctx context.Context, task domain.Task) error {
+	if task.Date == nil || task.Status == domain.TaskStatusCompleted {
+		return nil
+	}
+
+	overlapping, err := u.retriever.ListOverlapping(ctx, task.UserID, *task.Date, *task.BookingEnd(), task.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(overlapping) == 0 {
+		return nil
+	}
+
+	conflict := &domain.TaskConflictError{}
+	for _, t := range overlapping {
+		conflict.TaskIDs = append(conflict.TaskIDs, t.ID)
+	}
+
+	return conflict
+}
+
+func (u *taskUseCase) deadline(ctx context.Context, task domain.Task) (*time.Time, error) {
+	policy, err := u.slaPolicies.ListByTaskTypeAndPriority(ctx, task.Type, task.Priority)
+	if err != nil {
+		if errors.Is(err, domain.ErrSLAPolicyNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	dueAt := policy.Deadline(task.CreatedAt, task.Date)
+
+	return &dueAt, nil
+}
+
+func (u *taskUseCase) notifyUser(ctx context.Context, event string, task domain.Task) {
+	data := map[string]string{
+		"task_id": strconv.FormatInt(task.ID, 10),
+		"date":    task.Date.UTC().Format(domain.DateTimeLayout),
+	}
+
+	if err := u.userNotifier.Notify(ctx, event, task.UserID, data); err != nil {
+		log.Printf("error notifying user (%s): %v", event, err) // Later: send to metrics/observability
+	}
+}
+
+// detached runs fn in the background once the transaction in ctx, if any, has
+// committed, so rolled back changes are never announced.
+func detached(ctx context.Context, fn func(ctx context.Context)) {
+	domain.AfterCommit(ctx, func() {
+		ctx, cancel := domain.Detach(ctx, backgroundTimeout)
+
+		go func() {
+			defer cancel()
+
+			fn(ctx)
+		}()
+	})
 }
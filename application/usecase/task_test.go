@@ -21,14 +21,28 @@ func TestNewTask(t *testing.T) {
 	remover := domain.NewMockTaskRemover(ctrl)
 	encryptor := domain.NewMockSummaryEncryptor(ctrl)
 	notifier := domain.NewMockTaskNotifier(ctrl)
+	userNotifier := domain.NewMockUserNotifier(ctrl)
+	reminders := domain.NewMockReminderScheduler(ctrl)
+	slaPolicies := domain.NewMockSLAPolicyRetriever(ctrl)
+	sites := domain.NewMockSiteRetriever(ctrl)
+	checklists := domain.NewMockChecklistItemRetriever(ctrl)
+	transactor := domain.NewMockTaskTransactor(ctrl)
+	users := domain.NewMockUserRetriever(ctrl)
 
 	type args struct {
-		creator   domain.TaskCreator
-		retriever domain.TaskRetriever
-		updater   domain.TaskUpdater
-		remover   domain.TaskRemover
-		encryptor domain.SummaryEncryptor
-		notifier  domain.TaskNotifier
+		creator      domain.TaskCreator
+		retriever    domain.TaskRetriever
+		updater      domain.TaskUpdater
+		remover      domain.TaskRemover
+		encryptor    domain.SummaryEncryptor
+		notifier     domain.TaskNotifier
+		userNotifier domain.UserNotifier
+		reminders    domain.ReminderScheduler
+		slaPolicies  domain.SLAPolicyRetriever
+		sites        domain.SiteRetriever
+		checklists   domain.ChecklistItemRetriever
+		transactor   domain.TaskTransactor
+		users        domain.UserRetriever
 	}
 
 	tests := []struct {
@@ -40,12 +54,19 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without creator",
 			args: args{
-				creator:   nil,
-				retriever: retriever,
-				updater:   updater,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      nil,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -53,12 +74,19 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without retriever",
 			args: args{
-				creator:   creator,
-				retriever: nil,
-				updater:   updater,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    nil,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -66,12 +94,19 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without user updater",
 			args: args{
-				creator:   creator,
-				retriever: retriever,
-				updater:   nil,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      nil,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -79,12 +114,19 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without user remover",
 			args: args{
-				creator:   creator,
-				retriever: retriever,
-				updater:   updater,
-				remover:   nil,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      nil,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -92,12 +134,19 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without user encryptor",
 			args: args{
-				creator:   creator,
-				retriever: retriever,
-				updater:   updater,
-				remover:   remover,
-				encryptor: nil,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    nil,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -105,12 +154,159 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect error when initializing without user notifier",
 			args: args{
-				creator:   creator,
-				retriever: retriever,
-				updater:   updater,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  nil,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     nil,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without user notifier",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: nil,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without reminder scheduler",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    nil,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without sla policy retriever",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  nil,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without site retriever",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        nil,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without checklist item retriever",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   nil,
+				transactor:   transactor,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without task transactor",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   nil,
+				users:        users,
+			},
+			want:    &taskUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without user retriever",
+			args: args{
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        nil,
 			},
 			want:    &taskUseCase{},
 			wantErr: true,
@@ -118,27 +314,41 @@ func TestNewTask(t *testing.T) {
 		{
 			name: "Expect success",
 			args: args{
-				creator:   creator,
-				retriever: retriever,
-				updater:   updater,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			want: &taskUseCase{
-				creator:   creator,
-				retriever: retriever,
-				updater:   updater,
-				remover:   remover,
-				encryptor: encryptor,
-				notifier:  notifier,
+				creator:      creator,
+				retriever:    retriever,
+				updater:      updater,
+				remover:      remover,
+				encryptor:    encryptor,
+				notifier:     notifier,
+				userNotifier: userNotifier,
+				reminders:    reminders,
+				slaPolicies:  slaPolicies,
+				sites:        sites,
+				checklists:   checklists,
+				transactor:   transactor,
+				users:        users,
 			},
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewTask(tt.args.creator, tt.args.retriever, tt.args.updater, tt.args.remover, tt.args.encryptor, tt.args.notifier)
+			got, err := NewTask(tt.args.creator, tt.args.retriever, tt.args.updater, tt.args.remover, tt.args.encryptor, tt.args.notifier, tt.args.userNotifier, tt.args.reminders, tt.args.slaPolicies, tt.args.sites, tt.args.checklists, tt.args.transactor, tt.args.users)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewTask() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -156,7 +366,9 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 	}
 
 	var (
-		tasks = []domain.Task{
+		now    = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		filter = domain.TaskFilter{Now: now}
+		tasks  = []domain.Task{
 			{
 				ID:      1,
 				Summary: "task summary test",
@@ -174,8 +386,9 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 	)
 
 	type args struct {
-		ctx  context.Context
-		user domain.User
+		ctx    context.Context
+		user   domain.User
+		filter domain.TaskFilter
 	}
 	tests := []struct {
 		name            string
@@ -206,6 +419,64 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 			want:    []domain.Task{},
 			wantErr: true,
 		},
+		{
+			name: "Expect error when sla filter is not supported",
+			args: args{
+				ctx:    context.Background(),
+				user:   managerUser,
+				filter: domain.TaskFilter{SLA: "late"},
+			},
+			want:    []domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when priority filter is not supported",
+			args: args{
+				ctx:    context.Background(),
+				user:   managerUser,
+				filter: domain.TaskFilter{Priority: "P9"},
+			},
+			want:    []domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when sort is not supported",
+			args: args{
+				ctx:    context.Background(),
+				user:   managerUser,
+				filter: domain.TaskFilter{Sort: "summary"},
+			},
+			want:    []domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success when filtering and sorting by priority",
+			args: args{
+				ctx:    context.Background(),
+				user:   technicalUser,
+				filter: domain.TaskFilter{Priority: domain.TaskPriorityP1, Sort: domain.TaskSortPriority},
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID, domain.TaskFilter{Priority: domain.TaskPriorityP1, Sort: domain.TaskSortPriority, Now: now}).Return(tasks, nil)
+				d.encryptor.EXPECT().Decrypt(tasks[0].Summary).Return(tasks[0].Summary, nil)
+			},
+			want:    tasks,
+			wantErr: false,
+		},
+		{
+			name: "Expect success when filtering overdue tasks",
+			args: args{
+				ctx:    context.Background(),
+				user:   managerUser,
+				filter: domain.TaskFilter{SLA: domain.SLAOverdue},
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().List(context.Background(), domain.TaskFilter{SLA: domain.SLAOverdue, Now: now}).Return(tasks, nil)
+				d.encryptor.EXPECT().Decrypt(tasks[0].Summary).Return(tasks[0].Summary, nil)
+			},
+			want:    tasks,
+			wantErr: false,
+		},
 		{
 			name: "Expect error thrown by List when listing tasks for manager",
 			args: args{
@@ -213,7 +484,7 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 				user: managerUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.retriever.EXPECT().List(context.Background()).Return([]domain.Task{}, errors.New("err"))
+				d.retriever.EXPECT().List(context.Background(), filter).Return([]domain.Task{}, errors.New("err"))
 			},
 			want:    []domain.Task{},
 			wantErr: true,
@@ -225,7 +496,7 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 				user: technicalUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID).Return([]domain.Task{}, errors.New("err"))
+				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID, filter).Return([]domain.Task{}, errors.New("err"))
 			},
 			want:    []domain.Task{},
 			wantErr: true,
@@ -237,7 +508,7 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 				user: managerUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.retriever.EXPECT().List(context.Background()).Return(tasks, nil)
+				d.retriever.EXPECT().List(context.Background(), filter).Return(tasks, nil)
 				d.encryptor.EXPECT().Decrypt(tasks[0].Summary).Return(tasks[0].Summary, nil)
 			},
 			want:    tasks,
@@ -250,7 +521,7 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 				user: technicalUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID).Return(tasks, nil)
+				d.retriever.EXPECT().ListByUserID(context.Background(), technicalUser.ID, filter).Return(tasks, nil)
 				d.encryptor.EXPECT().Decrypt(tasks[0].Summary).Return(tasks[0].Summary, nil)
 			},
 			want:    tasks,
@@ -274,9 +545,10 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 			u := &taskUseCase{
 				retriever: d.retriever,
 				encryptor: d.encryptor,
+				now:       func() time.Time { return now },
 			}
 
-			got, err := u.ListByUser(tt.args.ctx, tt.args.user)
+			got, err := u.ListByUser(tt.args.ctx, tt.args.user, tt.args.filter)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ListByUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -287,34 +559,34 @@ func Test_taskUseCase_ListByUser(t *testing.T) {
 	}
 }
 
-func Test_taskUseCase_Add(t *testing.T) {
+func Test_taskUseCase_Get(t *testing.T) {
 	type dependencies struct {
-		creator   *domain.MockTaskCreator
+		retriever *domain.MockTaskRetriever
 		encryptor *domain.MockSummaryEncryptor
 	}
 
-	type args struct {
-		ctx  context.Context
-		task domain.Task
-		user domain.User
-	}
-
 	var (
 		task = domain.Task{
 			ID:      1,
-			Summary: "task summary test",
+			Summary: "encrypted",
 			UserID:  2,
+			Version: 3,
+		}
+		managerUser = domain.User{
+			ID:     1,
+			RoleID: 1,
 		}
 		technicalUser = domain.User{
 			ID:     2,
 			RoleID: 2,
 		}
-		technicalUser2 = domain.User{
-			ID:     3,
-			RoleID: 2,
-		}
 	)
 
+	type args struct {
+		ctx  context.Context
+		id   int64
+		user domain.User
+	}
 	tests := []struct {
 		name            string
 		args            args
@@ -323,106 +595,63 @@ func Test_taskUseCase_Add(t *testing.T) {
 		wantErr         bool
 	}{
 		{
-			name: "Expect error when task user ID is missing",
-			args: args{
-				ctx: context.Background(),
-				task: domain.Task{
-					Summary: task.Summary,
-				},
-				user: technicalUser,
-			},
-			want:    domain.Task{},
-			wantErr: true,
-		},
-		{
-			name: "Expect error when user ID is missing",
-			args: args{
-				ctx:  context.Background(),
-				task: task,
-				user: domain.User{
-					RoleID: technicalUser.RoleID,
-				},
-			},
-			want:    domain.Task{},
-			wantErr: true,
-		},
-		{
-			name: "Expect error when task user role ID is missing",
-			args: args{
-				ctx:  context.Background(),
-				task: task,
-				user: domain.User{
-					ID: technicalUser.ID,
-				},
-			},
-			want:    domain.Task{},
-			wantErr: true,
-		},
-		{
-			name: "Expect forbidden error when user is tries to add a task for somebody else",
+			name: "Expect error when task ID is missing",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
-				user: technicalUser2,
+				user: technicalUser,
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error thrown by Encrypt",
+			name: "Expect error when user role ID is missing",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
-				user: technicalUser,
-			},
-			setDependencies: func(d *dependencies) {
-				d.encryptor.EXPECT().Encrypt(task.Summary).Return("", errors.New("err"))
+				id:   task.ID,
+				user: domain.User{ID: technicalUser.ID},
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error thrown by Add",
+			name: "Expect technician not to read tasks of someone else",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
-				user: technicalUser,
+				id:   task.ID,
+				user: domain.User{ID: 3, RoleID: 2},
 			},
 			setDependencies: func(d *dependencies) {
-				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
-				d.creator.EXPECT().Add(context.Background(), task).Return(task.ID, errors.New("err"))
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, int64(3)).Return(domain.Task{}, domain.ErrTasksNotFound)
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error thrown by Decrypt",
+			name: "Expect technician to read an own task",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
+				id:   task.ID,
 				user: technicalUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
-				d.creator.EXPECT().Add(context.Background(), task).Return(task.ID, nil)
-				d.encryptor.EXPECT().Decrypt(task.Summary).Return("", errors.New("err"))
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, technicalUser.ID).Return(task, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return("task summary test", nil)
 			},
-			want:    domain.Task{},
-			wantErr: true,
+			want:    domain.Task{ID: 1, Summary: "task summary test", UserID: 2, Version: 3},
+			wantErr: false,
 		},
 		{
-			name: "Expect success",
+			name: "Expect manager to read any task",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
-				user: technicalUser,
+				id:   task.ID,
+				user: managerUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
-				d.creator.EXPECT().Add(context.Background(), task).Return(task.ID, nil)
-				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+				d.retriever.EXPECT().ListByID(context.Background(), task.ID).Return(task, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return("task summary test", nil)
 			},
-			want:    task,
+			want:    domain.Task{ID: 1, Summary: "task summary test", UserID: 2, Version: 3},
 			wantErr: false,
 		},
 	}
@@ -432,8 +661,8 @@ func Test_taskUseCase_Add(t *testing.T) {
 			defer ctrl.Finish()
 
 			d := dependencies{
+				retriever: domain.NewMockTaskRetriever(ctrl),
 				encryptor: domain.NewMockSummaryEncryptor(ctrl),
-				creator:   domain.NewMockTaskCreator(ctrl),
 			}
 
 			if tt.setDependencies != nil {
@@ -441,13 +670,13 @@ func Test_taskUseCase_Add(t *testing.T) {
 			}
 
 			u := &taskUseCase{
-				creator:   d.creator,
+				retriever: d.retriever,
 				encryptor: d.encryptor,
 			}
 
-			got, err := u.Add(tt.args.ctx, tt.args.task, tt.args.user)
+			got, err := u.Get(tt.args.ctx, tt.args.id, tt.args.user)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -456,79 +685,30 @@ func Test_taskUseCase_Add(t *testing.T) {
 	}
 }
 
-func Test_taskUseCase_Add_Notify_Concurrent(t *testing.T) {
-	var (
-		ctx  = context.Background()
-		date = time.Now()
-		task = domain.Task{
-			ID:      1,
-			Summary: "task summary test",
-			Date:    &date,
-			UserID:  2,
-		}
-		user = domain.User{
-			ID:     2,
-			RoleID: 2,
-		}
-	)
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	encryptor := domain.NewMockSummaryEncryptor(ctrl)
-	encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
-	encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
-
-	creator := domain.NewMockTaskCreator(ctrl)
-	creator.EXPECT().Add(ctx, task).Return(task.ID, nil)
-
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	notifier := domain.NewMockTaskNotifier(ctrl)
-	notifier.EXPECT().SendNotification(ctx, gomock.Any()).
-		Do(func(arg0, arg1 interface{}) interface{} {
-			defer wg.Done()
-			return nil
-		})
-
-	u := &taskUseCase{
-		creator:   creator,
-		encryptor: encryptor,
-		notifier:  notifier,
-	}
-	got, err := u.Add(ctx, task, user)
-
-	wg.Wait()
-	assert.Equal(t, nil, err)
-	assert.Equal(t, task, got)
-}
-
-func Test_taskUseCase_Update(t *testing.T) {
+func Test_taskUseCase_Next(t *testing.T) {
 	type dependencies struct {
 		retriever *domain.MockTaskRetriever
-		updater   *domain.MockTaskUpdater
 		encryptor *domain.MockSummaryEncryptor
 	}
 
-	type args struct {
-		ctx  context.Context
-		task domain.Task
-		user domain.User
-	}
-
 	var (
 		task = domain.Task{
-			ID:      1,
-			Summary: "task summary test",
-			UserID:  1,
+			ID:       1,
+			Summary:  "encrypted",
+			UserID:   2,
+			Priority: domain.TaskPriorityP1,
+			Status:   domain.TaskStatusOpen,
 		}
 		technicalUser = domain.User{
-			ID:     1,
+			ID:     2,
 			RoleID: 2,
 		}
 	)
 
+	type args struct {
+		ctx  context.Context
+		user domain.User
+	}
 	tests := []struct {
 		name            string
 		args            args
@@ -537,45 +717,691 @@ func Test_taskUseCase_Update(t *testing.T) {
 		wantErr         bool
 	}{
 		{
-			name: "Expect error when task ID is missing",
+			name: "Expect error when user ID is missing",
 			args: args{
-				ctx: context.Background(),
-				task: domain.Task{
-					Summary: task.Summary,
-					UserID:  task.UserID,
-				},
-				user: technicalUser,
+				ctx:  context.Background(),
+				user: domain.User{RoleID: technicalUser.RoleID},
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error when user ID is missing",
+			name: "Expect error thrown by ListNextByUserID",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
-				user: domain.User{
-					RoleID: technicalUser.RoleID,
-				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListNextByUserID(context.Background(), technicalUser.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error thrown by ListByIDAndUserID",
+			name: "Expect error thrown by Decrypt",
 			args: args{
 				ctx:  context.Background(),
-				task: task,
 				user: technicalUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{}, errors.New("err"))
+				d.retriever.EXPECT().ListNextByUserID(context.Background(), technicalUser.ID).Return(task, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return("", errors.New("err"))
 			},
 			want:    domain.Task{},
 			wantErr: true,
 		},
 		{
-			name: "Expect error thrown by Encrypt",
+			name: "Expect success",
+			args: args{
+				ctx:  context.Background(),
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListNextByUserID(context.Background(), technicalUser.ID).Return(task, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return("task summary test", nil)
+			},
+			want: domain.Task{
+				ID:       1,
+				Summary:  "task summary test",
+				UserID:   2,
+				Priority: domain.TaskPriorityP1,
+				Status:   domain.TaskStatusOpen,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockTaskRetriever(ctrl),
+				encryptor: domain.NewMockSummaryEncryptor(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				retriever: d.retriever,
+				encryptor: d.encryptor,
+			}
+
+			got, err := u.Next(tt.args.ctx, tt.args.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Next() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskUseCase_Add(t *testing.T) {
+	type dependencies struct {
+		creator     *domain.MockTaskCreator
+		retriever   *domain.MockTaskRetriever
+		encryptor   *domain.MockSummaryEncryptor
+		slaPolicies *domain.MockSLAPolicyRetriever
+		sites       *domain.MockSiteRetriever
+	}
+
+	type args struct {
+		ctx  context.Context
+		task domain.Task
+		user domain.User
+	}
+
+	var (
+		now  = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		due  = now.Add(48 * time.Hour)
+		date = now.Add(24 * time.Hour)
+		end  = date.Add(2 * time.Hour)
+		task = domain.Task{
+			ID:       1,
+			Summary:  "task summary test",
+			UserID:   2,
+			Type:     domain.DefaultTaskType,
+			Priority: domain.DefaultTaskPriority,
+			Status:   domain.TaskStatusOpen,
+		}
+		stored = domain.Task{
+			ID:        1,
+			Summary:   "task summary test",
+			UserID:    2,
+			Type:      domain.DefaultTaskType,
+			Priority:  domain.DefaultTaskPriority,
+			Status:    domain.TaskStatusOpen,
+			DueAt:     &due,
+			CreatedAt: now,
+		}
+		created = func() domain.Task {
+			t := stored
+			t.Version = domain.InitialTaskVersion
+			return t
+		}()
+		policy = domain.SLAPolicy{
+			TaskType:       domain.DefaultTaskType,
+			ResolutionTime: 48 * time.Hour,
+		}
+		technicalUser = domain.User{
+			ID:     2,
+			RoleID: 2,
+		}
+		technicalUser2 = domain.User{
+			ID:     3,
+			RoleID: 2,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		setDependencies func(d *dependencies)
+		want            domain.Task
+		wantErr         bool
+	}{
+		{
+			name: "Expect error when priority is not supported",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:       task.ID,
+					Summary:  task.Summary,
+					UserID:   task.UserID,
+					Type:     task.Type,
+					Priority: "P0",
+				},
+				user: technicalUser,
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when site does not exist",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					UserID:  task.UserID,
+					Type:    task.Type,
+					SiteID:  9,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.sites.EXPECT().ListByID(context.Background(), int64(9)).Return(domain.Site{}, domain.ErrSiteNotFound)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when task does not fit in its time window",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:          task.ID,
+					Summary:     task.Summary,
+					Date:        &date,
+					Duration:    3 * time.Hour,
+					UserID:      task.UserID,
+					Type:        task.Type,
+					WindowStart: &date,
+					WindowEnd:   &end,
+				},
+				user: technicalUser,
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect conflict error when the technician is already booked",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:       task.ID,
+					Summary:  task.Summary,
+					Date:     &date,
+					Duration: 2 * time.Hour,
+					UserID:   task.UserID,
+					Type:     task.Type,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListOverlapping(context.Background(), task.UserID, date, end, task.ID).
+					Return([]domain.Task{{ID: 7}, {ID: 9}}, nil)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect conflict error when a task without duration starts with another booking",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					Date:    &date,
+					UserID:  task.UserID,
+					Type:    task.Type,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListOverlapping(context.Background(), task.UserID, date, date.Add(domain.MinBookingDuration), task.ID).
+					Return([]domain.Task{{ID: 7}}, nil)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when task user ID is missing",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					Summary: task.Summary,
+				},
+				user: technicalUser,
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when user ID is missing",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: domain.User{
+					RoleID: technicalUser.RoleID,
+				},
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when task user role ID is missing",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: domain.User{
+					ID: technicalUser.ID,
+				},
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect forbidden error when user is tries to add a task for somebody else",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser2,
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by ListByTaskType",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(domain.SLAPolicy{}, errors.New("err"))
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by Encrypt",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(policy, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return("", errors.New("err"))
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by Add",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(policy, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.creator.EXPECT().Add(context.Background(), stored).Return(task.ID, errors.New("err"))
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by Decrypt",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(policy, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.creator.EXPECT().Add(context.Background(), stored).Return(task.ID, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return("", errors.New("err"))
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(policy, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.creator.EXPECT().Add(context.Background(), stored).Return(task.ID, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+			},
+			want:    created,
+			wantErr: false,
+		},
+		{
+			name: "Expect success without deadline when there is no sla policy",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), task.Type, domain.DefaultTaskPriority).Return(domain.SLAPolicy{}, domain.ErrSLAPolicyNotFound)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.creator.EXPECT().Add(context.Background(), domain.Task{
+					ID:        task.ID,
+					Summary:   task.Summary,
+					UserID:    task.UserID,
+					Type:      task.Type,
+					Priority:  task.Priority,
+					Status:    task.Status,
+					CreatedAt: now,
+				}).Return(task.ID, nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+			},
+			want: domain.Task{
+				ID:        task.ID,
+				Summary:   task.Summary,
+				UserID:    task.UserID,
+				Type:      task.Type,
+				Priority:  task.Priority,
+				Status:    task.Status,
+				CreatedAt: now,
+				Version:   domain.InitialTaskVersion,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				encryptor:   domain.NewMockSummaryEncryptor(ctrl),
+				creator:     domain.NewMockTaskCreator(ctrl),
+				retriever:   domain.NewMockTaskRetriever(ctrl),
+				slaPolicies: domain.NewMockSLAPolicyRetriever(ctrl),
+				sites:       domain.NewMockSiteRetriever(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				creator:     d.creator,
+				retriever:   d.retriever,
+				encryptor:   d.encryptor,
+				slaPolicies: d.slaPolicies,
+				sites:       d.sites,
+				now:         func() time.Time { return now },
+			}
+
+			got, err := u.Add(tt.args.ctx, tt.args.task, tt.args.user)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskUseCase_Add_Notify_Concurrent(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		now  = time.Now()
+		date = now.Add(time.Hour)
+		task = domain.Task{
+			ID:        1,
+			Summary:   "task summary test",
+			Date:      &date,
+			UserID:    2,
+			Type:      domain.DefaultTaskType,
+			Priority:  domain.DefaultTaskPriority,
+			Status:    domain.TaskStatusOpen,
+			DueAt:     &date,
+			CreatedAt: now,
+		}
+		user = domain.User{
+			ID:     2,
+			RoleID: 2,
+		}
+	)
+
+	created := task
+	created.Version = domain.InitialTaskVersion
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+	encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+	encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+
+	slaPolicies := domain.NewMockSLAPolicyRetriever(ctrl)
+	slaPolicies.EXPECT().ListByTaskTypeAndPriority(ctx, task.Type, domain.DefaultTaskPriority).Return(domain.SLAPolicy{TaskType: task.Type, ResolutionTime: 48 * time.Hour}, nil)
+
+	retriever := domain.NewMockTaskRetriever(ctrl)
+	retriever.EXPECT().ListOverlapping(ctx, task.UserID, date, date.Add(domain.MinBookingDuration), task.ID).Return(nil, nil)
+
+	creator := domain.NewMockTaskCreator(ctrl)
+	creator.EXPECT().Add(ctx, task).Return(task.ID, nil)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	notifier := domain.NewMockTaskNotifier(ctrl)
+	notifier.EXPECT().SendNotification(gomock.Any(), gomock.Any()).
+		Do(func(arg0, arg1 interface{}) interface{} {
+			defer wg.Done()
+			return nil
+		})
+
+	userNotifier := domain.NewMockUserNotifier(ctrl)
+	userNotifier.EXPECT().Notify(gomock.Any(), domain.EventTaskAssigned, task.UserID, gomock.Any()).
+		Do(func(arg0, arg1, arg2, arg3 interface{}) interface{} {
+			defer wg.Done()
+			return nil
+		})
+
+	reminders := domain.NewMockReminderScheduler(ctrl)
+	reminders.EXPECT().Schedule(ctx, created).Return(nil)
+
+	u := &taskUseCase{
+		creator:      creator,
+		retriever:    retriever,
+		encryptor:    encryptor,
+		notifier:     notifier,
+		userNotifier: userNotifier,
+		reminders:    reminders,
+		slaPolicies:  slaPolicies,
+		now:          func() time.Time { return now },
+	}
+	got, err := u.Add(ctx, domain.Task{
+		ID:      task.ID,
+		Summary: task.Summary,
+		Date:    task.Date,
+		UserID:  task.UserID,
+		Type:    task.Type,
+	}, user)
+
+	wg.Wait()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, created, got)
+}
+
+func Test_taskUseCase_Update(t *testing.T) {
+	type dependencies struct {
+		retriever   *domain.MockTaskRetriever
+		updater     *domain.MockTaskUpdater
+		encryptor   *domain.MockSummaryEncryptor
+		checklists  *domain.MockChecklistItemRetriever
+		slaPolicies *domain.MockSLAPolicyRetriever
+	}
+
+	type args struct {
+		ctx  context.Context
+		task domain.Task
+		user domain.User
+	}
+
+	var (
+		task = domain.Task{
+			ID:      1,
+			Summary: "task summary test",
+			UserID:  1,
+		}
+		saved = domain.Task{
+			ID:      1,
+			Summary: "task summary test",
+			UserID:  1,
+			Version: 1,
+		}
+		technicalUser = domain.User{
+			ID:     1,
+			RoleID: 2,
+		}
+		createdAt   = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		urgentDueAt = createdAt.Add(8 * time.Hour)
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		setDependencies func(d *dependencies)
+		want            domain.Task
+		wantErr         bool
+	}{
+		{
+			name: "Expect error when task ID is missing",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					Summary: task.Summary,
+					UserID:  task.UserID,
+				},
+				user: technicalUser,
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when user ID is missing",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: domain.User{
+					RoleID: technicalUser.RoleID,
+				},
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error thrown by ListByIDAndUserID",
+			args: args{
+				ctx:  context.Background(),
+				task: task,
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{}, errors.New("err"))
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when status transition is not allowed",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:     task.ID,
+					Status: domain.TaskStatusOpen,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					UserID:  task.UserID,
+					Status:  domain.TaskStatusCompleted,
+				}, nil)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when completing with unchecked required items",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:     task.ID,
+					Status: domain.TaskStatusCompleted,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					UserID:  task.UserID,
+					Status:  domain.TaskStatusInProgress,
+				}, nil)
+				d.checklists.EXPECT().ListByTaskID(context.Background(), task.ID).Return([]domain.ChecklistItem{
+					{ID: 1, TaskID: task.ID, Label: "Isolate power", Required: true},
+				}, nil)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect task completed when required items are checked",
+			args: args{
+				ctx: context.Background(),
+				task: domain.Task{
+					ID:     task.ID,
+					Status: domain.TaskStatusCompleted,
+				},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				checkedAt := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+				completed := domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					UserID:  task.UserID,
+					Status:  domain.TaskStatusCompleted,
+				}
+
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{
+					ID:      task.ID,
+					Summary: task.Summary,
+					UserID:  task.UserID,
+					Status:  domain.TaskStatusInProgress,
+				}, nil)
+				d.checklists.EXPECT().ListByTaskID(context.Background(), task.ID).Return([]domain.ChecklistItem{
+					{ID: 1, TaskID: task.ID, Label: "Isolate power", Required: true, CheckedBy: task.UserID, CheckedAt: &checkedAt},
+					{ID: 2, TaskID: task.ID, Label: "Take photos"},
+				}, nil)
+				d.updater.EXPECT().Update(context.Background(), completed).Return(nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+			},
+			want: domain.Task{
+				ID:      task.ID,
+				Summary: task.Summary,
+				UserID:  task.UserID,
+				Status:  domain.TaskStatusCompleted,
+				Version: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect error thrown by Encrypt",
 			args: args{
 				ctx:  context.Background(),
 				task: task,
@@ -632,7 +1458,55 @@ func Test_taskUseCase_Update(t *testing.T) {
 				d.updater.EXPECT().Update(context.Background(), task).Return(nil)
 				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
 			},
-			want:    task,
+			want:    saved,
+			wantErr: false,
+		},
+		{
+			name: "Expect version conflict when the task changed since it was read",
+			args: args{
+				ctx:  context.Background(),
+				task: domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 3},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 4}, nil)
+			},
+			want:    domain.Task{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success when the version matches",
+			args: args{
+				ctx:  context.Background(),
+				task: domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 4},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).Return(domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 4}, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.updater.EXPECT().Update(context.Background(), domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 4}).Return(nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+			},
+			want:    domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Version: 5},
+			wantErr: false,
+		},
+		{
+			name: "Expect deadline of an undated task to follow its new priority",
+			args: args{
+				ctx:  context.Background(),
+				task: domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Priority: domain.TaskPriorityP1},
+				user: technicalUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListByIDAndUserID(context.Background(), task.ID, task.UserID).
+					Return(domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Type: domain.DefaultTaskType, Priority: domain.DefaultTaskPriority, CreatedAt: createdAt}, nil)
+				d.encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+				d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(context.Background(), domain.DefaultTaskType, domain.TaskPriorityP1).
+					Return(domain.SLAPolicy{Priority: domain.TaskPriorityP1, ResolutionTime: 8 * time.Hour}, nil)
+				d.updater.EXPECT().Update(context.Background(), domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Type: domain.DefaultTaskType, Priority: domain.TaskPriorityP1, DueAt: &urgentDueAt, CreatedAt: createdAt}).Return(nil)
+				d.encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+			},
+			want:    domain.Task{ID: task.ID, Summary: task.Summary, UserID: task.UserID, Type: domain.DefaultTaskType, Priority: domain.TaskPriorityP1, DueAt: &urgentDueAt, CreatedAt: createdAt, Version: 1},
 			wantErr: false,
 		},
 	}
@@ -642,9 +1516,11 @@ func Test_taskUseCase_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			d := dependencies{
-				retriever: domain.NewMockTaskRetriever(ctrl),
-				updater:   domain.NewMockTaskUpdater(ctrl),
-				encryptor: domain.NewMockSummaryEncryptor(ctrl),
+				retriever:   domain.NewMockTaskRetriever(ctrl),
+				updater:     domain.NewMockTaskUpdater(ctrl),
+				encryptor:   domain.NewMockSummaryEncryptor(ctrl),
+				checklists:  domain.NewMockChecklistItemRetriever(ctrl),
+				slaPolicies: domain.NewMockSLAPolicyRetriever(ctrl),
 			}
 
 			if tt.setDependencies != nil {
@@ -652,9 +1528,11 @@ func Test_taskUseCase_Update(t *testing.T) {
 			}
 
 			u := &taskUseCase{
-				retriever: d.retriever,
-				updater:   d.updater,
-				encryptor: d.encryptor,
+				retriever:   d.retriever,
+				updater:     d.updater,
+				encryptor:   d.encryptor,
+				checklists:  d.checklists,
+				slaPolicies: d.slaPolicies,
 			}
 
 			got, err := u.Update(tt.args.ctx, tt.args.task, tt.args.user)
@@ -668,22 +1546,103 @@ func Test_taskUseCase_Update(t *testing.T) {
 	}
 }
 
-func Test_taskUseCase_Update_Notify_Concurrent(t *testing.T) {
+func Test_taskUseCase_Update_Notify_Concurrent(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		date = time.Now()
+		task = domain.Task{
+			ID:      1,
+			Summary: "task summary test",
+			Date:    &date,
+			UserID:  2,
+		}
+		user = domain.User{
+			ID:     2,
+			RoleID: 2,
+		}
+	)
+
+	saved := task
+	saved.Version++
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encryptor := domain.NewMockSummaryEncryptor(ctrl)
+	encryptor.EXPECT().Encrypt(task.Summary).Return(task.Summary, nil)
+	encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
+
+	updater := domain.NewMockTaskUpdater(ctrl)
+	updater.EXPECT().Update(ctx, task).Return(nil)
+
+	retriever := domain.NewMockTaskRetriever(ctrl)
+	retriever.EXPECT().ListByIDAndUserID(ctx, task.ID, task.UserID).Return(task, nil)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	notifier := domain.NewMockTaskNotifier(ctrl)
+	notifier.EXPECT().SendNotification(gomock.Any(), gomock.Any()).
+		Do(func(arg0, arg1 interface{}) interface{} {
+			defer wg.Done()
+			return nil
+		})
+
+	u := &taskUseCase{
+		retriever: retriever,
+		updater:   updater,
+		encryptor: encryptor,
+		notifier:  notifier,
+	}
+	got, err := u.Update(ctx, task, user)
+
+	wg.Wait()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, saved, got)
+}
+
+func Test_taskUseCase_Update_Notify_Rescheduled(t *testing.T) {
 	var (
-		ctx  = context.Background()
-		date = time.Now()
+		ctx      = context.Background()
+		previous = time.Now()
+		date     = previous.Add(24 * time.Hour)
+		stored   = domain.Task{
+			ID:       1,
+			Summary:  "task summary test",
+			Date:     &previous,
+			UserID:   2,
+			Type:     domain.DefaultTaskType,
+			Priority: domain.DefaultTaskPriority,
+			Status:   domain.TaskStatusOpen,
+			DueAt:    &previous,
+			Version:  4,
+		}
 		task = domain.Task{
 			ID:      1,
 			Summary: "task summary test",
 			Date:    &date,
 			UserID:  2,
 		}
+		updated = domain.Task{
+			ID:       1,
+			Summary:  "task summary test",
+			Date:     &date,
+			UserID:   2,
+			Type:     domain.DefaultTaskType,
+			Priority: domain.DefaultTaskPriority,
+			Status:   domain.TaskStatusOpen,
+			DueAt:    &date,
+			Version:  4,
+		}
 		user = domain.User{
 			ID:     2,
 			RoleID: 2,
 		}
 	)
 
+	saved := updated
+	saved.Version++
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -692,43 +1651,65 @@ func Test_taskUseCase_Update_Notify_Concurrent(t *testing.T) {
 	encryptor.EXPECT().Decrypt(task.Summary).Return(task.Summary, nil)
 
 	updater := domain.NewMockTaskUpdater(ctrl)
-	updater.EXPECT().Update(ctx, task).Return(nil)
+	slaPolicies := domain.NewMockSLAPolicyRetriever(ctrl)
+	slaPolicies.EXPECT().ListByTaskTypeAndPriority(ctx, domain.DefaultTaskType, domain.DefaultTaskPriority).Return(domain.SLAPolicy{TaskType: domain.DefaultTaskType, ResolutionTime: 48 * time.Hour}, nil)
+
+	updater.EXPECT().Update(ctx, updated).Return(nil)
 
 	retriever := domain.NewMockTaskRetriever(ctrl)
-	retriever.EXPECT().ListByIDAndUserID(ctx, task.ID, task.UserID).Return(task, nil)
+	retriever.EXPECT().ListByIDAndUserID(ctx, task.ID, task.UserID).Return(stored, nil)
+	retriever.EXPECT().ListOverlapping(ctx, task.UserID, date, date.Add(domain.MinBookingDuration), task.ID).Return(nil, nil)
 
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(2)
 
 	notifier := domain.NewMockTaskNotifier(ctrl)
-	notifier.EXPECT().SendNotification(ctx, gomock.Any()).
+	notifier.EXPECT().SendNotification(gomock.Any(), gomock.Any()).
 		Do(func(arg0, arg1 interface{}) interface{} {
 			defer wg.Done()
 			return nil
 		})
 
+	userNotifier := domain.NewMockUserNotifier(ctrl)
+	userNotifier.EXPECT().Notify(gomock.Any(), domain.EventTaskRescheduled, task.UserID, map[string]string{
+		"task_id": "1",
+		"date":    date.UTC().Format(domain.DateTimeLayout),
+	}).
+		Do(func(arg0, arg1, arg2, arg3 interface{}) interface{} {
+			defer wg.Done()
+			return nil
+		})
+
+	reminders := domain.NewMockReminderScheduler(ctrl)
+	reminders.EXPECT().Schedule(ctx, saved).Return(nil)
+
 	u := &taskUseCase{
-		retriever: retriever,
-		updater:   updater,
-		encryptor: encryptor,
-		notifier:  notifier,
+		retriever:    retriever,
+		updater:      updater,
+		encryptor:    encryptor,
+		notifier:     notifier,
+		userNotifier: userNotifier,
+		reminders:    reminders,
+		slaPolicies:  slaPolicies,
 	}
 	got, err := u.Update(ctx, task, user)
 
 	wg.Wait()
 	assert.Equal(t, nil, err)
-	assert.Equal(t, task, got)
+	assert.Equal(t, saved, got)
 }
 
 func Test_taskUseCase_Remove(t *testing.T) {
 	type dependencies struct {
-		remover *domain.MockTaskRemover
+		remover   *domain.MockTaskRemover
+		reminders *domain.MockReminderScheduler
 	}
 
 	type args struct {
-		ctx  context.Context
-		id   int64
-		user domain.User
+		ctx     context.Context
+		id      int64
+		version int64
+		user    domain.User
 	}
 
 	var (
@@ -788,14 +1769,41 @@ func Test_taskUseCase_Remove(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Expect success",
+			name: "Expect error thrown by Remove",
 			args: args{
 				ctx:  context.Background(),
 				id:   id,
 				user: managerUser,
 			},
 			setDependencies: func(d *dependencies) {
-				d.remover.EXPECT().Remove(context.Background(), id).Return(nil)
+				d.remover.EXPECT().Remove(context.Background(), id, int64(0)).Return(errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect version conflict thrown by Remove",
+			args: args{
+				ctx:     context.Background(),
+				id:      id,
+				version: 3,
+				user:    managerUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().Remove(context.Background(), id, int64(3)).Return(&domain.TaskVersionError{TaskID: id, Expected: 3, Current: 4})
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				ctx:     context.Background(),
+				id:      id,
+				version: 3,
+				user:    managerUser,
+			},
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().Remove(context.Background(), id, int64(3)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), id).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -806,7 +1814,8 @@ func Test_taskUseCase_Remove(t *testing.T) {
 			defer ctrl.Finish()
 
 			d := dependencies{
-				remover: domain.NewMockTaskRemover(ctrl),
+				remover:   domain.NewMockTaskRemover(ctrl),
+				reminders: domain.NewMockReminderScheduler(ctrl),
 			}
 
 			if tt.setDependencies != nil {
@@ -814,10 +1823,11 @@ func Test_taskUseCase_Remove(t *testing.T) {
 			}
 
 			u := &taskUseCase{
-				remover: d.remover,
+				remover:   d.remover,
+				reminders: d.reminders,
 			}
 
-			err := u.Remove(tt.args.ctx, tt.args.id, tt.args.user)
+			err := u.Remove(tt.args.ctx, tt.args.id, tt.args.version, tt.args.user)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Remove() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -825,3 +1835,437 @@ func Test_taskUseCase_Remove(t *testing.T) {
 		})
 	}
 }
+
+func Test_taskUseCase_Batch(t *testing.T) {
+	type dependencies struct {
+		remover    *domain.MockTaskRemover
+		reminders  *domain.MockReminderScheduler
+		transactor *domain.MockTaskTransactor
+	}
+
+	var (
+		managerUser = domain.User{ID: 1, RoleID: 1}
+		removals    = []domain.TaskOperation{
+			{Method: domain.TaskOperationDelete, Task: domain.Task{ID: 1}},
+			{Method: domain.TaskOperationDelete, Task: domain.Task{ID: 2, Version: 3}},
+		}
+		inTransaction = func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}
+	)
+
+	tests := []struct {
+		name            string
+		operations      []domain.TaskOperation
+		atomic          bool
+		setDependencies func(d *dependencies)
+		want            []domain.TaskOperationResult
+		wantErr         error
+	}{
+		{
+			name:    "Expect error when the batch is empty",
+			wantErr: domain.ErrInvalidTaskBatch,
+		},
+		{
+			name:       "Expect error when the batch is too large",
+			operations: make([]domain.TaskOperation, domain.MaxTaskBatchSize+1),
+			wantErr:    domain.ErrInvalidTaskBatch,
+		},
+		{
+			name:       "Expect error when a method is not supported",
+			operations: []domain.TaskOperation{removals[0], {Method: "archive"}},
+			wantErr:    domain.ErrInvalidTaskBatch,
+		},
+		{
+			name:       "Expect best effort batch to report each operation",
+			operations: removals,
+			setDependencies: func(d *dependencies) {
+				d.remover.EXPECT().Remove(context.Background(), int64(1), int64(0)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), int64(1)).Return(nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(2), int64(3)).Return(domain.ErrTasksNotFound)
+			},
+			want: []domain.TaskOperationResult{
+				{Task: domain.Task{ID: 1}},
+				{Task: domain.Task{ID: 2}, Err: domain.ErrTasksNotFound},
+			},
+		},
+		{
+			name:       "Expect atomic batch to abort every other operation on failure",
+			operations: removals,
+			atomic:     true,
+			setDependencies: func(d *dependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(inTransaction)
+				d.remover.EXPECT().Remove(context.Background(), int64(1), int64(0)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), int64(1)).Return(nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(2), int64(3)).Return(domain.ErrTasksNotFound)
+			},
+			want: []domain.TaskOperationResult{
+				{Task: domain.Task{ID: 1}, Err: domain.ErrTaskBatchAborted},
+				{Task: domain.Task{ID: 2}, Err: domain.ErrTasksNotFound},
+			},
+		},
+		{
+			name:       "Expect atomic batch to succeed",
+			operations: removals,
+			atomic:     true,
+			setDependencies: func(d *dependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(inTransaction)
+				d.remover.EXPECT().Remove(context.Background(), int64(1), int64(0)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), int64(1)).Return(nil)
+				d.remover.EXPECT().Remove(context.Background(), int64(2), int64(3)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), int64(2)).Return(nil)
+			},
+			want: []domain.TaskOperationResult{
+				{Task: domain.Task{ID: 1}},
+				{Task: domain.Task{ID: 2}},
+			},
+		},
+		{
+			name:       "Expect error when the transaction cannot commit",
+			operations: removals[:1],
+			atomic:     true,
+			setDependencies: func(d *dependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					if err := fn(ctx); err != nil {
+						return err
+					}
+
+					return errors.New("commit failed")
+				})
+				d.remover.EXPECT().Remove(context.Background(), int64(1), int64(0)).Return(nil)
+				d.reminders.EXPECT().Cancel(context.Background(), int64(1)).Return(nil)
+			},
+			wantErr: errors.New("commit failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				remover:    domain.NewMockTaskRemover(ctrl),
+				reminders:  domain.NewMockReminderScheduler(ctrl),
+				transactor: domain.NewMockTaskTransactor(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				remover:    d.remover,
+				reminders:  d.reminders,
+				transactor: d.transactor,
+			}
+
+			got, err := u.Batch(context.Background(), tt.operations, tt.atomic, managerUser)
+			if tt.wantErr != nil {
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskUseCase_Export(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockTaskRetriever
+		encryptor *domain.MockSummaryEncryptor
+	}
+
+	var (
+		now         = time.Date(2023, 12, 7, 10, 0, 0, 0, time.UTC)
+		managerUser = domain.User{ID: 1, RoleID: 1}
+		stream      = func(_ context.Context, _ domain.TaskFilter, fn func(domain.Task) error) error {
+			for _, task := range []domain.Task{{ID: 1, Summary: "encrypted-1"}, {ID: 2, Summary: "broken"}, {ID: 3, Summary: "encrypted-3"}} {
+				if err := fn(task); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		filter          domain.TaskFilter
+		setDependencies func(d *dependencies)
+		want            []domain.Task
+		wantErr         error
+	}{
+		{
+			name:    "Expect technicians to be forbidden",
+			user:    domain.User{ID: 2, RoleID: 2},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect unsupported filter to be rejected",
+			user:    managerUser,
+			filter:  domain.TaskFilter{Sort: "summary"},
+			wantErr: domain.ErrInvalidTask,
+		},
+		{
+			name:   "Expect summaries to be decrypted and undecryptable tasks skipped",
+			user:   managerUser,
+			filter: domain.TaskFilter{Priority: domain.TaskPriorityP1},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().Stream(context.Background(), domain.TaskFilter{Priority: domain.TaskPriorityP1, Now: now}, gomock.Any()).DoAndReturn(stream)
+				d.encryptor.EXPECT().Decrypt("encrypted-1").Return("first", nil)
+				d.encryptor.EXPECT().Decrypt("broken").Return("", errors.New("err"))
+				d.encryptor.EXPECT().Decrypt("encrypted-3").Return("third", nil)
+			},
+			want: []domain.Task{{ID: 1, Summary: "first"}, {ID: 3, Summary: "third"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockTaskRetriever(ctrl),
+				encryptor: domain.NewMockSummaryEncryptor(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				retriever: d.retriever,
+				encryptor: d.encryptor,
+				now:       func() time.Time { return now },
+			}
+
+			var got []domain.Task
+			err := u.Export(context.Background(), tt.user, tt.filter, func(task domain.Task) error {
+				got = append(got, task)
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_taskUseCase_Import(t *testing.T) {
+	type dependencies struct {
+		creator     *domain.MockTaskCreator
+		encryptor   *domain.MockSummaryEncryptor
+		slaPolicies *domain.MockSLAPolicyRetriever
+		users       *domain.MockUserRetriever
+		transactor  *domain.MockTaskTransactor
+	}
+
+	var (
+		now         = time.Date(2023, 12, 8, 10, 0, 0, 0, time.UTC)
+		managerUser = domain.User{ID: 1, RoleID: 1}
+		parseErr    = errors.New("date must be an RFC 3339 date")
+		rows        = []domain.TaskImportRow{
+			{Line: 2, TechnicianEmail: "tech@example.com", Task: domain.Task{Summary: "Fix the pump"}},
+			{Line: 3, Err: parseErr},
+			{Line: 4, TechnicianEmail: "manager@example.com", Task: domain.Task{Summary: "Fix the pump"}},
+			{Line: 5, TechnicianEmail: "tech@example.com"},
+		}
+		prepared = domain.Task{Summary: "Fix the pump", UserID: 2, Type: domain.DefaultTaskType, Priority: domain.DefaultTaskPriority, Status: domain.TaskStatusOpen, CreatedAt: now}
+		lookups  = func(d *dependencies) {
+			d.users.EXPECT().ListByEmail(gomock.Any(), "tech@example.com").Return(domain.User{ID: 2, RoleID: 2}, nil)
+			d.users.EXPECT().ListByEmail(gomock.Any(), "manager@example.com").Return(domain.User{ID: 1, RoleID: 1}, nil)
+			d.slaPolicies.EXPECT().ListByTaskTypeAndPriority(gomock.Any(), domain.DefaultTaskType, domain.DefaultTaskPriority).Return(domain.SLAPolicy{}, domain.ErrSLAPolicyNotFound)
+		}
+	)
+
+	tests := []struct {
+		name            string
+		rows            []domain.TaskImportRow
+		dryRun          bool
+		user            domain.User
+		setDependencies func(d *dependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect technicians to be forbidden",
+			rows:    rows,
+			user:    domain.User{ID: 2, RoleID: 2},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect error when the file has no rows",
+			user:    managerUser,
+			wantErr: domain.ErrInvalidTaskImport,
+		},
+		{
+			name:            "Expect dry run to report every row without storing",
+			rows:            rows,
+			dryRun:          true,
+			user:            managerUser,
+			setDependencies: lookups,
+		},
+		{
+			name: "Expect valid rows to be stored encrypted",
+			rows: rows,
+			user: managerUser,
+			setDependencies: func(d *dependencies) {
+				lookups(d)
+				d.transactor.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				d.encryptor.EXPECT().Encrypt("Fix the pump").Return("encrypted", nil)
+				stored := prepared
+				stored.Summary = "encrypted"
+				d.creator.EXPECT().Add(gomock.Any(), stored).Return(int64(7), nil)
+				d.encryptor.EXPECT().Decrypt("encrypted").Return("Fix the pump", nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				creator:     domain.NewMockTaskCreator(ctrl),
+				encryptor:   domain.NewMockSummaryEncryptor(ctrl),
+				slaPolicies: domain.NewMockSLAPolicyRetriever(ctrl),
+				users:       domain.NewMockUserRetriever(ctrl),
+				transactor:  domain.NewMockTaskTransactor(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				creator:     d.creator,
+				encryptor:   d.encryptor,
+				slaPolicies: d.slaPolicies,
+				users:       d.users,
+				transactor:  d.transactor,
+				now:         func() time.Time { return now },
+			}
+
+			got, err := u.Import(context.Background(), tt.rows, tt.dryRun, tt.user)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got, len(tt.rows))
+
+			assert.NoError(t, got[0].Err)
+			assert.Equal(t, int64(2), got[0].Task.UserID)
+			if !tt.dryRun {
+				assert.Equal(t, int64(7), got[0].Task.ID)
+			}
+
+			assert.Equal(t, parseErr, got[1].Err)
+			assert.ErrorIs(t, got[2].Err, domain.ErrInvalidTaskImport)
+			assert.ErrorIs(t, got[3].Err, domain.ErrInvalidTask)
+			assert.EqualError(t, got[3].Err, "task fields are invalid: summary must not be empty")
+			assert.Equal(t, []int{2, 3, 4, 5}, []int{got[0].Line, got[1].Line, got[2].Line, got[3].Line})
+		})
+	}
+}
+
+func Test_taskUseCase_Changes(t *testing.T) {
+	type dependencies struct {
+		retriever *domain.MockTaskRetriever
+		encryptor *domain.MockSummaryEncryptor
+	}
+
+	var (
+		now       = time.Date(2023, 12, 8, 10, 0, 0, 500, time.UTC)
+		until     = time.Date(2023, 12, 8, 10, 0, 0, 0, time.UTC)
+		changedAt = time.Date(2023, 12, 8, 9, 59, 58, 0, time.UTC)
+		cursor    = domain.TaskCursor{ChangedAt: changedAt.Add(-time.Minute), TaskID: 4}
+		changes   = []domain.TaskChange{
+			{Type: domain.TaskChangeCreated, Task: domain.Task{ID: 1, Summary: "encrypted-1", UserID: 2, Version: 1}, ChangedAt: changedAt},
+			{Type: domain.TaskChangeDeleted, Task: domain.Task{ID: 3, Summary: "broken", UserID: 2, Version: 2}, ChangedAt: changedAt},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *dependencies)
+		want            []domain.TaskChange
+		wantCursor      domain.TaskCursor
+		wantErr         error
+	}{
+		{
+			name:    "Expect unknown roles to be forbidden",
+			user:    domain.User{ID: 2, RoleID: 3},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect managers to see every change and the cursor to pass undecryptable ones",
+			user: domain.User{ID: 1, RoleID: 1},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListChanged(context.Background(), int64(0), cursor, until, domain.MaxTaskChanges).Return(changes, nil)
+				d.encryptor.EXPECT().Decrypt("encrypted-1").Return("first", nil)
+				d.encryptor.EXPECT().Decrypt("broken").Return("", errors.New("err"))
+			},
+			want:       []domain.TaskChange{{Type: domain.TaskChangeCreated, Task: domain.Task{ID: 1, Summary: "first", UserID: 2, Version: 1}, ChangedAt: changedAt}},
+			wantCursor: domain.TaskCursor{ChangedAt: changedAt, TaskID: 3},
+		},
+		{
+			name: "Expect technicians to only see their tasks",
+			user: domain.User{ID: 2, RoleID: 2},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListChanged(context.Background(), int64(2), cursor, until, domain.MaxTaskChanges).Return(nil, nil)
+			},
+			wantCursor: cursor,
+		},
+		{
+			name: "Expect storage errors to be returned",
+			user: domain.User{ID: 1, RoleID: 1},
+			setDependencies: func(d *dependencies) {
+				d.retriever.EXPECT().ListChanged(context.Background(), int64(0), cursor, until, domain.MaxTaskChanges).Return(nil, errors.New("err"))
+			},
+			wantErr: errors.New("err"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				retriever: domain.NewMockTaskRetriever(ctrl),
+				encryptor: domain.NewMockSummaryEncryptor(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &taskUseCase{
+				retriever: d.retriever,
+				encryptor: d.encryptor,
+				now:       func() time.Time { return now },
+			}
+
+			got, next, err := u.Changes(context.Background(), tt.user, cursor)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCursor, next)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"time"
+)
+
+type timeEntryUseCase struct {
+	creator   domain.TimeEntryCreator
+	retriever domain.TimeEntryRetriever
+	updater   domain.TimeEntryUpdater
+	tasks     domain.TaskRetriever
+	now       func() time.Time
+}
+
+func NewTimeEntry(
+	creator domain.TimeEntryCreator,
+	retriever domain.TimeEntryRetriever,
+	updater domain.TimeEntryUpdater,
+	tasks domain.TaskRetriever,
+) (domain.TimeEntryUsecase, error) {
+	if creator == nil {
+		return &timeEntryUseCase{}, errors.New("time entry creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &timeEntryUseCase{}, errors.New("time entry retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &timeEntryUseCase{}, errors.New("time entry updater must not be nil")
+	}
+
+	if tasks == nil {
+		return &timeEntryUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	return &timeEntryUseCase{
+		creator:   creator,
+		retriever: retriever,
+		updater:   updater,
+		tasks:     tasks,
+		now:       time.Now,
+	}, nil
+}
+
+func (u *timeEntryUseCase) Start(ctx context.Context, taskID int64, note string, user domain.User) (domain.TimeEntry, error) {
+	if taskID == 0 {
+		return domain.TimeEntry{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if len(note) > 500 {
+		return domain.TimeEntry{}, fmt.Errorf("%w: note size is too big", domain.ErrInvalidTimeEntry)
+	}
+
+	if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	_, err := u.retriever.ListRunningByUserID(ctx, user.ID)
+	if err == nil {
+		return domain.TimeEntry{}, domain.ErrTimerRunning
+	}
+
+	if !errors.Is(err, domain.ErrTimeEntryNotFound) {
+		return domain.TimeEntry{}, err
+	}
+
+	entry := domain.TimeEntry{
+		TaskID: taskID,
+		UserID: user.ID,
+		Start:  u.now().UTC().Truncate(time.Second),
+		Note:   note,
+	}
+
+	id, err := u.creator.Add(ctx, entry)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	entry.ID = id
+
+	return entry, nil
+}
+
+func (u *timeEntryUseCase) Stop(ctx context.Context, taskID int64, user domain.User) (domain.TimeEntry, error) {
+	if taskID == 0 {
+		return domain.TimeEntry{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	entry, err := u.retriever.ListRunningByUserID(ctx, user.ID)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if entry.TaskID != taskID {
+		return domain.TimeEntry{}, domain.ErrTimeEntryNotFound
+	}
+
+	end := u.now().UTC().Truncate(time.Second)
+	if !end.After(entry.Start) {
+		end = entry.Start.Add(time.Second)
+	}
+	entry.End = &end
+
+	if err := u.updater.Update(ctx, entry); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (u *timeEntryUseCase) Add(ctx context.Context, entry domain.TimeEntry, user domain.User) (domain.TimeEntry, error) {
+	if err := validateUser(user); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if entry.UserID != user.ID {
+		return domain.TimeEntry{}, domain.ErrUserNotAllowed
+	}
+
+	if entry.End == nil || entry.End.After(u.now()) {
+		return domain.TimeEntry{}, fmt.Errorf("%w: end must not be in the future", domain.ErrInvalidTimeEntry)
+	}
+
+	if _, err := u.tasks.ListByIDAndUserID(ctx, entry.TaskID, user.ID); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	overlapping, err := u.retriever.ListOverlapping(ctx, user.ID, entry.Start, *entry.End, 0)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if len(overlapping) > 0 {
+		return domain.TimeEntry{}, domain.ErrTimeEntryOverlap
+	}
+
+	id, err := u.creator.Add(ctx, entry)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+	entry.ID = id
+
+	return entry, nil
+}
+
+func (u *timeEntryUseCase) ListByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.TimeEntry, error) {
+	if taskID == 0 {
+		return []domain.TimeEntry{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return []domain.TimeEntry{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+			return []domain.TimeEntry{}, err
+		}
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *timeEntryUseCase) Approve(ctx context.Context, id int64, user domain.User) (domain.TimeEntry, error) {
+	if id == 0 {
+		return domain.TimeEntry{}, errors.New("time entry ID must not be 0")
+	}
+
+	if err := validateManager(user); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	entry, err := u.retriever.ListByID(ctx, id)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	if entry.IsRunning() {
+		return domain.TimeEntry{}, fmt.Errorf("%w: running timers cannot be approved", domain.ErrInvalidTimeEntry)
+	}
+
+	if entry.ApprovedAt != nil {
+		return entry, nil
+	}
+
+	now := u.now().UTC()
+	entry.ApprovedBy = user.ID
+	entry.ApprovedAt = &now
+
+	if err := u.updater.Update(ctx, entry); err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (u *timeEntryUseCase) Summarize(ctx context.Context, filter domain.TimeSummaryFilter, user domain.User) ([]domain.TimeSummary, error) {
+	if err := validateUser(user); err != nil {
+		return []domain.TimeSummary{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		filter.UserID = user.ID
+	}
+
+	return u.retriever.Summarize(ctx, filter)
+}
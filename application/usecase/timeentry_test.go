@@ -0,0 +1,391 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewTimeEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockTimeEntryCreator(ctrl)
+	retriever := domain.NewMockTimeEntryRetriever(ctrl)
+	updater := domain.NewMockTimeEntryUpdater(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+
+	type args struct {
+		creator   domain.TimeEntryCreator
+		retriever domain.TimeEntryRetriever
+		updater   domain.TimeEntryUpdater
+		tasks     domain.TaskRetriever
+	}
+
+	valid := args{creator, retriever, updater, tasks}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewTimeEntry(a.creator, a.retriever, a.updater, a.tasks)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTimeEntry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type timeEntryDependencies struct {
+	creator   *domain.MockTimeEntryCreator
+	retriever *domain.MockTimeEntryRetriever
+	updater   *domain.MockTimeEntryUpdater
+	tasks     *domain.MockTaskRetriever
+}
+
+func newTimeEntryUseCase(ctrl *gomock.Controller, now time.Time) (*timeEntryUseCase, timeEntryDependencies) {
+	d := timeEntryDependencies{
+		creator:   domain.NewMockTimeEntryCreator(ctrl),
+		retriever: domain.NewMockTimeEntryRetriever(ctrl),
+		updater:   domain.NewMockTimeEntryUpdater(ctrl),
+		tasks:     domain.NewMockTaskRetriever(ctrl),
+	}
+
+	return &timeEntryUseCase{
+		creator:   d.creator,
+		retriever: d.retriever,
+		updater:   d.updater,
+		tasks:     d.tasks,
+		now:       func() time.Time { return now },
+	}, d
+}
+
+func Test_timeEntryUseCase_Start(t *testing.T) {
+	var (
+		now  = time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC)
+		user = domain.User{ID: 2, RoleID: 2}
+		task = domain.Task{ID: 1, UserID: 2, Status: domain.TaskStatusInProgress}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *timeEntryDependencies)
+		wantErr         error
+	}{
+		{
+			name: "Expect error when task is not assigned to the technician",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name: "Expect error when a timer is already running",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListRunningByUserID(context.Background(), user.ID).Return(domain.TimeEntry{ID: 4, TaskID: 7}, nil)
+			},
+			wantErr: domain.ErrTimerRunning,
+		},
+		{
+			name: "Expect success",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListRunningByUserID(context.Background(), user.ID).Return(domain.TimeEntry{}, domain.ErrTimeEntryNotFound)
+				d.creator.EXPECT().Add(context.Background(), domain.TimeEntry{TaskID: 1, UserID: 2, Start: now, Note: "Diagnosis"}).Return(int64(4), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newTimeEntryUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Start(context.Background(), 1, "Diagnosis", user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Start() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, int64(4), got.ID)
+			assert.True(t, got.IsRunning())
+		})
+	}
+}
+
+func Test_timeEntryUseCase_Stop(t *testing.T) {
+	var (
+		now     = time.Date(2023, 11, 15, 13, 30, 0, 0, time.UTC)
+		user    = domain.User{ID: 2, RoleID: 2}
+		running = domain.TimeEntry{ID: 4, TaskID: 1, UserID: 2, Start: now.Add(-90 * time.Minute)}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *timeEntryDependencies)
+		wantErr         error
+	}{
+		{
+			name: "Expect error when no timer is running",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.retriever.EXPECT().ListRunningByUserID(context.Background(), user.ID).Return(domain.TimeEntry{}, domain.ErrTimeEntryNotFound)
+			},
+			wantErr: domain.ErrTimeEntryNotFound,
+		},
+		{
+			name: "Expect error when the running timer belongs to another task",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.retriever.EXPECT().ListRunningByUserID(context.Background(), user.ID).Return(domain.TimeEntry{ID: 4, TaskID: 7, UserID: 2}, nil)
+			},
+			wantErr: domain.ErrTimeEntryNotFound,
+		},
+		{
+			name: "Expect success",
+			setDependencies: func(d *timeEntryDependencies) {
+				d.retriever.EXPECT().ListRunningByUserID(context.Background(), user.ID).Return(running, nil)
+				d.updater.EXPECT().Update(context.Background(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newTimeEntryUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Stop(context.Background(), 1, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Stop() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, 90*time.Minute, got.Duration())
+		})
+	}
+}
+
+func Test_timeEntryUseCase_Add(t *testing.T) {
+	var (
+		now    = time.Date(2023, 11, 15, 18, 0, 0, 0, time.UTC)
+		user   = domain.User{ID: 2, RoleID: 2}
+		task   = domain.Task{ID: 1, UserID: 2}
+		start  = time.Date(2023, 11, 15, 9, 0, 0, 0, time.UTC)
+		end    = start.Add(2 * time.Hour)
+		future = now.Add(time.Hour)
+		entry  = domain.TimeEntry{TaskID: 1, UserID: 2, Start: start, End: &end}
+	)
+
+	tests := []struct {
+		name            string
+		entry           domain.TimeEntry
+		setDependencies func(d *timeEntryDependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect error when entry belongs to another technician",
+			entry:   domain.TimeEntry{TaskID: 1, UserID: 3, Start: start, End: &end},
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name:    "Expect error when entry ends in the future",
+			entry:   domain.TimeEntry{TaskID: 1, UserID: 2, Start: start, End: &future},
+			wantErr: domain.ErrInvalidTimeEntry,
+		},
+		{
+			name:  "Expect error when entry overlaps another one",
+			entry: entry,
+			setDependencies: func(d *timeEntryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOverlapping(context.Background(), user.ID, start, end, int64(0)).Return([]domain.TimeEntry{{ID: 4}}, nil)
+			},
+			wantErr: domain.ErrTimeEntryOverlap,
+		},
+		{
+			name:  "Expect success",
+			entry: entry,
+			setDependencies: func(d *timeEntryDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOverlapping(context.Background(), user.ID, start, end, int64(0)).Return(nil, nil)
+				d.creator.EXPECT().Add(context.Background(), entry).Return(int64(5), nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newTimeEntryUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Add(context.Background(), tt.entry, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, int64(5), got.ID)
+		})
+	}
+}
+
+func Test_timeEntryUseCase_Approve(t *testing.T) {
+	var (
+		now        = time.Date(2023, 11, 16, 9, 0, 0, 0, time.UTC)
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		end        = time.Date(2023, 11, 15, 11, 0, 0, 0, time.UTC)
+		closed     = domain.TimeEntry{ID: 4, TaskID: 1, UserID: 2, Start: end.Add(-2 * time.Hour), End: &end}
+	)
+
+	tests := []struct {
+		name            string
+		user            domain.User
+		setDependencies func(d *timeEntryDependencies)
+		wantErr         error
+	}{
+		{
+			name:    "Expect error when user is not a manager",
+			user:    technician,
+			wantErr: domain.ErrUserNotAllowed,
+		},
+		{
+			name: "Expect error when timer is still running",
+			user: manager,
+			setDependencies: func(d *timeEntryDependencies) {
+				d.retriever.EXPECT().ListByID(context.Background(), int64(4)).Return(domain.TimeEntry{ID: 4, Start: end}, nil)
+			},
+			wantErr: domain.ErrInvalidTimeEntry,
+		},
+		{
+			name: "Expect success",
+			user: manager,
+			setDependencies: func(d *timeEntryDependencies) {
+				approved := closed
+				approved.ApprovedBy = manager.ID
+				approved.ApprovedAt = &now
+
+				d.retriever.EXPECT().ListByID(context.Background(), int64(4)).Return(closed, nil)
+				d.updater.EXPECT().Update(context.Background(), approved).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newTimeEntryUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.Approve(context.Background(), 4, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Approve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, manager.ID, got.ApprovedBy)
+			assert.Equal(t, &now, got.ApprovedAt)
+		})
+	}
+}
+
+func Test_timeEntryUseCase_Summarize(t *testing.T) {
+	var (
+		manager    = domain.User{ID: 1, RoleID: 1}
+		technician = domain.User{ID: 2, RoleID: 2}
+		filter     = domain.TimeSummaryFilter{
+			From:    time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			To:      time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+			GroupBy: domain.TimeSummaryByTask,
+		}
+	)
+
+	tests := []struct {
+		name       string
+		user       domain.User
+		wantFilter domain.TimeSummaryFilter
+	}{
+		{
+			name:       "Expect managers to see every technician",
+			user:       manager,
+			wantFilter: filter,
+		},
+		{
+			name: "Expect technicians to see only their own time",
+			user: technician,
+			wantFilter: func() domain.TimeSummaryFilter {
+				f := filter
+				f.UserID = technician.ID
+				return f
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newTimeEntryUseCase(ctrl, time.Now())
+
+			want := []domain.TimeSummary{{TaskID: 1, Total: 3 * time.Hour, Approved: time.Hour}}
+			d.retriever.EXPECT().Summarize(context.Background(), tt.wantFilter).Return(want, nil)
+
+			got, err := u.Summarize(context.Background(), filter, tt.user)
+			if err != nil {
+				t.Errorf("Summarize() error = %v", err)
+				return
+			}
+
+			assert.Equal(t, want, got)
+		})
+	}
+}
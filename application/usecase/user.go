@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+)
+
+type userUseCase struct {
+	authenticator domain.Authenticator
+	retriever     domain.UserRetriever
+	updater       domain.UserTimezoneUpdater
+}
+
+func NewUser(authenticator domain.Authenticator, retriever domain.UserRetriever, updater domain.UserTimezoneUpdater) (domain.UserUsecase, error) {
+	if authenticator == nil {
+		return &userUseCase{}, errors.New("authenticator must not be nil")
+	}
+
+	if retriever == nil {
+		return &userUseCase{}, errors.New("retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &userUseCase{}, errors.New("updater must not be nil")
+	}
+
+	return &userUseCase{
+		authenticator: authenticator,
+		retriever:     retriever,
+		updater:       updater,
+	}, nil
+}
+
+func (u *userUseCase) UpdateTimezone(ctx context.Context, user domain.User, timezone string) (domain.User, string, error) {
+	if user.ID == 0 {
+		return domain.User{}, "", errors.New("user ID must not be empty")
+	}
+
+	if err := domain.ValidateTimezone(timezone); err != nil {
+		return domain.User{}, "", err
+	}
+
+	if err := u.updater.UpdateTimezone(ctx, user.ID, timezone); err != nil {
+		return domain.User{}, "", err
+	}
+
+	updated, err := u.retriever.ListByID(ctx, user.ID)
+	if err != nil {
+		return domain.User{}, "", err
+	}
+
+	token, err := u.authenticator.GenerateAccessToken(updated)
+	if err != nil {
+		return domain.User{}, "", errors.New("error while generating token")
+	}
+
+	return updated, token, nil
+}
@@ -0,0 +1,168 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	retriever := domain.NewMockUserRetriever(ctrl)
+	updater := domain.NewMockUserTimezoneUpdater(ctrl)
+
+	type args struct {
+		authenticator domain.Authenticator
+		retriever     domain.UserRetriever
+		updater       domain.UserTimezoneUpdater
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    domain.UserUsecase
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				authenticator: nil,
+				retriever:     retriever,
+				updater:       updater,
+			},
+			want:    &userUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without retriever",
+			args: args{
+				authenticator: authenticator,
+				retriever:     nil,
+				updater:       updater,
+			},
+			want:    &userUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without updater",
+			args: args{
+				authenticator: authenticator,
+				retriever:     retriever,
+				updater:       nil,
+			},
+			want:    &userUseCase{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				authenticator: authenticator,
+				retriever:     retriever,
+				updater:       updater,
+			},
+			want: &userUseCase{
+				authenticator: authenticator,
+				retriever:     retriever,
+				updater:       updater,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewUser(tt.args.authenticator, tt.args.retriever, tt.args.updater)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_userUseCase_UpdateTimezone(t *testing.T) {
+	type dependencies struct {
+		authenticator *domain.MockAuthenticator
+		retriever     *domain.MockUserRetriever
+		updater       *domain.MockUserTimezoneUpdater
+	}
+
+	var (
+		user    = domain.User{ID: 2, RoleID: 2}
+		updated = domain.User{ID: 2, RoleID: 2, Email: "joe.doe@example.com", Timezone: "America/Sao_Paulo"}
+	)
+
+	tests := []struct {
+		name            string
+		timezone        string
+		setDependencies func(d *dependencies)
+		want            domain.User
+		wantToken       string
+		wantErr         bool
+	}{
+		{
+			name:     "Expect error when timezone is invalid",
+			timezone: "Mars/Olympus",
+			want:     domain.User{},
+			wantErr:  true,
+		},
+		{
+			name:     "Expect error thrown by UpdateTimezone",
+			timezone: "America/Sao_Paulo",
+			setDependencies: func(d *dependencies) {
+				d.updater.EXPECT().UpdateTimezone(context.Background(), user.ID, "America/Sao_Paulo").Return(errors.New("err"))
+			},
+			want:    domain.User{},
+			wantErr: true,
+		},
+		{
+			name:     "Expect success with a refreshed token",
+			timezone: "America/Sao_Paulo",
+			setDependencies: func(d *dependencies) {
+				d.updater.EXPECT().UpdateTimezone(context.Background(), user.ID, "America/Sao_Paulo").Return(nil)
+				d.retriever.EXPECT().ListByID(context.Background(), user.ID).Return(updated, nil)
+				d.authenticator.EXPECT().GenerateAccessToken(updated).Return("token", nil)
+			},
+			want:      updated,
+			wantToken: "token",
+			wantErr:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := dependencies{
+				authenticator: domain.NewMockAuthenticator(ctrl),
+				retriever:     domain.NewMockUserRetriever(ctrl),
+				updater:       domain.NewMockUserTimezoneUpdater(ctrl),
+			}
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			u := &userUseCase{
+				authenticator: d.authenticator,
+				retriever:     d.retriever,
+				updater:       d.updater,
+			}
+
+			got, token, err := u.UpdateTimezone(context.Background(), user, tt.timezone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateTimezone() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantToken, token)
+		})
+	}
+}
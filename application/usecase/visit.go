@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"time"
+)
+
+type visitUseCase struct {
+	creator    domain.VisitCreator
+	retriever  domain.VisitRetriever
+	updater    domain.VisitUpdater
+	tasks      domain.TaskRetriever
+	taskSaver  domain.TaskUpdater
+	sites      domain.SiteRetriever
+	transactor domain.TaskTransactor
+	radius     float64
+	now        func() time.Time
+}
+
+func NewVisit(
+	creator domain.VisitCreator,
+	retriever domain.VisitRetriever,
+	updater domain.VisitUpdater,
+	tasks domain.TaskRetriever,
+	taskSaver domain.TaskUpdater,
+	sites domain.SiteRetriever,
+	transactor domain.TaskTransactor,
+	radius float64,
+) (domain.VisitUsecase, error) {
+	if creator == nil {
+		return &visitUseCase{}, errors.New("visit creator must not be nil")
+	}
+
+	if retriever == nil {
+		return &visitUseCase{}, errors.New("visit retriever must not be nil")
+	}
+
+	if updater == nil {
+		return &visitUseCase{}, errors.New("visit updater must not be nil")
+	}
+
+	if tasks == nil {
+		return &visitUseCase{}, errors.New("task retriever must not be nil")
+	}
+
+	if taskSaver == nil {
+		return &visitUseCase{}, errors.New("task updater must not be nil")
+	}
+
+	if sites == nil {
+		return &visitUseCase{}, errors.New("site retriever must not be nil")
+	}
+
+	if transactor == nil {
+		return &visitUseCase{}, errors.New("transactor must not be nil")
+	}
+
+	if radius <= 0 {
+		return &visitUseCase{}, errors.New("radius must be positive")
+	}
+
+	return &visitUseCase{
+		creator:    creator,
+		retriever:  retriever,
+		updater:    updater,
+		tasks:      tasks,
+		taskSaver:  taskSaver,
+		sites:      sites,
+		transactor: transactor,
+		radius:     radius,
+		now:        time.Now,
+	}, nil
+}
+
+func (u *visitUseCase) CheckIn(ctx context.Context, taskID int64, checkpoint domain.Checkpoint, user domain.User) (domain.Visit, error) {
+	if taskID == 0 {
+		return domain.Visit{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.Visit{}, err
+	}
+
+	var visit domain.Visit
+
+	// The task stays locked until the visit is saved, so concurrent check-ins
+	// of the same task wait for each other and the later one finds it open.
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := u.tasks.LockByIDAndUserID(ctx, taskID, user.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := task.TransitionTo(domain.TaskStatusInProgress); err != nil {
+			return err
+		}
+
+		_, err = u.retriever.ListOpenByTaskID(ctx, taskID)
+		if err == nil {
+			return domain.ErrAlreadyCheckedIn
+		}
+
+		if !errors.Is(err, domain.ErrVisitNotFound) {
+			return err
+		}
+
+		if err := u.locate(ctx, task, &checkpoint); err != nil {
+			return err
+		}
+
+		visit = domain.Visit{
+			TaskID:  taskID,
+			UserID:  user.ID,
+			CheckIn: checkpoint,
+		}
+
+		id, err := u.creator.Add(ctx, visit)
+		if err != nil {
+			return err
+		}
+		visit.ID = id
+
+		return u.taskSaver.Update(ctx, task)
+	})
+	if err != nil {
+		return domain.Visit{}, err
+	}
+
+	if checkpoint.OutOfRange {
+		log.Printf("task %d checked in out of range by user %d: %.0fm", taskID, user.ID, *checkpoint.Distance) // Later: send to metrics/observability
+	}
+
+	return visit, nil
+}
+
+func (u *visitUseCase) CheckOut(ctx context.Context, taskID int64, checkpoint domain.Checkpoint, user domain.User) (domain.Visit, error) {
+	if taskID == 0 {
+		return domain.Visit{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return domain.Visit{}, err
+	}
+
+	task, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID)
+	if err != nil {
+		return domain.Visit{}, err
+	}
+
+	visit, err := u.retriever.ListOpenByTaskID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVisitNotFound) {
+			return domain.Visit{}, domain.ErrNotCheckedIn
+		}
+
+		return domain.Visit{}, err
+	}
+
+	if err := u.locate(ctx, task, &checkpoint); err != nil {
+		return domain.Visit{}, err
+	}
+
+	visit.CheckOut = &checkpoint
+
+	if err := u.updater.CheckOut(ctx, visit); err != nil {
+		return domain.Visit{}, err
+	}
+
+	if checkpoint.OutOfRange {
+		log.Printf("task %d checked out out of range by user %d: %.0fm", taskID, user.ID, *checkpoint.Distance) // Later: send to metrics/observability
+	}
+
+	return visit, nil
+}
+
+func (u *visitUseCase) ListByTask(ctx context.Context, taskID int64, user domain.User) ([]domain.Visit, error) {
+	if taskID == 0 {
+		return []domain.Visit{}, errors.New("task ID must not be 0")
+	}
+
+	if err := validateUser(user); err != nil {
+		return []domain.Visit{}, err
+	}
+
+	if user.GetRole() != domain.Manager {
+		if _, err := u.tasks.ListByIDAndUserID(ctx, taskID, user.ID); err != nil {
+			return []domain.Visit{}, err
+		}
+	}
+
+	return u.retriever.ListByTaskID(ctx, taskID)
+}
+
+func (u *visitUseCase) locate(ctx context.Context, task domain.Task, checkpoint *domain.Checkpoint) error {
+	checkpoint.At = u.now().UTC()
+
+	if task.SiteID == 0 {
+		return nil
+	}
+
+	site, err := u.sites.ListByID(ctx, task.SiteID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSiteNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	checkpoint.Locate(site.Coordinates, u.radius)
+
+	return nil
+}
@@ -0,0 +1,274 @@
+//go:build unit
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewVisit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	creator := domain.NewMockVisitCreator(ctrl)
+	retriever := domain.NewMockVisitRetriever(ctrl)
+	updater := domain.NewMockVisitUpdater(ctrl)
+	tasks := domain.NewMockTaskRetriever(ctrl)
+	taskSaver := domain.NewMockTaskUpdater(ctrl)
+	sites := domain.NewMockSiteRetriever(ctrl)
+	transactor := domain.NewMockTaskTransactor(ctrl)
+
+	type args struct {
+		creator    domain.VisitCreator
+		retriever  domain.VisitRetriever
+		updater    domain.VisitUpdater
+		tasks      domain.TaskRetriever
+		taskSaver  domain.TaskUpdater
+		sites      domain.SiteRetriever
+		transactor domain.TaskTransactor
+		radius     float64
+	}
+
+	valid := args{creator, retriever, updater, tasks, taskSaver, sites, transactor, 200}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without creator", args: func() args { a := valid; a.creator = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without retriever", args: func() args { a := valid; a.retriever = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without updater", args: func() args { a := valid; a.updater = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task retriever", args: func() args { a := valid; a.tasks = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without task updater", args: func() args { a := valid; a.taskSaver = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without site retriever", args: func() args { a := valid; a.sites = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without transactor", args: func() args { a := valid; a.transactor = nil; return a }, wantErr: true},
+		{name: "Expect error when initializing without radius", args: func() args { a := valid; a.radius = 0; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewVisit(a.creator, a.retriever, a.updater, a.tasks, a.taskSaver, a.sites, a.transactor, a.radius)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVisit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type visitDependencies struct {
+	creator    *domain.MockVisitCreator
+	retriever  *domain.MockVisitRetriever
+	updater    *domain.MockVisitUpdater
+	tasks      *domain.MockTaskRetriever
+	taskSaver  *domain.MockTaskUpdater
+	sites      *domain.MockSiteRetriever
+	transactor *domain.MockTaskTransactor
+}
+
+func newVisitUseCase(ctrl *gomock.Controller, now time.Time) (*visitUseCase, visitDependencies) {
+	d := visitDependencies{
+		creator:    domain.NewMockVisitCreator(ctrl),
+		retriever:  domain.NewMockVisitRetriever(ctrl),
+		updater:    domain.NewMockVisitUpdater(ctrl),
+		tasks:      domain.NewMockTaskRetriever(ctrl),
+		taskSaver:  domain.NewMockTaskUpdater(ctrl),
+		sites:      domain.NewMockSiteRetriever(ctrl),
+		transactor: domain.NewMockTaskTransactor(ctrl),
+	}
+
+	return &visitUseCase{
+		creator:    d.creator,
+		retriever:  d.retriever,
+		updater:    d.updater,
+		tasks:      d.tasks,
+		taskSaver:  d.taskSaver,
+		sites:      d.sites,
+		transactor: d.transactor,
+		radius:     200,
+		now:        func() time.Time { return now },
+	}, d
+}
+
+func Test_visitUseCase_CheckIn(t *testing.T) {
+	var (
+		now        = time.Date(2023, 11, 15, 12, 0, 0, 0, time.UTC)
+		user       = domain.User{ID: 2, RoleID: 2}
+		site       = domain.Site{ID: 5, Coordinates: &domain.Coordinates{Latitude: -23.5613, Longitude: -46.6565}}
+		task       = domain.Task{ID: 1, UserID: 2, SiteID: 5, Status: domain.TaskStatusOpen}
+		started    = domain.Task{ID: 1, UserID: 2, SiteID: 5, Status: domain.TaskStatusInProgress}
+		completed  = domain.Task{ID: 1, UserID: 2, SiteID: 5, Status: domain.TaskStatusCompleted}
+		nearby     = domain.Coordinates{Latitude: -23.5614, Longitude: -46.6566}
+		faraway    = domain.Coordinates{Latitude: -23.5505, Longitude: -46.6333}
+		deviceTime = now.Add(-time.Minute)
+	)
+
+	tests := []struct {
+		name            string
+		coordinates     domain.Coordinates
+		setDependencies func(d *visitDependencies)
+		wantOutOfRange  bool
+		wantErr         error
+	}{
+		{
+			name:        "Expect error when task is not assigned to the technician",
+			coordinates: nearby,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantErr: domain.ErrTasksNotFound,
+		},
+		{
+			name:        "Expect error when task is completed",
+			coordinates: nearby,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(completed, nil)
+			},
+			wantErr: domain.ErrInvalidTaskTransition,
+		},
+		{
+			name:        "Expect error when already checked in",
+			coordinates: nearby,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(started, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(domain.Visit{ID: 3}, nil)
+			},
+			wantErr: domain.ErrAlreadyCheckedIn,
+		},
+		{
+			name:        "Expect task to move to in progress",
+			coordinates: nearby,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(domain.Visit{}, domain.ErrVisitNotFound)
+				d.sites.EXPECT().ListByID(context.Background(), int64(5)).Return(site, nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(3), nil)
+				d.taskSaver.EXPECT().Update(context.Background(), started).Return(nil)
+			},
+		},
+		{
+			name:        "Expect check-in far from the site to be flagged",
+			coordinates: faraway,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(domain.Visit{}, domain.ErrVisitNotFound)
+				d.sites.EXPECT().ListByID(context.Background(), int64(5)).Return(site, nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(3), nil)
+				d.taskSaver.EXPECT().Update(context.Background(), started).Return(nil)
+			},
+			wantOutOfRange: true,
+		},
+		{
+			name:        "Expect error when the task cannot be saved",
+			coordinates: nearby,
+			setDependencies: func(d *visitDependencies) {
+				d.transactor.EXPECT().WithinTransaction(context.Background(), gomock.Any()).DoAndReturn(runTransaction)
+				d.tasks.EXPECT().LockByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(domain.Visit{}, domain.ErrVisitNotFound)
+				d.sites.EXPECT().ListByID(context.Background(), int64(5)).Return(site, nil)
+				d.creator.EXPECT().Add(context.Background(), gomock.Any()).Return(int64(3), nil)
+				d.taskSaver.EXPECT().Update(context.Background(), started).Return(domain.ErrTaskVersionConflict)
+			},
+			wantErr: domain.ErrTaskVersionConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newVisitUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			checkpoint := domain.Checkpoint{Coordinates: tt.coordinates, DeviceTime: deviceTime}
+
+			got, err := u.CheckIn(context.Background(), 1, checkpoint, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckIn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, int64(3), got.ID)
+			assert.Equal(t, now, got.CheckIn.At)
+			assert.Equal(t, deviceTime, got.CheckIn.DeviceTime)
+			assert.NotNil(t, got.CheckIn.Distance)
+			assert.Equal(t, tt.wantOutOfRange, got.CheckIn.OutOfRange)
+		})
+	}
+}
+
+func Test_visitUseCase_CheckOut(t *testing.T) {
+	var (
+		now  = time.Date(2023, 11, 15, 14, 0, 0, 0, time.UTC)
+		user = domain.User{ID: 2, RoleID: 2}
+		task = domain.Task{ID: 1, UserID: 2, Status: domain.TaskStatusInProgress}
+		open = domain.Visit{ID: 3, TaskID: 1, UserID: 2}
+	)
+
+	tests := []struct {
+		name            string
+		setDependencies func(d *visitDependencies)
+		wantErr         error
+	}{
+		{
+			name: "Expect error when not checked in",
+			setDependencies: func(d *visitDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(domain.Visit{}, domain.ErrVisitNotFound)
+			},
+			wantErr: domain.ErrNotCheckedIn,
+		},
+		{
+			name: "Expect success without site",
+			setDependencies: func(d *visitDependencies) {
+				d.tasks.EXPECT().ListByIDAndUserID(context.Background(), int64(1), user.ID).Return(task, nil)
+				d.retriever.EXPECT().ListOpenByTaskID(context.Background(), int64(1)).Return(open, nil)
+				d.updater.EXPECT().CheckOut(context.Background(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u, d := newVisitUseCase(ctrl, now)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(&d)
+			}
+
+			got, err := u.CheckOut(context.Background(), 1, domain.Checkpoint{DeviceTime: now}, user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckOut() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.NotNil(t, got.CheckOut)
+			assert.Equal(t, now, got.CheckOut.At)
+			assert.Nil(t, got.CheckOut.Distance)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+)
+
+// attachmentFormOverhead leaves room in the request body for the multipart
+// boundaries and headers around the file.
+const attachmentFormOverhead = 64 << 10
+
+type attachmentResponse struct {
+	ID          int64  `json:"id"`
+	TaskID      int64  `json:"task_id"`
+	UserID      int64  `json:"user_id"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type AttachmentAPIHandler struct {
+	router            *gin.Engine
+	authenticator     domain.Authenticator
+	attachmentUsecase domain.AttachmentUsecase
+	maxSize           int64
+}
+
+func NewAttachment(r *gin.Engine, authenticator domain.Authenticator, attachmentUsecase domain.AttachmentUsecase, maxSize int64) (*AttachmentAPIHandler, error) {
+	if r == nil {
+		return &AttachmentAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &AttachmentAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if attachmentUsecase == nil {
+		return &AttachmentAPIHandler{}, errors.New("attachmentUsecase must not be nil")
+	}
+
+	if maxSize <= 0 {
+		return &AttachmentAPIHandler{}, errors.New("max size must be positive")
+	}
+
+	return &AttachmentAPIHandler{
+		router:            r,
+		authenticator:     authenticator,
+		attachmentUsecase: attachmentUsecase,
+		maxSize:           maxSize,
+	}, nil
+}
+
+func (h *AttachmentAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/attachments", h.get)
+	tasks.POST("/:id/attachments", h.post)
+	tasks.GET("/:id/attachments/:attachment_id", h.download)
+	tasks.DELETE("/:id/attachments/:attachment_id", h.remove)
+}
+
+func (h *AttachmentAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.attachmentUsecase.ListByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []attachmentResponse
+
+	dates := requestDates(c)
+
+	for _, a := range result {
+		response = append(response, formatAttachment(a, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *AttachmentAPIHandler) post(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	// Stop reading once the body cannot hold an attachment within the limit,
+	// so an oversized upload is neither buffered nor spooled to disk.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxSize+attachmentFormOverhead)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, h.maxSize))
+			return
+		}
+
+		respondInvalidField(c, "file", "is required")
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, h.maxSize+1))
+	if err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	if int64(len(content)) > h.maxSize {
+		respondError(c, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, h.maxSize))
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	attachment, err := domain.NewAttachment(taskID, user.ID, filepath.Base(header.Filename), sniffContentType(content), int64(len(content)))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.attachmentUsecase.Upload(c.Request.Context(), attachment, content, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatAttachment(result, requestDates(c))))
+}
+
+func (h *AttachmentAPIHandler) download(c *gin.Context) {
+	taskID, id, ok := attachmentParams(c)
+	if !ok {
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	attachment, content, err := h.attachmentUsecase.Download(c.Request.Context(), taskID, id, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	c.Data(http.StatusOK, attachment.ContentType, content)
+}
+
+func (h *AttachmentAPIHandler) remove(c *gin.Context) {
+	taskID, id, ok := attachmentParams(c)
+	if !ok {
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.attachmentUsecase.Remove(c.Request.Context(), taskID, id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func attachmentParams(c *gin.Context) (int64, int64, bool) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return 0, 0, false
+	}
+
+	id, err := strconv.ParseInt(c.Param("attachment_id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "attachment_id", invalidIntegerMessage)
+		return 0, 0, false
+	}
+
+	return taskID, id, true
+}
+
+func sniffContentType(content []byte) string {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil {
+		return ""
+	}
+
+	return contentType
+}
+
+func formatAttachment(attachment domain.Attachment, dates dateCodec) attachmentResponse {
+	return attachmentResponse{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		UserID:      attachment.UserID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   dates.format(&attachment.CreatedAt),
+	}
+}
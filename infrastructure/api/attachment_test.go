@@ -0,0 +1,207 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	attachmentUsecase := domain.NewMockAttachmentUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                 *gin.Engine
+		authenticator     domain.Authenticator
+		attachmentUsecase domain.AttachmentUsecase
+		maxSize           int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *AttachmentAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                 nil,
+				authenticator:     authenticator,
+				attachmentUsecase: attachmentUsecase,
+				maxSize:           1024,
+			},
+			want:    &AttachmentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                 r,
+				authenticator:     nil,
+				attachmentUsecase: attachmentUsecase,
+				maxSize:           1024,
+			},
+			want:    &AttachmentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without attachmentUsecase",
+			args: args{
+				r:                 r,
+				authenticator:     authenticator,
+				attachmentUsecase: nil,
+				maxSize:           1024,
+			},
+			want:    &AttachmentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without max size",
+			args: args{
+				r:                 r,
+				authenticator:     authenticator,
+				attachmentUsecase: attachmentUsecase,
+			},
+			want:    &AttachmentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                 r,
+				authenticator:     authenticator,
+				attachmentUsecase: attachmentUsecase,
+				maxSize:           1024,
+			},
+			want: &AttachmentAPIHandler{
+				router:            r,
+				authenticator:     authenticator,
+				attachmentUsecase: attachmentUsecase,
+				maxSize:           1024,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAttachment(tt.args.r, tt.args.authenticator, tt.args.attachmentUsecase, tt.args.maxSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachmentAPIHandler_post(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const maxSize = 1024
+
+	png := func(size int) []byte {
+		header := []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+		return append(header, bytes.Repeat([]byte{0}, size-len(header))...)
+	}
+
+	claims := map[string]interface{}{"user_id": float64(2), "role_id": float64(2)}
+
+	tests := []struct {
+		name            string
+		content         []byte
+		setDependencies func(attachmentUsecase *domain.MockAttachmentUsecase)
+		wantStatus      int
+		wantCode        string
+	}{
+		{
+			name:    "Expect attachment within the limit to be uploaded",
+			content: png(maxSize),
+			setDependencies: func(attachmentUsecase *domain.MockAttachmentUsecase) {
+				attachmentUsecase.EXPECT().Upload(gomock.Any(), gomock.Any(), png(maxSize), gomock.Any()).
+					Return(domain.Attachment{ID: 1, TaskID: 1, Size: maxSize}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:            "Expect attachment just over the limit to be too large",
+			content:         png(maxSize + 1),
+			setDependencies: func(attachmentUsecase *domain.MockAttachmentUsecase) {},
+			wantStatus:      http.StatusRequestEntityTooLarge,
+			wantCode:        "attachment_too_large",
+		},
+		{
+			name:            "Expect body beyond the limit to be cut off",
+			content:         png(maxSize + attachmentFormOverhead),
+			setDependencies: func(attachmentUsecase *domain.MockAttachmentUsecase) {},
+			wantStatus:      http.StatusRequestEntityTooLarge,
+			wantCode:        "attachment_too_large",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			attachmentUsecase := domain.NewMockAttachmentUsecase(ctrl)
+			tt.setDependencies(attachmentUsecase)
+
+			r := gin.New()
+
+			h, err := NewAttachment(r, authenticator, attachmentUsecase, maxSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("file", "notes.txt")
+			part.Write(tt.content)
+			writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/1/attachments", body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var response taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantCode, response.Code)
+		})
+	}
+}
+
+func Test_sniffContentType(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+		want    string
+	}{
+		{name: "Expect png", content: []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR"), want: "image/png"},
+		{name: "Expect jpeg", content: []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF"), want: "image/jpeg"},
+		{name: "Expect pdf", content: []byte("%PDF-1.7\n"), want: "application/pdf"},
+		{name: "Expect text without charset", content: []byte("isolate power"), want: "text/plain"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sniffContentType(tt.content))
+		})
+	}
+}
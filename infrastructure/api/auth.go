@@ -1,7 +1,6 @@
 package api
 
 import (
-	"context"
 	"errors"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/gin-gonic/gin"
@@ -47,18 +46,17 @@ func (h *AuthAPIHandler) post(c *gin.Context) {
 	var request authRequest
 
 	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondBadRequest(c, err)
 		return
 	}
 
-	result, err := h.authUsecase.Authenticate(context.Background(), request.Email, request.Password)
+	result, err := h.authUsecase.Authenticate(c.Request.Context(), request.Email, request.Password)
 	if err != nil {
 		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrUserInvalidPass) {
-			c.JSON(http.StatusUnauthorized, toResponse(false, unauthorizedMessage))
-			return
+			err = errUnauthorized
 		}
 
-		c.JSON(http.StatusInternalServerError, toResponse(false, internalServerMessage))
+		respondError(c, err)
 		return
 	}
 
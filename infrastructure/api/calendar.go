@@ -0,0 +1,211 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	calendarFeedExtension = ".ics"
+	calendarProductID     = "-//field-team-management//tasks//EN"
+	calendarUIDDomain     = "field-team-management"
+	calendarTimeLayout    = "20060102T150405Z"
+	calendarRefresh       = "PT15M"
+	calendarLineOctets    = 75
+)
+
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "")
+
+type calendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type CalendarAPIHandler struct {
+	router          *gin.Engine
+	authenticator   domain.Authenticator
+	calendarUsecase domain.CalendarUsecase
+
+	// baseURL is the public address of the API that feed URLs are built from,
+	// as the Host and X-Forwarded-Proto headers of a request cannot be trusted.
+	baseURL string
+}
+
+func NewCalendar(r *gin.Engine, authenticator domain.Authenticator, calendarUsecase domain.CalendarUsecase, baseURL string) (*CalendarAPIHandler, error) {
+	if r == nil {
+		return &CalendarAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &CalendarAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if calendarUsecase == nil {
+		return &CalendarAPIHandler{}, errors.New("calendarUsecase must not be nil")
+	}
+
+	parsed, err := url.Parse(baseURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return &CalendarAPIHandler{}, errors.New("baseURL must be an absolute http or https URL")
+	}
+
+	return &CalendarAPIHandler{
+		router:          r,
+		authenticator:   authenticator,
+		calendarUsecase: calendarUsecase,
+		baseURL:         strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+func (h *CalendarAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+
+	// Calendar apps cannot send a bearer token, so the feed is authorized by
+	// the secret token in its path.
+	v1.GET("/calendar/:feed", h.feed)
+
+	users := v1.Group("users")
+	users.Use(Authenticator(h.authenticator))
+
+	users.POST("/me/calendar-token", h.issueToken)
+	users.DELETE("/me/calendar-token", h.revokeToken)
+}
+
+func (h *CalendarAPIHandler) feed(c *gin.Context) {
+	feed := c.Param("feed")
+	if !strings.HasSuffix(feed, calendarFeedExtension) {
+		respondError(c, domain.ErrCalendarFeedNotFound)
+		return
+	}
+
+	result, err := h.calendarUsecase.Feed(c.Request.Context(), strings.TrimSuffix(feed, calendarFeedExtension))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-cache")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", renderCalendar(result))
+}
+
+func (h *CalendarAPIHandler) issueToken(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	token, err := h.calendarUsecase.IssueToken(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, calendarTokenResponse{
+		Token: token,
+		URL:   fmt.Sprintf("%s/v1/calendar/%s%s", h.baseURL, token, calendarFeedExtension),
+	}))
+}
+
+func (h *CalendarAPIHandler) revokeToken(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	if err := h.calendarUsecase.RevokeToken(c.Request.Context(), user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// renderCalendar writes the feed as an RFC 5545 calendar. Events keep their UID
+// across changes and carry the task version as SEQUENCE, so calendars update
+// their copy instead of adding one, and removed tasks are sent as cancelled.
+func renderCalendar(feed domain.CalendarFeed) []byte {
+	var w calendarWriter
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", calendarProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("X-WR-CALNAME", "Field tasks")
+	if feed.User.Timezone != "" {
+		w.line("X-WR-TIMEZONE", feed.User.Timezone)
+	}
+	w.line("REFRESH-INTERVAL;VALUE=DURATION", calendarRefresh)
+	w.line("X-PUBLISHED-TTL", calendarRefresh)
+
+	for _, event := range feed.Events {
+		task := event.Task
+		if task.Date == nil {
+			continue
+		}
+
+		modified := event.UpdatedAt
+		if modified.IsZero() {
+			modified = task.CreatedAt
+		}
+
+		status := "CONFIRMED"
+		if event.Cancelled {
+			status = "CANCELLED"
+		}
+
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", fmt.Sprintf("task-%d@%s", task.ID, calendarUIDDomain))
+		w.line("DTSTAMP", calendarTime(modified))
+		if !task.CreatedAt.IsZero() {
+			w.line("CREATED", calendarTime(task.CreatedAt))
+		}
+		w.line("LAST-MODIFIED", calendarTime(modified))
+		w.line("SEQUENCE", fmt.Sprint(event.Sequence()))
+		w.line("STATUS", status)
+		w.line("DTSTART", calendarTime(*task.Date))
+		if task.Duration > 0 {
+			w.line("DTEND", calendarTime(*task.End()))
+		}
+		w.line("SUMMARY", calendarTextEscaper.Replace(task.Summary))
+		w.line("DESCRIPTION", calendarTextEscaper.Replace(fmt.Sprintf("Priority: %s\nStatus: %s", task.Priority, task.Status)))
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.buf.Bytes()
+}
+
+func calendarTime(t time.Time) string {
+	return t.UTC().Format(calendarTimeLayout)
+}
+
+type calendarWriter struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line, folding it so no line is longer than 75 octets
+// without splitting a UTF-8 sequence.
+func (w *calendarWriter) line(name, value string) {
+	content := name + ":" + value
+	limit := calendarLineOctets
+
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+
+		// The space opening a continuation line counts towards its length.
+		limit = calendarLineOctets - 1
+	}
+
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
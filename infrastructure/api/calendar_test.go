@@ -0,0 +1,305 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	calendarUsecase := domain.NewMockCalendarUsecase(ctrl)
+	r := gin.Default()
+	baseURL := "https://api.example.com"
+
+	type args struct {
+		r               *gin.Engine
+		authenticator   domain.Authenticator
+		calendarUsecase domain.CalendarUsecase
+		baseURL         string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *CalendarAPIHandler
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when initializing without router",
+			args:    args{authenticator: authenticator, calendarUsecase: calendarUsecase, baseURL: baseURL},
+			want:    &CalendarAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when initializing without authenticator",
+			args:    args{r: r, calendarUsecase: calendarUsecase, baseURL: baseURL},
+			want:    &CalendarAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when initializing without calendarUsecase",
+			args:    args{r: r, authenticator: authenticator, baseURL: baseURL},
+			want:    &CalendarAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when baseURL is not absolute",
+			args:    args{r: r, authenticator: authenticator, calendarUsecase: calendarUsecase, baseURL: "api.example.com"},
+			want:    &CalendarAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{r: r, authenticator: authenticator, calendarUsecase: calendarUsecase, baseURL: baseURL + "/"},
+			want: &CalendarAPIHandler{
+				router:          r,
+				authenticator:   authenticator,
+				calendarUsecase: calendarUsecase,
+				baseURL:         baseURL,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCalendar(tt.args.r, tt.args.authenticator, tt.args.calendarUsecase, tt.args.baseURL)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCalendar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalendarAPIHandler_feed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		date      = time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC)
+		createdAt = time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+		updatedAt = time.Date(2023, 12, 2, 10, 30, 0, 0, time.UTC)
+		feed      = domain.CalendarFeed{
+			User: domain.User{ID: 2, RoleID: 2, Timezone: "America/Sao_Paulo"},
+			Events: []domain.CalendarEvent{
+				{
+					Task:      domain.Task{ID: 1, Summary: "Fix the pump, then test; twice", Date: &date, Duration: 90 * time.Minute, Priority: "P1", Status: "open", CreatedAt: createdAt, Version: 3},
+					UpdatedAt: updatedAt,
+				},
+				{
+					Task:      domain.Task{ID: 2, Summary: "Replace the meter", Date: &date, Priority: "P3", Status: "open", CreatedAt: createdAt, Version: 2},
+					Cancelled: true,
+					UpdatedAt: updatedAt,
+				},
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		target          string
+		setDependencies func(calendarUsecase *domain.MockCalendarUsecase)
+		wantStatus      int
+		wantBody        string
+		wantCode        string
+	}{
+		{
+			name:   "Expect feed to be rendered as iCalendar",
+			target: "/v1/calendar/secret.ics",
+			setDependencies: func(calendarUsecase *domain.MockCalendarUsecase) {
+				calendarUsecase.EXPECT().Feed(gomock.Any(), "secret").Return(feed, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:-//field-team-management//tasks//EN",
+				"CALSCALE:GREGORIAN",
+				"X-WR-CALNAME:Field tasks",
+				"X-WR-TIMEZONE:America/Sao_Paulo",
+				"REFRESH-INTERVAL;VALUE=DURATION:PT15M",
+				"X-PUBLISHED-TTL:PT15M",
+				"BEGIN:VEVENT",
+				"UID:task-1@field-team-management",
+				"DTSTAMP:20231202T103000Z",
+				"CREATED:20231201T100000Z",
+				"LAST-MODIFIED:20231202T103000Z",
+				"SEQUENCE:2",
+				"STATUS:CONFIRMED",
+				"DTSTART:20231208T130000Z",
+				"DTEND:20231208T143000Z",
+				`SUMMARY:Fix the pump\, then test\; twice`,
+				`DESCRIPTION:Priority: P1\nStatus: open`,
+				"END:VEVENT",
+				"BEGIN:VEVENT",
+				"UID:task-2@field-team-management",
+				"DTSTAMP:20231202T103000Z",
+				"CREATED:20231201T100000Z",
+				"LAST-MODIFIED:20231202T103000Z",
+				"SEQUENCE:1",
+				"STATUS:CANCELLED",
+				"DTSTART:20231208T130000Z",
+				"SUMMARY:Replace the meter",
+				`DESCRIPTION:Priority: P3\nStatus: open`,
+				"END:VEVENT",
+				"END:VCALENDAR",
+				"",
+			}, "\r\n"),
+		},
+		{
+			name:       "Expect path without extension to be not found",
+			target:     "/v1/calendar/secret",
+			wantStatus: http.StatusNotFound,
+			wantCode:   "calendar_feed_not_found",
+		},
+		{
+			name:   "Expect revoked token to be not found",
+			target: "/v1/calendar/revoked.ics",
+			setDependencies: func(calendarUsecase *domain.MockCalendarUsecase) {
+				calendarUsecase.EXPECT().Feed(gomock.Any(), "revoked").Return(domain.CalendarFeed{}, domain.ErrCalendarFeedNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+			wantCode:   "calendar_feed_not_found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			calendarUsecase := domain.NewMockCalendarUsecase(ctrl)
+			if tt.setDependencies != nil {
+				tt.setDependencies(calendarUsecase)
+			}
+
+			r := gin.New()
+
+			h, err := NewCalendar(r, domain.NewMockAuthenticator(ctrl), calendarUsecase, "https://api.example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			if tt.wantCode != "" {
+				var response taskHandlerResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(t, tt.wantCode, response.Code)
+				return
+			}
+
+			assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestCalendarAPIHandler_token(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		claims = map[string]any{"user_id": float64(2), "role_id": float64(2)}
+		user   = domain.User{ID: 2, RoleID: 2}
+	)
+
+	tests := []struct {
+		name            string
+		method          string
+		setDependencies func(calendarUsecase *domain.MockCalendarUsecase)
+		wantStatus      int
+		wantResponse    calendarTokenResponse
+	}{
+		{
+			name:   "Expect feed URL to be built from the base URL, not the request headers",
+			method: http.MethodPost,
+			setDependencies: func(calendarUsecase *domain.MockCalendarUsecase) {
+				calendarUsecase.EXPECT().IssueToken(gomock.Any(), user).Return("secret", nil)
+			},
+			wantStatus:   http.StatusCreated,
+			wantResponse: calendarTokenResponse{Token: "secret", URL: "https://api.example.com/v1/calendar/secret.ics"},
+		},
+		{
+			name:   "Expect token to be revoked",
+			method: http.MethodDelete,
+			setDependencies: func(calendarUsecase *domain.MockCalendarUsecase) {
+				calendarUsecase.EXPECT().RevokeToken(gomock.Any(), user).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			calendarUsecase := domain.NewMockCalendarUsecase(ctrl)
+			tt.setDependencies(calendarUsecase)
+
+			r := gin.New()
+
+			h, err := NewCalendar(r, authenticator, calendarUsecase, "https://api.example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(tt.method, "http://attacker.example.com/v1/users/me/calendar-token", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("X-Forwarded-Proto", "http")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var response struct {
+				Result calendarTokenResponse `json:"result"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantResponse, response.Result)
+		})
+	}
+}
+
+func Test_calendarWriter_line(t *testing.T) {
+	var w calendarWriter
+
+	w.line("SUMMARY", strings.Repeat("a", 70)+"ção"+strings.Repeat("b", 80))
+
+	lines := strings.Split(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n")
+
+	assert.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("a", 67), lines[0])
+	assert.Equal(t, " "+strings.Repeat("a", 3)+"ção"+strings.Repeat("b", 66), lines[1])
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("a", 70)+"ção"+strings.Repeat("b", 80), strings.ReplaceAll(strings.TrimSuffix(w.buf.String(), "\r\n"), "\r\n ", ""))
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type checklistAttachRequest struct {
+	TemplateID int64 `json:"template_id" binding:"required"`
+}
+
+type checklistCheckRequest struct {
+	Checked *bool `json:"checked" binding:"required"`
+}
+
+type checklistItemResponse struct {
+	ID         int64  `json:"id"`
+	TaskID     int64  `json:"task_id"`
+	TemplateID int64  `json:"template_id"`
+	Label      string `json:"label"`
+	Required   bool   `json:"required"`
+	Checked    bool   `json:"checked"`
+	CheckedBy  int64  `json:"checked_by,omitempty"`
+	CheckedAt  string `json:"checked_at,omitempty"`
+}
+
+type ChecklistAPIHandler struct {
+	router           *gin.Engine
+	authenticator    domain.Authenticator
+	checklistUsecase domain.ChecklistUsecase
+}
+
+func NewChecklist(r *gin.Engine, authenticator domain.Authenticator, checklistUsecase domain.ChecklistUsecase) (*ChecklistAPIHandler, error) {
+	if r == nil {
+		return &ChecklistAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &ChecklistAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if checklistUsecase == nil {
+		return &ChecklistAPIHandler{}, errors.New("checklistUsecase must not be nil")
+	}
+
+	return &ChecklistAPIHandler{
+		router:           r,
+		authenticator:    authenticator,
+		checklistUsecase: checklistUsecase,
+	}, nil
+}
+
+func (h *ChecklistAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/checklist", h.get)
+	tasks.POST("/:id/checklist", h.attach)
+	tasks.PATCH("/:id/checklist/:item_id", h.check)
+}
+
+func (h *ChecklistAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistUsecase.ListByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatChecklist(result, requestDates(c))))
+}
+
+func (h *ChecklistAPIHandler) attach(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request checklistAttachRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistUsecase.Attach(c.Request.Context(), taskID, request.TemplateID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatChecklist(result, requestDates(c))))
+}
+
+func (h *ChecklistAPIHandler) check(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	itemID, err := strconv.ParseInt(c.Param("item_id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "item_id", invalidIntegerMessage)
+		return
+	}
+
+	var request checklistCheckRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistUsecase.Check(c.Request.Context(), taskID, itemID, *request.Checked, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatChecklistItem(result, requestDates(c))))
+}
+
+func formatChecklist(items []domain.ChecklistItem, dates dateCodec) []checklistItemResponse {
+	var response []checklistItemResponse
+
+	for _, item := range items {
+		response = append(response, formatChecklistItem(item, dates))
+	}
+
+	return response
+}
+
+func formatChecklistItem(item domain.ChecklistItem, dates dateCodec) checklistItemResponse {
+	return checklistItemResponse{
+		ID:         item.ID,
+		TaskID:     item.TaskID,
+		TemplateID: item.TemplateID,
+		Label:      item.Label,
+		Required:   item.Required,
+		Checked:    item.IsChecked(),
+		CheckedBy:  item.CheckedBy,
+		CheckedAt:  dates.format(item.CheckedAt),
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewChecklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	checklistUsecase := domain.NewMockChecklistUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                *gin.Engine
+		authenticator    domain.Authenticator
+		checklistUsecase domain.ChecklistUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ChecklistAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                nil,
+				authenticator:    authenticator,
+				checklistUsecase: checklistUsecase,
+			},
+			want:    &ChecklistAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                r,
+				authenticator:    nil,
+				checklistUsecase: checklistUsecase,
+			},
+			want:    &ChecklistAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without checklistUsecase",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				checklistUsecase: nil,
+			},
+			want:    &ChecklistAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				checklistUsecase: checklistUsecase,
+			},
+			want: &ChecklistAPIHandler{
+				router:           r,
+				authenticator:    authenticator,
+				checklistUsecase: checklistUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChecklist(tt.args.r, tt.args.authenticator, tt.args.checklistUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklist() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
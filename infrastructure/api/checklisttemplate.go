@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type checklistTemplateItemRequest struct {
+	Label    string `json:"label" binding:"required,max=255"`
+	Required bool   `json:"required"`
+}
+
+type checklistTemplateCreateRequest struct {
+	Name  string                         `json:"name" binding:"required,max=255"`
+	Items []checklistTemplateItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+type checklistTemplateUpdateRequest struct {
+	Name  string                         `json:"name" binding:"max=255"`
+	Items []checklistTemplateItemRequest `json:"items" binding:"dive"`
+}
+
+type checklistTemplateItemResponse struct {
+	Label    string `json:"label"`
+	Required bool   `json:"required"`
+}
+
+type checklistTemplateResponse struct {
+	ID    int64                           `json:"id"`
+	Name  string                          `json:"name"`
+	Items []checklistTemplateItemResponse `json:"items"`
+}
+
+type ChecklistTemplateAPIHandler struct {
+	router                   *gin.Engine
+	authenticator            domain.Authenticator
+	checklistTemplateUsecase domain.ChecklistTemplateUsecase
+}
+
+func NewChecklistTemplate(r *gin.Engine, authenticator domain.Authenticator, checklistTemplateUsecase domain.ChecklistTemplateUsecase) (*ChecklistTemplateAPIHandler, error) {
+	if r == nil {
+		return &ChecklistTemplateAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &ChecklistTemplateAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if checklistTemplateUsecase == nil {
+		return &ChecklistTemplateAPIHandler{}, errors.New("checklistTemplateUsecase must not be nil")
+	}
+
+	return &ChecklistTemplateAPIHandler{
+		router:                   r,
+		authenticator:            authenticator,
+		checklistTemplateUsecase: checklistTemplateUsecase,
+	}, nil
+}
+
+func (h *ChecklistTemplateAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	templates := v1.Group("checklist-templates")
+	templates.Use(Authenticator(h.authenticator))
+
+	templates.GET("", h.get)
+	templates.POST("", h.post)
+	templates.PATCH("/:id", h.patch)
+	templates.DELETE("/:id", h.remove)
+}
+
+func (h *ChecklistTemplateAPIHandler) get(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistTemplateUsecase.List(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []checklistTemplateResponse
+
+	for _, template := range result {
+		response = append(response, formatChecklistTemplate(template))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *ChecklistTemplateAPIHandler) post(c *gin.Context) {
+	var request checklistTemplateCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	template, err := domain.NewChecklistTemplate(request.Name, toChecklistTemplateItems(request.Items))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistTemplateUsecase.Add(c.Request.Context(), template, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatChecklistTemplate(result)))
+}
+
+func (h *ChecklistTemplateAPIHandler) patch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request checklistTemplateUpdateRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	template := domain.ChecklistTemplate{
+		ID:    id,
+		Name:  request.Name,
+		Items: toChecklistTemplateItems(request.Items),
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.checklistTemplateUsecase.Update(c.Request.Context(), template, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatChecklistTemplate(result)))
+}
+
+func (h *ChecklistTemplateAPIHandler) remove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.checklistTemplateUsecase.Remove(c.Request.Context(), id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func toChecklistTemplateItems(request []checklistTemplateItemRequest) []domain.ChecklistTemplateItem {
+	var items []domain.ChecklistTemplateItem
+
+	for _, item := range request {
+		items = append(items, domain.ChecklistTemplateItem{Label: item.Label, Required: item.Required})
+	}
+
+	return items
+}
+
+func formatChecklistTemplate(template domain.ChecklistTemplate) checklistTemplateResponse {
+	response := checklistTemplateResponse{
+		ID:   template.ID,
+		Name: template.Name,
+	}
+
+	for _, item := range template.Items {
+		response.Items = append(response.Items, checklistTemplateItemResponse{Label: item.Label, Required: item.Required})
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewChecklistTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	checklistTemplateUsecase := domain.NewMockChecklistTemplateUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                        *gin.Engine
+		authenticator            domain.Authenticator
+		checklistTemplateUsecase domain.ChecklistTemplateUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ChecklistTemplateAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                        nil,
+				authenticator:            authenticator,
+				checklistTemplateUsecase: checklistTemplateUsecase,
+			},
+			want:    &ChecklistTemplateAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                        r,
+				authenticator:            nil,
+				checklistTemplateUsecase: checklistTemplateUsecase,
+			},
+			want:    &ChecklistTemplateAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without checklistTemplateUsecase",
+			args: args{
+				r:                        r,
+				authenticator:            authenticator,
+				checklistTemplateUsecase: nil,
+			},
+			want:    &ChecklistTemplateAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                        r,
+				authenticator:            authenticator,
+				checklistTemplateUsecase: checklistTemplateUsecase,
+			},
+			want: &ChecklistTemplateAPIHandler{
+				router:                   r,
+				authenticator:            authenticator,
+				checklistTemplateUsecase: checklistTemplateUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChecklistTemplate(tt.args.r, tt.args.authenticator, tt.args.checklistTemplateUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklistTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
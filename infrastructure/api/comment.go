@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type commentRequest struct {
+	Body     string `json:"body"`
+	Internal bool   `json:"internal"`
+}
+
+type commentResponse struct {
+	ID        int64  `json:"id"`
+	TaskID    int64  `json:"task_id"`
+	UserID    int64  `json:"user_id"`
+	Body      string `json:"body"`
+	Internal  bool   `json:"internal"`
+	CreatedAt string `json:"created_at"`
+	EditedAt  string `json:"edited_at,omitempty"`
+}
+
+type CommentAPIHandler struct {
+	router         *gin.Engine
+	authenticator  domain.Authenticator
+	commentUsecase domain.CommentUsecase
+}
+
+func NewComment(r *gin.Engine, authenticator domain.Authenticator, commentUsecase domain.CommentUsecase) (*CommentAPIHandler, error) {
+	if r == nil {
+		return &CommentAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &CommentAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if commentUsecase == nil {
+		return &CommentAPIHandler{}, errors.New("commentUsecase must not be nil")
+	}
+
+	return &CommentAPIHandler{
+		router:         r,
+		authenticator:  authenticator,
+		commentUsecase: commentUsecase,
+	}, nil
+}
+
+func (h *CommentAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/comments", h.get)
+	tasks.POST("/:id/comments", h.post)
+	tasks.PATCH("/:id/comments/:comment_id", h.patch)
+	tasks.DELETE("/:id/comments/:comment_id", h.remove)
+}
+
+func (h *CommentAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.commentUsecase.ListByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []commentResponse
+
+	dates := requestDates(c)
+
+	for _, comment := range result {
+		response = append(response, formatComment(comment, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *CommentAPIHandler) post(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request commentRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	comment, err := domain.NewComment(taskID, user.ID, request.Body, request.Internal)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.commentUsecase.Add(c.Request.Context(), comment, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatComment(result, requestDates(c))))
+}
+
+func (h *CommentAPIHandler) patch(c *gin.Context) {
+	taskID, id, ok := commentParams(c)
+	if !ok {
+		return
+	}
+
+	var request commentRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.commentUsecase.Update(c.Request.Context(), domain.Comment{ID: id, TaskID: taskID, Body: request.Body}, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatComment(result, requestDates(c))))
+}
+
+func (h *CommentAPIHandler) remove(c *gin.Context) {
+	taskID, id, ok := commentParams(c)
+	if !ok {
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.commentUsecase.Remove(c.Request.Context(), taskID, id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func commentParams(c *gin.Context) (int64, int64, bool) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return 0, 0, false
+	}
+
+	id, err := strconv.ParseInt(c.Param("comment_id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "comment_id", invalidIntegerMessage)
+		return 0, 0, false
+	}
+
+	return taskID, id, true
+}
+
+func formatComment(comment domain.Comment, dates dateCodec) commentResponse {
+	return commentResponse{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		UserID:    comment.UserID,
+		Body:      comment.Body,
+		Internal:  comment.Internal,
+		CreatedAt: dates.format(&comment.CreatedAt),
+		EditedAt:  dates.format(comment.EditedAt),
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	commentUsecase := domain.NewMockCommentUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r              *gin.Engine
+		authenticator  domain.Authenticator
+		commentUsecase domain.CommentUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *CommentAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:              nil,
+				authenticator:  authenticator,
+				commentUsecase: commentUsecase,
+			},
+			want:    &CommentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:              r,
+				authenticator:  nil,
+				commentUsecase: commentUsecase,
+			},
+			want:    &CommentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without commentUsecase",
+			args: args{
+				r:              r,
+				authenticator:  authenticator,
+				commentUsecase: nil,
+			},
+			want:    &CommentAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:              r,
+				authenticator:  authenticator,
+				commentUsecase: commentUsecase,
+			},
+			want: &CommentAPIHandler{
+				router:         r,
+				authenticator:  authenticator,
+				commentUsecase: commentUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewComment(tt.args.r, tt.args.authenticator, tt.args.commentUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type customerCreateRequest struct {
+	Name  string `json:"name" binding:"required,max=255"`
+	Email string `json:"email" binding:"omitempty,email,max=255"`
+	Phone string `json:"phone" binding:"max=32"`
+}
+
+type customerUpdateRequest struct {
+	Name  string `json:"name" binding:"max=255"`
+	Email string `json:"email" binding:"omitempty,email,max=255"`
+	Phone string `json:"phone" binding:"max=32"`
+}
+
+type customerResponse struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type CustomerAPIHandler struct {
+	router          *gin.Engine
+	authenticator   domain.Authenticator
+	customerUsecase domain.CustomerUsecase
+}
+
+func NewCustomer(r *gin.Engine, authenticator domain.Authenticator, customerUsecase domain.CustomerUsecase) (*CustomerAPIHandler, error) {
+	if r == nil {
+		return &CustomerAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &CustomerAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if customerUsecase == nil {
+		return &CustomerAPIHandler{}, errors.New("customerUsecase must not be nil")
+	}
+
+	return &CustomerAPIHandler{
+		router:          r,
+		authenticator:   authenticator,
+		customerUsecase: customerUsecase,
+	}, nil
+}
+
+func (h *CustomerAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	customers := v1.Group("customers")
+	customers.Use(Authenticator(h.authenticator))
+
+	customers.GET("", h.get)
+	customers.POST("", h.post)
+	customers.PATCH("/:id", h.patch)
+	customers.DELETE("/:id", h.remove)
+}
+
+func (h *CustomerAPIHandler) get(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	result, err := h.customerUsecase.List(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []customerResponse
+
+	for _, customer := range result {
+		response = append(response, formatCustomer(customer))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *CustomerAPIHandler) post(c *gin.Context) {
+	var request customerCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	customer, err := domain.NewCustomer(request.Name, request.Email, request.Phone)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.customerUsecase.Add(c.Request.Context(), customer, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatCustomer(result)))
+}
+
+func (h *CustomerAPIHandler) patch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request customerUpdateRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	customer := domain.Customer{
+		ID:    id,
+		Name:  request.Name,
+		Email: request.Email,
+		Phone: request.Phone,
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.customerUsecase.Update(c.Request.Context(), customer, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatCustomer(result)))
+}
+
+func (h *CustomerAPIHandler) remove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.customerUsecase.Remove(c.Request.Context(), id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func formatCustomer(customer domain.Customer) customerResponse {
+	return customerResponse{
+		ID:    customer.ID,
+		Name:  customer.Name,
+		Email: customer.Email,
+		Phone: customer.Phone,
+	}
+}
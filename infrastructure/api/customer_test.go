@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	customerUsecase := domain.NewMockCustomerUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r               *gin.Engine
+		authenticator   domain.Authenticator
+		customerUsecase domain.CustomerUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *CustomerAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:               nil,
+				authenticator:   authenticator,
+				customerUsecase: customerUsecase,
+			},
+			want:    &CustomerAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:               r,
+				authenticator:   nil,
+				customerUsecase: customerUsecase,
+			},
+			want:    &CustomerAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without customerUsecase",
+			args: args{
+				r:               r,
+				authenticator:   authenticator,
+				customerUsecase: nil,
+			},
+			want:    &CustomerAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:               r,
+				authenticator:   authenticator,
+				customerUsecase: customerUsecase,
+			},
+			want: &CustomerAPIHandler{
+				router:          r,
+				authenticator:   authenticator,
+				customerUsecase: customerUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCustomer(tt.args.r, tt.args.authenticator, tt.args.customerUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCustomer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_dateCodec_parse(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	want := time.Date(2023, 11, 15, 18, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		codec   dateCodec
+		date    string
+		want    *time.Time
+		wantErr bool
+	}{
+		{
+			name:  "Expect empty date to be nil",
+			codec: dateCodec{location: time.UTC},
+			date:  "",
+			want:  nil,
+		},
+		{
+			name:  "Expect RFC 3339 offset to be honoured",
+			codec: dateCodec{location: time.UTC},
+			date:  "2023-11-15T15:04:00-03:00",
+			want:  &want,
+		},
+		{
+			name:    "Expect legacy layout to be rejected without the flag",
+			codec:   dateCodec{location: saoPaulo},
+			date:    "11/15/2023 15:04",
+			wantErr: true,
+		},
+		{
+			name:  "Expect legacy layout to be read in the user timezone",
+			codec: dateCodec{location: saoPaulo, legacy: true},
+			date:  "11/15/2023 15:04",
+			want:  &want,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.codec.parse(tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dateCodec_format(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	date := time.Date(2023, 11, 15, 18, 4, 0, 0, time.UTC)
+
+	assert.Equal(t, "", dateCodec{location: saoPaulo}.format(nil))
+	assert.Equal(t, "2023-11-15T15:04:00-03:00", dateCodec{location: saoPaulo}.format(&date))
+	assert.Equal(t, "11/15/2023 15:04", dateCodec{location: saoPaulo, legacy: true}.format(&date))
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const (
+	codeMalformedRequest = "malformed_request"
+	codeValidationFailed = "validation_failed"
+	codeUnauthorized     = "unauthorized"
+	codeForbidden        = "forbidden"
+	codeTaskConflict     = "task_conflict"
+	codeInternal         = "internal_error"
+
+	invalidIntegerMessage = "must be an integer"
+	invalidDateMessage    = "must be an RFC 3339 date"
+)
+
+var (
+	errUnauthorized    = errors.New(unauthorizedMessage)
+	errRequestTooLarge = errors.New("request body is too large")
+)
+
+type fieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *fieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+type errorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+type errorMapping struct {
+	target  error
+	status  int
+	code    string
+	message string
+}
+
+var errorMappings = []errorMapping{
+	{target: errUnauthorized, status: http.StatusUnauthorized, code: codeUnauthorized, message: unauthorizedMessage},
+	{target: domain.ErrUserNotAllowed, status: http.StatusForbidden, code: codeForbidden, message: forbiddenMessage},
+
+	{target: domain.ErrInvalidTask, status: http.StatusBadRequest, code: "invalid_task"},
+	{target: domain.ErrInvalidTaskTransition, status: http.StatusBadRequest, code: "invalid_task_transition"},
+	{target: domain.ErrInvalidRRule, status: http.StatusBadRequest, code: "invalid_rrule"},
+	{target: domain.ErrInvalidTaskSeries, status: http.StatusBadRequest, code: "invalid_task_series"},
+	{target: domain.ErrTaskNotInTaskSeries, status: http.StatusBadRequest, code: "task_not_in_series"},
+	{target: domain.ErrInvalidTimezone, status: http.StatusBadRequest, code: "invalid_timezone"},
+	{target: domain.ErrInvalidNotificationPreference, status: http.StatusBadRequest, code: "invalid_notification_preference"},
+	{target: domain.ErrInvalidCustomer, status: http.StatusBadRequest, code: "invalid_customer"},
+	{target: domain.ErrInvalidSite, status: http.StatusBadRequest, code: "invalid_site"},
+	{target: domain.ErrInvalidVisit, status: http.StatusBadRequest, code: "invalid_visit"},
+	{target: domain.ErrInvalidTimeEntry, status: http.StatusBadRequest, code: "invalid_time_entry"},
+	{target: domain.ErrInvalidChecklist, status: http.StatusBadRequest, code: "invalid_checklist"},
+	{target: domain.ErrInvalidAttachment, status: http.StatusBadRequest, code: "invalid_attachment"},
+	{target: domain.ErrInvalidSignature, status: http.StatusBadRequest, code: "invalid_signature"},
+	{target: domain.ErrInvalidComment, status: http.StatusBadRequest, code: "invalid_comment"},
+	{target: domain.ErrInvalidPart, status: http.StatusBadRequest, code: "invalid_part"},
+	{target: domain.ErrInvalidStock, status: http.StatusBadRequest, code: "invalid_stock"},
+	{target: domain.ErrInvalidPartUsage, status: http.StatusBadRequest, code: "invalid_part_usage"},
+	{target: domain.ErrInvalidIdempotencyKey, status: http.StatusBadRequest, code: "invalid_idempotency_key"},
+	{target: domain.ErrInvalidTaskBatch, status: http.StatusBadRequest, code: "invalid_task_batch"},
+	{target: domain.ErrInvalidTaskImport, status: http.StatusBadRequest, code: "invalid_task_import"},
+
+	{target: domain.ErrTasksNotFound, status: http.StatusNotFound, code: "task_not_found"},
+	{target: domain.ErrTaskSeriesNotFound, status: http.StatusNotFound, code: "task_series_not_found"},
+	{target: domain.ErrUserNotFound, status: http.StatusNotFound, code: "user_not_found"},
+	{target: domain.ErrCustomerNotFound, status: http.StatusNotFound, code: "customer_not_found"},
+	{target: domain.ErrSiteNotFound, status: http.StatusNotFound, code: "site_not_found"},
+	{target: domain.ErrTimeEntryNotFound, status: http.StatusNotFound, code: "time_entry_not_found"},
+	{target: domain.ErrChecklistTemplateNotFound, status: http.StatusNotFound, code: "checklist_template_not_found"},
+	{target: domain.ErrChecklistItemNotFound, status: http.StatusNotFound, code: "checklist_item_not_found"},
+	{target: domain.ErrAttachmentNotFound, status: http.StatusNotFound, code: "attachment_not_found"},
+	{target: domain.ErrSignatureNotFound, status: http.StatusNotFound, code: "signature_not_found"},
+	{target: domain.ErrCommentNotFound, status: http.StatusNotFound, code: "comment_not_found"},
+	{target: domain.ErrPartNotFound, status: http.StatusNotFound, code: "part_not_found"},
+	{target: domain.ErrVisitNotFound, status: http.StatusNotFound, code: "visit_not_found"},
+	{target: domain.ErrCalendarFeedNotFound, status: http.StatusNotFound, code: "calendar_feed_not_found"},
+
+	{target: domain.ErrTaskConflict, status: http.StatusConflict, code: codeTaskConflict},
+	{target: domain.ErrChecklistIncomplete, status: http.StatusConflict, code: "checklist_incomplete"},
+	{target: domain.ErrAlreadyCheckedIn, status: http.StatusConflict, code: "already_checked_in"},
+	{target: domain.ErrNotCheckedIn, status: http.StatusConflict, code: "not_checked_in"},
+	{target: domain.ErrTimerRunning, status: http.StatusConflict, code: "timer_running"},
+	{target: domain.ErrTimeEntryOverlap, status: http.StatusConflict, code: "time_entry_overlap"},
+	{target: domain.ErrTaskAlreadySigned, status: http.StatusConflict, code: "task_already_signed"},
+	{target: domain.ErrInsufficientStock, status: http.StatusConflict, code: "insufficient_stock"},
+	{target: domain.ErrIdempotencyKeyInProgress, status: http.StatusConflict, code: "idempotency_key_in_progress"},
+
+	{target: domain.ErrIdempotencyKeyReused, status: http.StatusUnprocessableEntity, code: "idempotency_key_reused"},
+
+	{target: domain.ErrTaskVersionConflict, status: http.StatusPreconditionFailed, code: "task_version_conflict"},
+
+	{target: domain.ErrTaskBatchAborted, status: http.StatusFailedDependency, code: "task_batch_aborted"},
+
+	{target: domain.ErrAttachmentTooLarge, status: http.StatusRequestEntityTooLarge, code: "attachment_too_large"},
+	{target: errRequestTooLarge, status: http.StatusRequestEntityTooLarge, code: "request_too_large"},
+
+	{target: context.Canceled, status: http.StatusServiceUnavailable, code: "request_canceled", message: "request was canceled"},
+	{target: context.DeadlineExceeded, status: http.StatusGatewayTimeout, code: "request_timeout", message: "request timed out"},
+}
+
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(requestFieldName)
+	}
+}
+
+func respondError(c *gin.Context, err error) {
+	var version *domain.TaskVersionError
+	if errors.As(err, &version) {
+		c.Header("ETag", versionETag(version.Current))
+	}
+
+	status, response := errorResponse(c, err)
+
+	c.JSON(status, response)
+}
+
+// errorResponse maps err to its status and envelope. It is shared by
+// respondError and the per-item results of batch requests.
+func errorResponse(c *gin.Context, err error) (int, taskHandlerResponse) {
+	var fieldErr *fieldError
+	if errors.As(err, &fieldErr) {
+		details := []errorDetail{{Field: fieldErr.Field, Message: fieldErr.Message}}
+
+		return http.StatusBadRequest, toErrorResponse(c, codeValidationFailed, badRequestMessage, details)
+	}
+
+	var conflict *domain.TaskConflictError
+	if errors.As(err, &conflict) {
+		response := toErrorResponse(c, codeTaskConflict, conflictMessage, nil)
+		response.Conflicts = conflict.TaskIDs
+
+		return http.StatusConflict, response
+	}
+
+	for _, m := range errorMappings {
+		if !errors.Is(err, m.target) {
+			continue
+		}
+
+		message := m.message
+		if message == "" {
+			message = err.Error()
+		}
+
+		return m.status, toErrorResponse(c, m.code, message, nil)
+	}
+
+	log.Printf("error handling request %s: %v", c.GetString(requestIDKey), err) // Later: send to metrics/observability
+
+	return http.StatusInternalServerError, toErrorResponse(c, codeInternal, internalServerMessage, nil)
+}
+
+func respondBadRequest(c *gin.Context, err error) {
+	var (
+		validation validator.ValidationErrors
+		typeErr    *json.UnmarshalTypeError
+		fieldErr   *fieldError
+	)
+
+	switch {
+	case errors.As(err, &fieldErr):
+		respondInvalidField(c, fieldErr.Field, fieldErr.Message)
+	case errors.As(err, &validation):
+		var details []errorDetail
+		for _, fe := range validation {
+			details = append(details, errorDetail{Field: fe.Field(), Message: validationMessage(fe)})
+		}
+
+		c.JSON(http.StatusBadRequest, toErrorResponse(c, codeValidationFailed, badRequestMessage, details))
+	case errors.As(err, &typeErr):
+		details := []errorDetail{{Field: typeErr.Field, Message: typeMessage(typeErr.Type.Kind())}}
+
+		c.JSON(http.StatusBadRequest, toErrorResponse(c, codeValidationFailed, badRequestMessage, details))
+	default:
+		c.JSON(http.StatusBadRequest, toErrorResponse(c, codeMalformedRequest, badRequestMessage, nil))
+	}
+}
+
+func respondInvalidField(c *gin.Context, field, message string) {
+	details := []errorDetail{{Field: field, Message: message}}
+
+	c.JSON(http.StatusBadRequest, toErrorResponse(c, codeValidationFailed, badRequestMessage, details))
+}
+
+func toErrorResponse(c *gin.Context, code, message string, details []errorDetail) taskHandlerResponse {
+	return taskHandlerResponse{
+		Status:    false,
+		Error:     message,
+		Code:      code,
+		Details:   details,
+		RequestID: c.GetString(requestIDKey),
+	}
+}
+
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.Join(strings.Fields(fe.Param()), ", "))
+	case "min":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must have at least %s characters", fe.Param())
+		}
+
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must have at least %s items", fe.Param())
+		}
+
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must have at most %s characters", fe.Param())
+		}
+
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must have at most %s items", fe.Param())
+		}
+
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	}
+
+	return fmt.Sprintf("failed on the %s rule", fe.Tag())
+}
+
+func typeMessage(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return invalidIntegerMessage
+	case reflect.Float32, reflect.Float64:
+		return "must be a number"
+	case reflect.Bool:
+		return "must be a boolean"
+	case reflect.Slice, reflect.Array:
+		return "must be an array"
+	case reflect.Map, reflect.Struct:
+		return "must be an object"
+	}
+
+	return "must be a string"
+}
+
+func requestFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form"} {
+		name := strings.Split(field.Tag.Get(tag), ",")[0]
+
+		if name == "-" {
+			return ""
+		}
+
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
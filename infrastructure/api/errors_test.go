@@ -0,0 +1,134 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_respondError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		want       taskHandlerResponse
+	}{
+		{
+			name:       "Expect missing resources to be not found",
+			err:        domain.ErrTasksNotFound,
+			wantStatus: http.StatusNotFound,
+			want:       taskHandlerResponse{Error: "tasks not found", Code: "task_not_found"},
+		},
+		{
+			name:       "Expect forbidden to hide the domain message",
+			err:        domain.ErrUserNotAllowed,
+			wantStatus: http.StatusForbidden,
+			want:       taskHandlerResponse{Error: forbiddenMessage, Code: codeForbidden},
+		},
+		{
+			name:       "Expect booking conflicts to list the conflicting tasks",
+			err:        &domain.TaskConflictError{TaskIDs: []int64{4, 7}},
+			wantStatus: http.StatusConflict,
+			want:       taskHandlerResponse{Error: conflictMessage, Code: codeTaskConflict, Conflicts: []int64{4, 7}},
+		},
+		{
+			name:       "Expect stale versions to fail the precondition",
+			err:        &domain.TaskVersionError{TaskID: 1, Expected: 3, Current: 4},
+			wantStatus: http.StatusPreconditionFailed,
+			want:       taskHandlerResponse{Error: "task was changed by another request: task 1 is at version 4, not 3", Code: "task_version_conflict"},
+		},
+		{
+			name:       "Expect unknown errors to be hidden",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			want:       taskHandlerResponse{Error: internalServerMessage, Code: codeInternal},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(RequestID())
+			r.GET("/", func(c *gin.Context) {
+				respondError(c, tt.err)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(requestIDHeader, "req-1")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var got taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+			tt.want.RequestID = "req-1"
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "req-1", w.Header().Get(requestIDHeader))
+		})
+	}
+}
+
+func Test_respondBadRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode string
+		want     []errorDetail
+	}{
+		{
+			name:     "Expect binding rules to be reported per field",
+			body:     `{"summary": "", "priority": "P9", "duration_minutes": -1}`,
+			wantCode: codeValidationFailed,
+			want: []errorDetail{
+				{Field: "summary", Message: "is required"},
+				{Field: "duration_minutes", Message: "must be at least 0"},
+				{Field: "priority", Message: "must be one of P1, P2, P3, P4"},
+			},
+		},
+		{
+			name:     "Expect wrong JSON types to be reported per field",
+			body:     `{"summary": "hello", "site_id": "one"}`,
+			wantCode: codeValidationFailed,
+			want:     []errorDetail{{Field: "site_id", Message: invalidIntegerMessage}},
+		},
+		{
+			name:     "Expect broken JSON to be malformed",
+			body:     `{"summary": `,
+			wantCode: codeMalformedRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.POST("/", func(c *gin.Context) {
+				var request taskCreateRequest
+				if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+					respondBadRequest(c, err)
+				}
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body)))
+
+			var got taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Equal(t, badRequestMessage, got.Error)
+			assert.Equal(t, tt.wantCode, got.Code)
+			assert.Equal(t, tt.want, got.Details)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyStorageTimeout = 5 * time.Second
+)
+
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+
+	return w.ResponseWriter.WriteString(data)
+}
+
+// Idempotency makes POST, PATCH and DELETE requests carrying an Idempotency-Key
+// header safe to retry. The first response for a key is stored for the user
+// and replayed on retries; server errors release the key so it can be retried.
+// Requests without a valid token are left to the route's authenticator. The
+// body is read to fingerprint the request, so keyed requests larger than
+// maxBodySize are refused before they are buffered.
+func Idempotency(authenticator domain.Authenticator, idempotencyUsecase domain.IdempotencyUsecase, maxBodySize int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(idempotencyKeyHeader)
+		if header == "" || !idempotentMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		valid, claims, err := authenticator.IsAccessTokenValid(bearerToken(c))
+		if !valid || err != nil {
+			c.Next()
+			return
+		}
+
+		userID, _ := claims["user_id"].(float64)
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				err = fmt.Errorf("%w: limit for requests with an %s is %d bytes", errRequestTooLarge, idempotencyKeyHeader, maxBodySize)
+			}
+
+			respondError(c, err)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		key, err := domain.NewIdempotencyKey(int64(userID), header, requestFingerprint(c.Request, body))
+		if err != nil {
+			respondError(c, err)
+			c.Abort()
+			return
+		}
+
+		stored, replay, err := idempotencyUsecase.Reserve(c.Request.Context(), key)
+		if err != nil {
+			respondError(c, err)
+			c.Abort()
+			return
+		}
+
+		if replay {
+			c.Header(idempotentReplayedHeader, "true")
+			c.Data(stored.StatusCode, stored.ContentType, []byte(stored.Body))
+			c.Abort()
+			return
+		}
+
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		completed := false
+		defer func() {
+			if !completed {
+				releaseIdempotencyKey(c, idempotencyUsecase, key)
+			}
+		}()
+
+		c.Next()
+
+		if writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+
+		key.StatusCode = writer.Status()
+		key.ContentType = writer.Header().Get("Content-Type")
+		key.Body = writer.body.String()
+
+		ctx, cancel := domain.Detach(c.Request.Context(), idempotencyStorageTimeout)
+		defer cancel()
+
+		if err = idempotencyUsecase.Complete(ctx, key); err != nil {
+			log.Printf("error storing idempotent response %s: %v", c.GetString(requestIDKey), err) // Later: send to metrics/observability
+			return
+		}
+
+		completed = true
+	}
+}
+
+func releaseIdempotencyKey(c *gin.Context, idempotencyUsecase domain.IdempotencyUsecase, key domain.IdempotencyKey) {
+	ctx, cancel := domain.Detach(c.Request.Context(), idempotencyStorageTimeout)
+	defer cancel()
+
+	if err := idempotencyUsecase.Release(ctx, key); err != nil {
+		log.Printf("error releasing idempotency key %s: %v", c.GetString(requestIDKey), err) // Later: send to metrics/observability
+	}
+}
+
+func idempotentMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodPatch || method == http.MethodDelete
+}
+
+// requestFingerprint identifies the request a key was used with. Multipart
+// boundaries are random per attempt, so they are left out of the hash.
+func requestFingerprint(r *http.Request, body []byte) string {
+	if mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		if boundary := params["boundary"]; boundary != "" {
+			body = bytes.ReplaceAll(body, []byte(boundary), nil)
+		}
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
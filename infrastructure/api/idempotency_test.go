@@ -0,0 +1,204 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := map[string]any{"user_id": float64(2), "role_id": float64(2)}
+
+	tests := []struct {
+		name            string
+		method          string
+		key             string
+		body            string
+		handlerStatus   int
+		setDependencies func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase)
+		wantStatus      int
+		wantBody        string
+		wantCalls       int
+		wantReplayed    bool
+	}{
+		{
+			name:          "Expect requests without a key to pass through",
+			method:        http.MethodPost,
+			handlerStatus: http.StatusCreated,
+			wantStatus:    http.StatusCreated,
+			wantBody:      `{"id":1}`,
+			wantCalls:     1,
+		},
+		{
+			name:          "Expect reads to ignore the key",
+			method:        http.MethodGet,
+			key:           "retry-1",
+			handlerStatus: http.StatusOK,
+			wantStatus:    http.StatusOK,
+			wantBody:      `{"id":1}`,
+			wantCalls:     1,
+		},
+		{
+			name:          "Expect anonymous requests to be left to the authenticator",
+			method:        http.MethodPost,
+			key:           "retry-1",
+			handlerStatus: http.StatusCreated,
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(false, nil, errors.New("err"))
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":1}`,
+			wantCalls:  1,
+		},
+		{
+			name:          "Expect first request to be stored",
+			method:        http.MethodPost,
+			key:           "retry-1",
+			handlerStatus: http.StatusCreated,
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, key domain.IdempotencyKey) (domain.IdempotencyKey, bool, error) {
+					return key, false, nil
+				})
+				idempotency.EXPECT().Complete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, key domain.IdempotencyKey) error {
+					assert.Equal(t, int64(2), key.UserID)
+					assert.Equal(t, "retry-1", key.Key)
+					assert.Equal(t, http.StatusCreated, key.StatusCode)
+					assert.Equal(t, `{"id":1}`, key.Body)
+					return nil
+				})
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":1}`,
+			wantCalls:  1,
+		},
+		{
+			name:   "Expect retries to replay the stored response",
+			method: http.MethodPost,
+			key:    "retry-1",
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(domain.IdempotencyKey{StatusCode: http.StatusCreated, ContentType: gin.MIMEJSON, Body: `{"id":1}`}, true, nil)
+			},
+			wantStatus:   http.StatusCreated,
+			wantBody:     `{"id":1}`,
+			wantReplayed: true,
+		},
+		{
+			name:   "Expect key reused with another payload to be unprocessable",
+			method: http.MethodPatch,
+			key:    "retry-1",
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(domain.IdempotencyKey{}, false, domain.ErrIdempotencyKeyReused)
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "Expect too long key to be rejected",
+			method: http.MethodDelete,
+			key:    strings.Repeat("a", domain.MaxIdempotencyKeyLength+1),
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Expect bodies over the limit to be refused before they are buffered",
+			method: http.MethodPost,
+			key:    "retry-1",
+			body:   strings.Repeat("a", 65),
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+			},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:          "Expect server errors to release the key",
+			method:        http.MethodPost,
+			key:           "retry-1",
+			handlerStatus: http.StatusInternalServerError,
+			setDependencies: func(authenticator *domain.MockAuthenticator, idempotency *domain.MockIdempotencyUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, key domain.IdempotencyKey) (domain.IdempotencyKey, bool, error) {
+					return key, false, nil
+				})
+				idempotency.EXPECT().Release(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"id":1}`,
+			wantCalls:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			idempotency := domain.NewMockIdempotencyUsecase(ctrl)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(authenticator, idempotency)
+			}
+
+			calls := 0
+
+			r := gin.New()
+			r.Use(Idempotency(authenticator, idempotency, 64))
+			r.Handle(tt.method, "/v1/tasks", func(c *gin.Context) {
+				calls++
+				c.Data(tt.handlerStatus, gin.MIMEJSON, []byte(`{"id":1}`))
+			})
+
+			body := tt.body
+			if body == "" {
+				body = `{"summary":"hello"}`
+			}
+
+			req := httptest.NewRequest(tt.method, "/v1/tasks", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer token")
+			if tt.key != "" {
+				req.Header.Set(idempotencyKeyHeader, tt.key)
+			}
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+			assert.Equal(t, tt.wantReplayed, w.Header().Get(idempotentReplayedHeader) == "true")
+		})
+	}
+}
+
+func Test_requestFingerprint(t *testing.T) {
+	multipart := func(boundary string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/1/attachments", nil)
+		req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+		return req
+	}
+
+	body := func(boundary string) []byte {
+		return []byte("--" + boundary + "\r\nContent-Disposition: form-data; name=\"file\"\r\n\r\ndata\r\n--" + boundary + "--\r\n")
+	}
+
+	assert.Equal(t, requestFingerprint(multipart("aaa"), body("aaa")), requestFingerprint(multipart("bbb"), body("bbb")))
+
+	post := httptest.NewRequest(http.MethodPost, "/v1/tasks", nil)
+	assert.NotEqual(t, requestFingerprint(post, []byte(`{"summary":"a"}`)), requestFingerprint(post, []byte(`{"summary":"b"}`)))
+
+	patch := httptest.NewRequest(http.MethodPatch, "/v1/tasks", nil)
+	assert.NotEqual(t, requestFingerprint(post, []byte(`{}`)), requestFingerprint(patch, []byte(`{}`)))
+}
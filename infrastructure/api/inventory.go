@@ -0,0 +1,283 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type stockRequest struct {
+	Quantity    *int64 `json:"quantity" binding:"required"`
+	MinQuantity int64  `json:"min_quantity"`
+}
+
+type stockResponse struct {
+	UserID      int64        `json:"user_id"`
+	Part        partResponse `json:"part"`
+	Quantity    int64        `json:"quantity"`
+	MinQuantity int64        `json:"min_quantity"`
+	Low         bool         `json:"low"`
+}
+
+type partUsageRequest struct {
+	PartID   int64 `json:"part_id" binding:"required"`
+	Quantity int64 `json:"quantity" binding:"required"`
+}
+
+type partUsageResponse struct {
+	ID         int64          `json:"id"`
+	TaskID     int64          `json:"task_id"`
+	PartID     int64          `json:"part_id"`
+	UserID     int64          `json:"user_id"`
+	Quantity   int64          `json:"quantity"`
+	ConsumedAt string         `json:"consumed_at"`
+	Stock      *stockResponse `json:"stock,omitempty"`
+}
+
+type partUsageReportResponse struct {
+	CustomerID   int64        `json:"customer_id"`
+	CustomerName string       `json:"customer_name"`
+	Part         partResponse `json:"part"`
+	Quantity     int64        `json:"quantity"`
+}
+
+type InventoryAPIHandler struct {
+	router           *gin.Engine
+	authenticator    domain.Authenticator
+	inventoryUsecase domain.InventoryUsecase
+}
+
+func NewInventory(r *gin.Engine, authenticator domain.Authenticator, inventoryUsecase domain.InventoryUsecase) (*InventoryAPIHandler, error) {
+	if r == nil {
+		return &InventoryAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &InventoryAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if inventoryUsecase == nil {
+		return &InventoryAPIHandler{}, errors.New("inventoryUsecase must not be nil")
+	}
+
+	return &InventoryAPIHandler{
+		router:           r,
+		authenticator:    authenticator,
+		inventoryUsecase: inventoryUsecase,
+	}, nil
+}
+
+func (h *InventoryAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+
+	stock := v1.Group("stock")
+	stock.Use(Authenticator(h.authenticator))
+
+	stock.GET("", h.getStock)
+	stock.PUT("/:user_id/:part_id", h.putStock)
+
+	parts := v1.Group("parts")
+	parts.Use(Authenticator(h.authenticator))
+
+	parts.GET("/usage", h.report)
+
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/parts", h.getUsage)
+	tasks.POST("/:id/parts", h.consume)
+}
+
+func (h *InventoryAPIHandler) getStock(c *gin.Context) {
+	var userID int64
+
+	if c.Query("user_id") != "" {
+		id, err := strconv.ParseInt(c.Query("user_id"), 10, 64)
+		if err != nil {
+			respondInvalidField(c, "user_id", invalidIntegerMessage)
+			return
+		}
+
+		userID = id
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.inventoryUsecase.ListStock(c.Request.Context(), userID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []stockResponse
+
+	for _, stock := range result {
+		response = append(response, formatStock(stock))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *InventoryAPIHandler) putStock(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "user_id", invalidIntegerMessage)
+		return
+	}
+
+	partID, err := strconv.ParseInt(c.Param("part_id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "part_id", invalidIntegerMessage)
+		return
+	}
+
+	var request stockRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	stock, err := domain.NewStock(userID, partID, *request.Quantity, request.MinQuantity)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.inventoryUsecase.SetStock(c.Request.Context(), stock, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatStock(result)))
+}
+
+func (h *InventoryAPIHandler) getUsage(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.inventoryUsecase.ListUsageByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []partUsageResponse
+
+	dates := requestDates(c)
+
+	for _, usage := range result {
+		response = append(response, formatPartUsage(usage, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *InventoryAPIHandler) consume(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request partUsageRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	usage, err := domain.NewPartUsage(taskID, request.PartID, user.ID, request.Quantity)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.inventoryUsecase.Consume(c.Request.Context(), usage, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatPartUsage(result, requestDates(c))))
+}
+
+func (h *InventoryAPIHandler) report(c *gin.Context) {
+	from, to, err := requestDates(c).parseWindow(c.Query("from"), c.Query("to"))
+	if err != nil {
+		respondInvalidField(c, windowField(err, "from", "to"), invalidDateMessage)
+		return
+	}
+
+	filter := domain.PartUsageFilter{From: from, To: to}
+
+	if c.Query("customer_id") != "" {
+		filter.CustomerID, err = strconv.ParseInt(c.Query("customer_id"), 10, 64)
+		if err != nil {
+			respondInvalidField(c, "customer_id", invalidIntegerMessage)
+			return
+		}
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.inventoryUsecase.UsageReport(c.Request.Context(), filter, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []partUsageReportResponse
+
+	for _, r := range result {
+		response = append(response, partUsageReportResponse{
+			CustomerID:   r.CustomerID,
+			CustomerName: r.CustomerName,
+			Part:         formatPart(r.Part),
+			Quantity:     r.Quantity,
+		})
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func formatStock(stock domain.Stock) stockResponse {
+	return stockResponse{
+		UserID:      stock.UserID,
+		Part:        formatPart(stock.Part),
+		Quantity:    stock.Quantity,
+		MinQuantity: stock.MinQuantity,
+		Low:         stock.IsLow(),
+	}
+}
+
+func formatPartUsage(usage domain.PartUsage, dates dateCodec) partUsageResponse {
+	response := partUsageResponse{
+		ID:         usage.ID,
+		TaskID:     usage.TaskID,
+		PartID:     usage.PartID,
+		UserID:     usage.UserID,
+		Quantity:   usage.Quantity,
+		ConsumedAt: dates.format(&usage.CreatedAt),
+	}
+
+	if usage.Stock != nil {
+		stock := formatStock(*usage.Stock)
+		response.Stock = &stock
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	inventoryUsecase := domain.NewMockInventoryUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                *gin.Engine
+		authenticator    domain.Authenticator
+		inventoryUsecase domain.InventoryUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *InventoryAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                nil,
+				authenticator:    authenticator,
+				inventoryUsecase: inventoryUsecase,
+			},
+			want:    &InventoryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                r,
+				authenticator:    nil,
+				inventoryUsecase: inventoryUsecase,
+			},
+			want:    &InventoryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without inventoryUsecase",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				inventoryUsecase: nil,
+			},
+			want:    &InventoryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				inventoryUsecase: inventoryUsecase,
+			},
+			want: &InventoryAPIHandler{
+				router:           r,
+				authenticator:    authenticator,
+				inventoryUsecase: inventoryUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewInventory(tt.args.r, tt.args.authenticator, tt.args.inventoryUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewInventory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
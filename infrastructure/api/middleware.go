@@ -1,36 +1,150 @@
 package api
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/gin-gonic/gin"
-	"net/http"
+	"regexp"
 	"strings"
+	"time"
 )
 
+const (
+	requestIDHeader   = "X-Request-ID"
+	requestIDKey      = "request_id"
+	traceParentHeader = "traceparent"
+
+	transferTimeout = 2 * time.Minute
+	reportTimeout   = 30 * time.Second
+)
+
+var (
+	traceParentPattern = regexp.MustCompile(`^[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+	routeTimeouts = map[string]time.Duration{
+		"POST /v1/tasks/:id/attachments":               transferTimeout,
+		"GET /v1/tasks/:id/attachments/:attachment_id": transferTimeout,
+		"POST /v1/tasks/:id/signature":                 transferTimeout,
+		"GET /v1/time-entries/summary":                 reportTimeout,
+		"GET /v1/parts/usage":                          reportTimeout,
+		"POST /v1/tasks:batch":                         reportTimeout,
+		"GET /v1/tasks/export":                         transferTimeout,
+		"POST /v1/tasks/import":                        transferTimeout,
+	}
+)
+
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+
+		c.Set(requestIDKey, id)
+		c.Header(requestIDHeader, id)
+		c.Request = c.Request.WithContext(domain.WithRequestID(c.Request.Context(), id))
+
+		c.Next()
+	}
+}
+
+func TraceParent() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		traceParent := c.GetHeader(traceParentHeader)
+
+		if !traceParentPattern.MatchString(traceParent) {
+			traceParent = "00-" + randomHex(16) + "-" + randomHex(8) + "-01"
+		}
+
+		c.Request = c.Request.WithContext(domain.WithTraceParent(c.Request.Context(), traceParent))
+
+		c.Next()
+	}
+}
+
+func Deadline(fallback time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout := fallback
+		if override, ok := routeTimeouts[c.Request.Method+" "+c.FullPath()]; ok {
+			timeout = override
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
 func Authenticator(authenticator domain.Authenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		token := c.GetHeader("Authorization")
+		token := bearerToken(c)
 
 		if len(token) == 0 {
-			c.JSON(http.StatusUnauthorized, toResponse(false, unauthorizedMessage))
+			respondError(c, errUnauthorized)
 			c.Abort()
 			return
 		}
 
-		if strings.Contains(token, "Bearer") {
-			token = strings.TrimSpace(strings.Replace(token, "Bearer", "", -1))
-		}
-
 		valid, claims, err := authenticator.IsAccessTokenValid(token)
 		if !valid || err != nil {
-			c.JSON(http.StatusUnauthorized, toResponse(false, unauthorizedMessage))
+			respondError(c, errUnauthorized)
 			c.Abort()
 			return
 		}
 
 		c.Set("user_id", claims["user_id"])
 		c.Set("role_id", claims["role_id"])
+		c.Set("timezone", claims["timezone"])
 
 		c.Next()
 	}
 }
+
+func LegacyDates(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("legacy_dates", enabled)
+
+		c.Next()
+	}
+}
+
+func bearerToken(c *gin.Context) string {
+	token := c.GetHeader("Authorization")
+
+	if strings.Contains(token, "Bearer") {
+		token = strings.TrimSpace(strings.Replace(token, "Bearer", "", -1))
+	}
+
+	return token
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newRequestID() string {
+	return randomHex(16)
+}
+
+func randomHex(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTraceParent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{
+			name:   "Expect valid traceparent to be kept",
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			keep:   true,
+		},
+		{
+			name:   "Expect invalid traceparent to be replaced",
+			header: "not-a-trace",
+		},
+		{
+			name: "Expect missing traceparent to be generated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			r := gin.New()
+			r.Use(TraceParent())
+			r.GET("/", func(c *gin.Context) {
+				got = domain.TraceParentFrom(c.Request.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(traceParentHeader, tt.header)
+			}
+
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Regexp(t, traceParentPattern, got)
+			if tt.keep {
+				assert.Equal(t, tt.header, got)
+			}
+		})
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		method string
+		route  string
+		target string
+		want   time.Duration
+	}{
+		{
+			name:   "Expect fallback timeout on regular routes",
+			method: http.MethodGet,
+			route:  "/v1/tasks",
+			target: "/v1/tasks",
+			want:   time.Second,
+		},
+		{
+			name:   "Expect longer timeout on uploads",
+			method: http.MethodPost,
+			route:  "/v1/tasks/:id/attachments",
+			target: "/v1/tasks/1/attachments",
+			want:   transferTimeout,
+		},
+		{
+			name:   "Expect report timeout on summaries",
+			method: http.MethodGet,
+			route:  "/v1/time-entries/summary",
+			target: "/v1/time-entries/summary",
+			want:   reportTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got time.Time
+
+			r := gin.New()
+			r.Use(Deadline(time.Second))
+			r.Handle(tt.method, tt.route, func(c *gin.Context) {
+				got, _ = c.Request.Context().Deadline()
+			})
+
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.WithinDuration(t, time.Now().Add(tt.want), got, 500*time.Millisecond)
+		})
+	}
+}
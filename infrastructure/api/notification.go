@@ -0,0 +1,105 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+)
+
+type notificationPreferenceRequest struct {
+	Channels []string `json:"channels" binding:"required,dive,oneof=email sms"`
+	Locale   string   `json:"locale" binding:"max=16"`
+	Phone    string   `json:"phone" binding:"max=32"`
+}
+
+type notificationPreferenceResponse struct {
+	UserID   int64    `json:"user_id"`
+	Channels []string `json:"channels"`
+	Locale   string   `json:"locale"`
+	Email    string   `json:"email"`
+	Phone    string   `json:"phone,omitempty"`
+}
+
+type NotificationAPIHandler struct {
+	router              *gin.Engine
+	authenticator       domain.Authenticator
+	notificationUsecase domain.NotificationUsecase
+}
+
+func NewNotification(r *gin.Engine, authenticator domain.Authenticator, notificationUsecase domain.NotificationUsecase) (*NotificationAPIHandler, error) {
+	if r == nil {
+		return &NotificationAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &NotificationAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if notificationUsecase == nil {
+		return &NotificationAPIHandler{}, errors.New("notificationUsecase must not be nil")
+	}
+
+	return &NotificationAPIHandler{
+		router:              r,
+		authenticator:       authenticator,
+		notificationUsecase: notificationUsecase,
+	}, nil
+}
+
+func (h *NotificationAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	notifications := v1.Group("notifications")
+	notifications.Use(Authenticator(h.authenticator))
+
+	notifications.GET("/preferences", h.get)
+	notifications.PUT("/preferences", h.put)
+}
+
+func (h *NotificationAPIHandler) get(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	result, err := h.notificationUsecase.GetPreference(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatNotificationPreference(result)))
+}
+
+func (h *NotificationAPIHandler) put(c *gin.Context) {
+	var request notificationPreferenceRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	preference, err := domain.NewNotificationPreference(user.ID, request.Channels, request.Locale, request.Phone)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.notificationUsecase.UpdatePreference(c.Request.Context(), preference, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatNotificationPreference(result)))
+}
+
+func formatNotificationPreference(preference domain.NotificationPreference) notificationPreferenceResponse {
+	return notificationPreferenceResponse{
+		UserID:   preference.UserID,
+		Channels: preference.Channels,
+		Locale:   preference.Locale,
+		Email:    preference.Email,
+		Phone:    preference.Phone,
+	}
+}
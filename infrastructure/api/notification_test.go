@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	notificationUsecase := domain.NewMockNotificationUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                   *gin.Engine
+		authenticator       domain.Authenticator
+		notificationUsecase domain.NotificationUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *NotificationAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                   nil,
+				authenticator:       authenticator,
+				notificationUsecase: notificationUsecase,
+			},
+			want:    &NotificationAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                   r,
+				authenticator:       nil,
+				notificationUsecase: notificationUsecase,
+			},
+			want:    &NotificationAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without notificationUsecase",
+			args: args{
+				r:                   r,
+				authenticator:       authenticator,
+				notificationUsecase: nil,
+			},
+			want:    &NotificationAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                   r,
+				authenticator:       authenticator,
+				notificationUsecase: notificationUsecase,
+			},
+			want: &NotificationAPIHandler{
+				router:              r,
+				authenticator:       authenticator,
+				notificationUsecase: notificationUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNotification(tt.args.r, tt.args.authenticator, tt.args.notificationUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewNotification() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotificationAPIHandler_put(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := map[string]interface{}{"user_id": float64(2), "role_id": float64(2)}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       taskHandlerResponse
+	}{
+		{
+			name:       "Expect sms without a phone to be a bad request",
+			body:       `{"channels": ["email", "sms"]}`,
+			wantStatus: http.StatusBadRequest,
+			want:       taskHandlerResponse{Error: "notification preference fields are invalid: phone must not be empty when sms channel is enabled", Code: "invalid_notification_preference"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			r := gin.New()
+
+			h, err := NewNotification(r, authenticator, domain.NewMockNotificationUsecase(ctrl))
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodPut, "/v1/notifications/preferences", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var got taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,409 @@
+package api
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+type openAPISpec struct {
+	Paths      map[string]openAPIPathItem `json:"paths"`
+	Components struct {
+		Schemas map[string]*openAPISchema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIPathItem struct {
+	Parameters []openAPIParameter `json:"parameters"`
+	Get        *openAPIOperation  `json:"get"`
+	Post       *openAPIOperation  `json:"post"`
+	Put        *openAPIOperation  `json:"put"`
+	Patch      *openAPIOperation  `json:"patch"`
+	Delete     *openAPIOperation  `json:"delete"`
+}
+
+type openAPIOperation struct {
+	OperationID string                `json:"operationId"`
+	Parameters  []openAPIParameter    `json:"parameters"`
+	RequestBody *openAPIRequestBody   `json:"requestBody"`
+	Security    []map[string][]string `json:"security"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool `json:"required"`
+	Content  map[string]struct {
+		Schema *openAPISchema `json:"schema"`
+	} `json:"content"`
+}
+
+type openAPISchema struct {
+	Ref        string                    `json:"$ref"`
+	Type       string                    `json:"type"`
+	Properties map[string]*openAPISchema `json:"properties"`
+	Required   []string                  `json:"required"`
+	Items      *openAPISchema            `json:"items"`
+	Enum       []any                     `json:"enum"`
+	MinLength  *int                      `json:"minLength"`
+	MaxLength  *int                      `json:"maxLength"`
+	Minimum    *float64                  `json:"minimum"`
+}
+
+type OpenAPIHandler struct {
+	router *gin.Engine
+}
+
+func NewOpenAPI(r *gin.Engine) (*OpenAPIHandler, error) {
+	if r == nil {
+		return &OpenAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	return &OpenAPIHandler{
+		router: r,
+	}, nil
+}
+
+func (h *OpenAPIHandler) CreateRouter() {
+	h.router.GET("/openapi.json", h.get)
+}
+
+func (h *OpenAPIHandler) get(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", openAPIDocument)
+}
+
+func RequestValidator() (gin.HandlerFunc, error) {
+	spec, err := loadOpenAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		operation, parameters, ok := spec.operation(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		// Leave anonymous calls to the authenticator, so they get a 401 rather than a 400
+		if len(operation.Security) > 0 && c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+
+		if err := spec.validateRequest(c, operation, parameters); err != nil {
+			respondBadRequest(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}, nil
+}
+
+func loadOpenAPI() (*openAPISpec, error) {
+	var spec openAPISpec
+
+	if err := json.Unmarshal(openAPIDocument, &spec); err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+
+	return &spec, nil
+}
+
+func (s *openAPISpec) routes() []string {
+	var routes []string
+
+	for path, item := range s.Paths {
+		for method := range item.operations() {
+			routes = append(routes, method+" "+path)
+		}
+	}
+
+	sort.Strings(routes)
+
+	return routes
+}
+
+func (s *openAPISpec) operation(method, route string) (*openAPIOperation, []openAPIParameter, bool) {
+	if route == "" {
+		return nil, nil, false
+	}
+
+	item, ok := s.Paths[openAPIPath(route)]
+	if !ok {
+		return nil, nil, false
+	}
+
+	operation, ok := item.operations()[method]
+	if !ok {
+		return nil, nil, false
+	}
+
+	parameters := append([]openAPIParameter{}, item.Parameters...)
+	parameters = append(parameters, operation.Parameters...)
+
+	return operation, parameters, true
+}
+
+func (p openAPIPathItem) operations() map[string]*openAPIOperation {
+	operations := map[string]*openAPIOperation{}
+
+	for method, operation := range map[string]*openAPIOperation{
+		http.MethodGet:    p.Get,
+		http.MethodPost:   p.Post,
+		http.MethodPut:    p.Put,
+		http.MethodPatch:  p.Patch,
+		http.MethodDelete: p.Delete,
+	} {
+		if operation != nil {
+			operations[method] = operation
+		}
+	}
+
+	return operations
+}
+
+func (s *openAPISpec) validateRequest(c *gin.Context, operation *openAPIOperation, parameters []openAPIParameter) error {
+	for _, parameter := range parameters {
+		var (
+			value   string
+			present bool
+		)
+
+		switch parameter.In {
+		case "path":
+			value = c.Param(parameter.Name)
+			present = value != ""
+		case "query":
+			value, present = c.GetQuery(parameter.Name)
+		default:
+			continue
+		}
+
+		if !present {
+			if parameter.Required {
+				return &fieldError{Field: parameter.Name, Message: "is required"}
+			}
+
+			continue
+		}
+
+		if err := s.validateParameter(parameter.Name, value, parameter.Schema); err != nil {
+			return err
+		}
+	}
+
+	if operation.RequestBody == nil {
+		return nil
+	}
+
+	media, ok := operation.RequestBody.Content[gin.MIMEJSON]
+	if !ok || media.Schema == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return errors.New("request body could not be read")
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if operation.RequestBody.Required {
+			return &fieldError{Field: "body", Message: "is required"}
+		}
+
+		return nil
+	}
+
+	var payload any
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&payload); err != nil {
+		return errors.New("request body is not valid JSON")
+	}
+
+	return s.validate("body", payload, media.Schema)
+}
+
+func (s *openAPISpec) validateParameter(name, value string, schema *openAPISchema) error {
+	schema = s.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	switch schema.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return &fieldError{Field: name, Message: invalidIntegerMessage}
+		}
+
+		return s.validate(name, json.Number(value), schema)
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return &fieldError{Field: name, Message: "must be a number"}
+		}
+
+		return s.validate(name, json.Number(value), schema)
+	case "boolean":
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return &fieldError{Field: name, Message: "must be a boolean"}
+		}
+
+		return s.validate(name, parsed, schema)
+	}
+
+	return s.validate(name, value, schema)
+}
+
+func (s *openAPISpec) validate(name string, value any, schema *openAPISchema) error {
+	schema = s.resolve(schema)
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return &fieldError{Field: name, Message: "must be an object"}
+		}
+
+		for _, field := range schema.Required {
+			if _, ok := object[field]; !ok {
+				return &fieldError{Field: field, Message: "is required"}
+			}
+		}
+
+		fields := make([]string, 0, len(object))
+		for field := range object {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			property, ok := schema.Properties[field]
+			if !ok {
+				continue
+			}
+
+			if err := s.validate(field, object[field], property); err != nil {
+				return err
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return &fieldError{Field: name, Message: "must be an array"}
+		}
+
+		for i, item := range items {
+			if err := s.validate(fmt.Sprintf("%s[%d]", name, i), item, schema.Items); err != nil {
+				return err
+			}
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return &fieldError{Field: name, Message: "must be a string"}
+		}
+
+		length := utf8.RuneCountInString(text)
+
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return &fieldError{Field: name, Message: fmt.Sprintf("must have at least %d characters", *schema.MinLength)}
+		}
+
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return &fieldError{Field: name, Message: fmt.Sprintf("must have at most %d characters", *schema.MaxLength)}
+		}
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			return &fieldError{Field: name, Message: fmt.Sprintf("must be a %s", schema.Type)}
+		}
+
+		if schema.Type == "integer" {
+			if _, err := number.Int64(); err != nil {
+				return &fieldError{Field: name, Message: invalidIntegerMessage}
+			}
+		}
+
+		parsed, err := number.Float64()
+		if err != nil {
+			return &fieldError{Field: name, Message: "must be a number"}
+		}
+
+		if schema.Minimum != nil && parsed < *schema.Minimum {
+			return &fieldError{Field: name, Message: fmt.Sprintf("must be at least %v", *schema.Minimum)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return &fieldError{Field: name, Message: "must be a boolean"}
+		}
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(value, schema.Enum) {
+		var allowed []string
+		for _, e := range schema.Enum {
+			allowed = append(allowed, fmt.Sprint(e))
+		}
+
+		return &fieldError{Field: name, Message: fmt.Sprintf("must be one of %s", strings.Join(allowed, ", "))}
+	}
+
+	return nil
+}
+
+func (s *openAPISpec) resolve(schema *openAPISchema) *openAPISchema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+	}
+
+	return schema
+}
+
+func inEnum(value any, enum []any) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func openAPIPath(route string) string {
+	segments := strings.Split(route, "/")
+
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
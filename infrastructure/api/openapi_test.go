@@ -0,0 +1,245 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestNewOpenAPI(t *testing.T) {
+	r := gin.Default()
+
+	type args struct {
+		r *gin.Engine
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *OpenAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r: nil,
+			},
+			want:    &OpenAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success when initializing with all dependencies",
+			args: args{
+				r: r,
+			},
+			want: &OpenAPIHandler{
+				router: r,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpenAPI(tt.args.r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpenAPI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equalf(t, tt.want, got, "NewOpenAPI(%v)", tt.args.r)
+		})
+	}
+}
+
+func TestOpenAPIMatchesRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	r := gin.New()
+
+	taskRouter, err := NewTask(r, domain.NewMockAuthenticator(ctrl), domain.NewMockTaskUsecase(ctrl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taskRouter.CreateRouter()
+
+	authRouter, err := NewAuth(r, domain.NewMockAuthUsecase(ctrl))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authRouter.CreateRouter()
+
+	spec, err := loadOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var registered []string
+	for _, route := range r.Routes() {
+		registered = append(registered, route.Method+" "+openAPIPath(route.Path))
+	}
+	sort.Strings(registered)
+
+	assert.Equal(t, spec.routes(), registered, "openapi.json is out of sync with the task and auth routers")
+}
+
+func TestRequestValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validator, err := RequestValidator()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	r.Use(validator)
+
+	ok := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+	r.POST("/v1/auth", ok)
+	r.GET("/v1/tasks", ok)
+	r.POST("/v1/tasks", ok)
+	r.PATCH("/v1/tasks/:id", ok)
+	r.GET("/v1/customers", ok)
+
+	tests := []struct {
+		name      string
+		method    string
+		target    string
+		body      string
+		anonymous bool
+		want      int
+		wantError errorDetail
+	}{
+		{
+			name:   "Expect valid task to pass",
+			method: http.MethodPost,
+			target: "/v1/tasks",
+			body:   `{"summary": "Replace broken pump", "duration_minutes": 90, "priority": "P1"}`,
+			want:   http.StatusOK,
+		},
+		{
+			name:      "Expect missing summary to be rejected",
+			method:    http.MethodPost,
+			target:    "/v1/tasks",
+			body:      `{"date": "2023-11-15T15:04:00-03:00"}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "summary", Message: "is required"},
+		},
+		{
+			name:      "Expect empty body to be rejected",
+			method:    http.MethodPost,
+			target:    "/v1/tasks",
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "body", Message: "is required"},
+		},
+		{
+			name:      "Expect unknown priority to be rejected",
+			method:    http.MethodPost,
+			target:    "/v1/tasks",
+			body:      `{"summary": "hello", "priority": "P9"}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "priority", Message: "must be one of P1, P2, P3, P4"},
+		},
+		{
+			name:      "Expect too long summary to be rejected",
+			method:    http.MethodPost,
+			target:    "/v1/tasks",
+			body:      `{"summary": "` + strings.Repeat("a", 2501) + `"}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "summary", Message: "must have at most 2500 characters"},
+		},
+		{
+			name:      "Expect fractional duration to be rejected",
+			method:    http.MethodPatch,
+			target:    "/v1/tasks/1",
+			body:      `{"duration_minutes": 1.5}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "duration_minutes", Message: "must be an integer"},
+		},
+		{
+			name:      "Expect negative duration to be rejected",
+			method:    http.MethodPatch,
+			target:    "/v1/tasks/1",
+			body:      `{"duration_minutes": -1}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "duration_minutes", Message: "must be at least 0"},
+		},
+		{
+			name:      "Expect non numeric task ID to be rejected",
+			method:    http.MethodPatch,
+			target:    "/v1/tasks/abc",
+			body:      `{"summary": "hello"}`,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "id", Message: "must be an integer"},
+		},
+		{
+			name:   "Expect null fields to pass",
+			method: http.MethodPatch,
+			target: "/v1/tasks/1",
+			body:   `{"summary": "hello", "date": null}`,
+			want:   http.StatusOK,
+		},
+		{
+			name:      "Expect unknown sla filter to be rejected",
+			method:    http.MethodGet,
+			target:    "/v1/tasks?sla=late",
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "sla", Message: "must be one of overdue, at_risk"},
+		},
+		{
+			name:      "Expect zero customer filter to be rejected",
+			method:    http.MethodGet,
+			target:    "/v1/tasks?customer_id=0",
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "customer_id", Message: "must be at least 1"},
+		},
+		{
+			name:      "Expect missing password to be rejected",
+			method:    http.MethodPost,
+			target:    "/v1/auth",
+			body:      `{"email": "joe.doe@example.com"}`,
+			anonymous: true,
+			want:      http.StatusBadRequest,
+			wantError: errorDetail{Field: "password", Message: "is required"},
+		},
+		{
+			name:      "Expect anonymous calls to secured routes to be left to the authenticator",
+			method:    http.MethodPost,
+			target:    "/v1/tasks",
+			anonymous: true,
+			want:      http.StatusOK,
+		},
+		{
+			name:   "Expect routes outside the document to pass",
+			method: http.MethodGet,
+			target: "/v1/customers?sla=late",
+			want:   http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", gin.MIMEJSON)
+			if !tt.anonymous {
+				req.Header.Set("Authorization", "Bearer token")
+			}
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+			if tt.wantError != (errorDetail{}) {
+				var got taskHandlerResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, codeValidationFailed, got.Code)
+				assert.Equal(t, []errorDetail{tt.wantError}, got.Details)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type partCreateRequest struct {
+	SKU  string `json:"sku" binding:"required,max=64"`
+	Name string `json:"name" binding:"required,max=255"`
+	Unit string `json:"unit" binding:"max=16"`
+}
+
+type partUpdateRequest struct {
+	SKU  string `json:"sku" binding:"max=64"`
+	Name string `json:"name" binding:"max=255"`
+	Unit string `json:"unit" binding:"max=16"`
+}
+
+type partResponse struct {
+	ID   int64  `json:"id"`
+	SKU  string `json:"sku"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+}
+
+type PartAPIHandler struct {
+	router        *gin.Engine
+	authenticator domain.Authenticator
+	partUsecase   domain.PartUsecase
+}
+
+func NewPart(r *gin.Engine, authenticator domain.Authenticator, partUsecase domain.PartUsecase) (*PartAPIHandler, error) {
+	if r == nil {
+		return &PartAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &PartAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if partUsecase == nil {
+		return &PartAPIHandler{}, errors.New("partUsecase must not be nil")
+	}
+
+	return &PartAPIHandler{
+		router:        r,
+		authenticator: authenticator,
+		partUsecase:   partUsecase,
+	}, nil
+}
+
+func (h *PartAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	parts := v1.Group("parts")
+	parts.Use(Authenticator(h.authenticator))
+
+	parts.GET("", h.get)
+	parts.POST("", h.post)
+	parts.PATCH("/:id", h.patch)
+	parts.DELETE("/:id", h.remove)
+}
+
+func (h *PartAPIHandler) get(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	result, err := h.partUsecase.List(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []partResponse
+
+	for _, part := range result {
+		response = append(response, formatPart(part))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *PartAPIHandler) post(c *gin.Context) {
+	var request partCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	part, err := domain.NewPart(request.SKU, request.Name, request.Unit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.partUsecase.Add(c.Request.Context(), part, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatPart(result)))
+}
+
+func (h *PartAPIHandler) patch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request partUpdateRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.partUsecase.Update(c.Request.Context(), domain.Part{ID: id, SKU: request.SKU, Name: request.Name, Unit: request.Unit}, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatPart(result)))
+}
+
+func (h *PartAPIHandler) remove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.partUsecase.Remove(c.Request.Context(), id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func formatPart(part domain.Part) partResponse {
+	return partResponse{
+		ID:   part.ID,
+		SKU:  part.SKU,
+		Name: part.Name,
+		Unit: part.Unit,
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	partUsecase := domain.NewMockPartUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r             *gin.Engine
+		authenticator domain.Authenticator
+		partUsecase   domain.PartUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *PartAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:             nil,
+				authenticator: authenticator,
+				partUsecase:   partUsecase,
+			},
+			want:    &PartAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:             r,
+				authenticator: nil,
+				partUsecase:   partUsecase,
+			},
+			want:    &PartAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without partUsecase",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				partUsecase:   nil,
+			},
+			want:    &PartAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				partUsecase:   partUsecase,
+			},
+			want: &PartAPIHandler{
+				router:        r,
+				authenticator: authenticator,
+				partUsecase:   partUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPart(tt.args.r, tt.args.authenticator, tt.args.partUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPart() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type seriesCreateRequest struct {
+	Summary         string `json:"summary" binding:"required,max=2500"`
+	RRule           string `json:"rrule" binding:"required,max=255"`
+	Start           string `json:"start" binding:"required"`
+	Type            string `json:"type" binding:"max=64"`
+	Priority        string `json:"priority" binding:"omitempty,oneof=P1 P2 P3 P4"`
+	SiteID          int64  `json:"site_id" binding:"min=0"`
+	DurationMinutes int    `json:"duration_minutes" binding:"min=0"`
+	WindowMinutes   int    `json:"window_minutes" binding:"min=0"`
+	Timezone        string `json:"timezone" binding:"max=64"`
+	UserID          int64  `json:"user_id"`
+}
+
+type seriesUpdateRequest struct {
+	Summary         string `json:"summary" binding:"max=2500"`
+	RRule           string `json:"rrule" binding:"max=255"`
+	Start           string `json:"start"`
+	Type            string `json:"type" binding:"max=64"`
+	Priority        string `json:"priority" binding:"omitempty,oneof=P1 P2 P3 P4"`
+	SiteID          int64  `json:"site_id" binding:"min=0"`
+	DurationMinutes int    `json:"duration_minutes" binding:"min=0"`
+	WindowMinutes   int    `json:"window_minutes" binding:"min=0"`
+	Timezone        string `json:"timezone" binding:"max=64"`
+}
+
+type seriesUpdateQuery struct {
+	FromTask int64 `form:"from_task" binding:"omitempty,min=1"`
+}
+
+type seriesResponse struct {
+	ID              int64  `json:"id"`
+	Summary         string `json:"summary"`
+	RRule           string `json:"rrule"`
+	Start           string `json:"start"`
+	UserID          int64  `json:"user_id"`
+	Type            string `json:"type"`
+	Priority        string `json:"priority"`
+	SiteID          int64  `json:"site_id,omitempty"`
+	DurationMinutes int64  `json:"duration_minutes"`
+	WindowMinutes   int64  `json:"window_minutes"`
+	Timezone        string `json:"timezone"`
+	GeneratedUntil  string `json:"generated_until,omitempty"`
+}
+
+type SeriesAPIHandler struct {
+	router        *gin.Engine
+	authenticator domain.Authenticator
+	seriesUsecase domain.TaskSeriesUsecase
+}
+
+func NewSeries(r *gin.Engine, authenticator domain.Authenticator, seriesUsecase domain.TaskSeriesUsecase) (*SeriesAPIHandler, error) {
+	if r == nil {
+		return &SeriesAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &SeriesAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if seriesUsecase == nil {
+		return &SeriesAPIHandler{}, errors.New("seriesUsecase must not be nil")
+	}
+
+	return &SeriesAPIHandler{
+		router:        r,
+		authenticator: authenticator,
+		seriesUsecase: seriesUsecase,
+	}, nil
+}
+
+func (h *SeriesAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	series := v1.Group("series")
+	series.Use(Authenticator(h.authenticator))
+
+	series.GET("", h.get)
+	series.POST("", h.post)
+	series.PATCH("/:id", h.patch)
+	series.DELETE("/:id", h.remove)
+}
+
+func (h *SeriesAPIHandler) get(c *gin.Context) {
+	user := identifyUserRequester(c)
+
+	result, err := h.seriesUsecase.ListByUser(c.Request.Context(), user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []seriesResponse
+
+	dates := requestDates(c)
+
+	for _, s := range result {
+		response = append(response, formatSeries(s, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *SeriesAPIHandler) post(c *gin.Context) {
+	var request seriesCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+
+	start, err := dates.parse(request.Start)
+	if err != nil {
+		respondInvalidField(c, "start", invalidDateMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	userID := request.UserID
+	if userID == 0 {
+		userID = user.ID
+	}
+
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = user.Timezone
+	}
+
+	series, err := domain.NewTaskSeries(request.Summary, request.RRule, start, userID, request.Type, request.Priority, timezone)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	series.SiteID = request.SiteID
+	series.Duration = time.Duration(request.DurationMinutes) * time.Minute
+	series.Window = time.Duration(request.WindowMinutes) * time.Minute
+
+	result, err := h.seriesUsecase.Add(c.Request.Context(), series, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSeries(result, dates)))
+}
+
+func (h *SeriesAPIHandler) patch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var query seriesUpdateQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	var request seriesUpdateRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+
+	start, err := dates.parse(request.Start)
+	if err != nil {
+		respondInvalidField(c, "start", invalidDateMessage)
+		return
+	}
+
+	series := domain.TaskSeries{
+		ID:       id,
+		Summary:  request.Summary,
+		RRule:    request.RRule,
+		Type:     request.Type,
+		Priority: request.Priority,
+		SiteID:   request.SiteID,
+		Duration: time.Duration(request.DurationMinutes) * time.Minute,
+		Window:   time.Duration(request.WindowMinutes) * time.Minute,
+		Timezone: request.Timezone,
+	}
+
+	if start != nil {
+		series.Start = *start
+	}
+
+	user := identifyUserRequester(c)
+
+	var result domain.TaskSeries
+
+	if query.FromTask != 0 {
+		result, err = h.seriesUsecase.UpdateFromOccurrence(c.Request.Context(), query.FromTask, series, user)
+	} else {
+		result, err = h.seriesUsecase.Update(c.Request.Context(), series, user)
+	}
+
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSeries(result, dates)))
+}
+
+func (h *SeriesAPIHandler) remove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.seriesUsecase.Remove(c.Request.Context(), id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func formatSeries(series domain.TaskSeries, dates dateCodec) seriesResponse {
+	return seriesResponse{
+		ID:              series.ID,
+		Summary:         series.Summary,
+		RRule:           series.RRule,
+		Start:           dates.format(&series.Start),
+		UserID:          series.UserID,
+		Type:            series.Type,
+		Priority:        series.Priority,
+		SiteID:          series.SiteID,
+		DurationMinutes: int64(series.Duration / time.Minute),
+		WindowMinutes:   int64(series.Window / time.Minute),
+		Timezone:        series.Timezone,
+		GeneratedUntil:  dates.format(series.GeneratedUntil),
+	}
+}
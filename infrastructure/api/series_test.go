@@ -0,0 +1,181 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	seriesUsecase := domain.NewMockTaskSeriesUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r             *gin.Engine
+		authenticator domain.Authenticator
+		seriesUsecase domain.TaskSeriesUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SeriesAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:             nil,
+				authenticator: authenticator,
+				seriesUsecase: seriesUsecase,
+			},
+			want:    &SeriesAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:             r,
+				authenticator: nil,
+				seriesUsecase: seriesUsecase,
+			},
+			want:    &SeriesAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without seriesUsecase",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				seriesUsecase: nil,
+			},
+			want:    &SeriesAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				seriesUsecase: seriesUsecase,
+			},
+			want: &SeriesAPIHandler{
+				router:        r,
+				authenticator: authenticator,
+				seriesUsecase: seriesUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSeries(tt.args.r, tt.args.authenticator, tt.args.seriesUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSeries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSeriesAPIHandler_post(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := map[string]interface{}{"user_id": float64(1), "role_id": float64(1)}
+
+	tests := []struct {
+		name            string
+		body            string
+		setDependencies func(seriesUsecase *domain.MockTaskSeriesUsecase)
+		wantStatus      int
+		want            taskHandlerResponse
+	}{
+		{
+			name: "Expect schedule of the occurrences to be kept",
+			body: `{"summary": "Inspect the pump", "rrule": "FREQ=WEEKLY", "start": "2023-12-08T13:00:00Z", "priority": "P1", "site_id": 4, "duration_minutes": 60, "window_minutes": 120}`,
+			setDependencies: func(seriesUsecase *domain.MockTaskSeriesUsecase) {
+				series := domain.TaskSeries{
+					Summary:  "Inspect the pump",
+					RRule:    "FREQ=WEEKLY",
+					Start:    time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC),
+					UserID:   1,
+					Type:     domain.DefaultTaskType,
+					Priority: domain.TaskPriorityP1,
+					SiteID:   4,
+					Duration: time.Hour,
+					Window:   2 * time.Hour,
+					Timezone: domain.DefaultTimezone,
+				}
+
+				result := series
+				result.ID = 9
+
+				seriesUsecase.EXPECT().Add(gomock.Any(), series, domain.User{ID: 1, RoleID: 1}).Return(result, nil)
+			},
+			wantStatus: http.StatusOK,
+			want: taskHandlerResponse{Status: true, Result: map[string]interface{}{
+				"id":               float64(9),
+				"summary":          "Inspect the pump",
+				"rrule":            "FREQ=WEEKLY",
+				"start":            "2023-12-08T13:00:00Z",
+				"user_id":          float64(1),
+				"type":             "standard",
+				"priority":         "P1",
+				"site_id":          float64(4),
+				"duration_minutes": float64(60),
+				"window_minutes":   float64(120),
+				"timezone":         "UTC",
+			}},
+		},
+		{
+			name:       "Expect unsupported rule to be a bad request",
+			body:       `{"summary": "Inspect the pump", "rrule": "FREQ=SOMETIMES", "start": "2023-12-08T13:00:00Z"}`,
+			wantStatus: http.StatusBadRequest,
+			want:       taskHandlerResponse{Error: `task series fields are invalid: recurrence rule is invalid: FREQ "SOMETIMES" is not supported`, Code: "invalid_task_series"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			r := gin.New()
+
+			seriesUsecase := domain.NewMockTaskSeriesUsecase(ctrl)
+			if tt.setDependencies != nil {
+				tt.setDependencies(seriesUsecase)
+			}
+
+			h, err := NewSeries(r, authenticator, seriesUsecase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/series", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var got taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
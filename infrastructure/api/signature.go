@@ -0,0 +1,205 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type signatureRequest struct {
+	SignerName string          `json:"signer_name"`
+	Strokes    json.RawMessage `json:"strokes"`
+}
+
+type signatureResponse struct {
+	ID          int64           `json:"id"`
+	TaskID      int64           `json:"task_id"`
+	SignerName  string          `json:"signer_name"`
+	Format      string          `json:"format"`
+	Image       string          `json:"image,omitempty"`
+	Strokes     json.RawMessage `json:"strokes,omitempty"`
+	ContentHash string          `json:"content_hash"`
+	TaskHash    string          `json:"task_hash"`
+	SignedBy    int64           `json:"signed_by"`
+	SignedAt    string          `json:"signed_at"`
+}
+
+type signatureVerificationResponse struct {
+	Valid       bool   `json:"valid"`
+	SignerName  string `json:"signer_name"`
+	SignedAt    string `json:"signed_at"`
+	TaskHash    string `json:"task_hash"`
+	CurrentHash string `json:"current_hash"`
+}
+
+type SignatureAPIHandler struct {
+	router           *gin.Engine
+	authenticator    domain.Authenticator
+	signatureUsecase domain.SignatureUsecase
+}
+
+func NewSignature(r *gin.Engine, authenticator domain.Authenticator, signatureUsecase domain.SignatureUsecase) (*SignatureAPIHandler, error) {
+	if r == nil {
+		return &SignatureAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &SignatureAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if signatureUsecase == nil {
+		return &SignatureAPIHandler{}, errors.New("signatureUsecase must not be nil")
+	}
+
+	return &SignatureAPIHandler{
+		router:           r,
+		authenticator:    authenticator,
+		signatureUsecase: signatureUsecase,
+	}, nil
+}
+
+func (h *SignatureAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/signature", h.get)
+	tasks.POST("/:id/signature", h.post)
+	tasks.GET("/:id/signature/verify", h.verify)
+}
+
+func (h *SignatureAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.signatureUsecase.Get(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSignature(result, requestDates(c))))
+}
+
+func (h *SignatureAPIHandler) post(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	signerName, format, content, err := signatureContent(c)
+	if err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	signature, err := domain.NewSignature(taskID, signerName, format, content)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.signatureUsecase.Sign(c.Request.Context(), signature, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatSignature(result, requestDates(c))))
+}
+
+func (h *SignatureAPIHandler) verify(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.signatureUsecase.Verify(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, signatureVerificationResponse{
+		Valid:       result.Valid,
+		SignerName:  result.Signature.SignerName,
+		SignedAt:    requestDates(c).format(&result.Signature.SignedAt),
+		TaskHash:    result.Signature.TaskHash,
+		CurrentHash: result.CurrentHash,
+	}))
+}
+
+func signatureContent(c *gin.Context) (string, string, []byte, error) {
+	if c.ContentType() != gin.MIMEMultipartPOSTForm {
+		var request signatureRequest
+		if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+			return "", "", nil, err
+		}
+
+		return request.SignerName, domain.SignatureFormatStrokes, request.Strokes, nil
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return "", "", nil, &fieldError{Field: "file", Message: "is required"}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return "", "", nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, domain.MaxSignatureSize+1))
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	format := domain.SignatureFormatSVG
+	if sniffContentType(content) == "image/png" {
+		format = domain.SignatureFormatPNG
+	}
+
+	return c.PostForm("signer_name"), format, content, nil
+}
+
+func formatSignature(signature domain.Signature, dates dateCodec) signatureResponse {
+	response := signatureResponse{
+		ID:          signature.ID,
+		TaskID:      signature.TaskID,
+		SignerName:  signature.SignerName,
+		Format:      signature.Format,
+		ContentHash: signature.ContentHash,
+		TaskHash:    signature.TaskHash,
+		SignedBy:    signature.SignedBy,
+		SignedAt:    dates.format(&signature.SignedAt),
+	}
+
+	switch signature.Format {
+	case domain.SignatureFormatStrokes:
+		response.Strokes = signature.Content
+	case domain.SignatureFormatPNG:
+		response.Image = "data:image/png;base64," + base64.StdEncoding.EncodeToString(signature.Content)
+	case domain.SignatureFormatSVG:
+		response.Image = "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(signature.Content)
+	}
+
+	return response
+}
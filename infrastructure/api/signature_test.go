@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	signatureUsecase := domain.NewMockSignatureUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                *gin.Engine
+		authenticator    domain.Authenticator
+		signatureUsecase domain.SignatureUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SignatureAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                nil,
+				authenticator:    authenticator,
+				signatureUsecase: signatureUsecase,
+			},
+			want:    &SignatureAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                r,
+				authenticator:    nil,
+				signatureUsecase: signatureUsecase,
+			},
+			want:    &SignatureAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without signatureUsecase",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				signatureUsecase: nil,
+			},
+			want:    &SignatureAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				signatureUsecase: signatureUsecase,
+			},
+			want: &SignatureAPIHandler{
+				router:           r,
+				authenticator:    authenticator,
+				signatureUsecase: signatureUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSignature(tt.args.r, tt.args.authenticator, tt.args.signatureUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSignature() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
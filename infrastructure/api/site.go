@@ -0,0 +1,219 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type siteCreateRequest struct {
+	CustomerID int64    `json:"customer_id" binding:"required,min=1"`
+	Name       string   `json:"name" binding:"required,max=255"`
+	Address    string   `json:"address" binding:"required,max=500"`
+	Latitude   *float64 `json:"latitude" binding:"required"`
+	Longitude  *float64 `json:"longitude" binding:"required"`
+}
+
+type siteUpdateRequest struct {
+	CustomerID int64    `json:"customer_id" binding:"min=0"`
+	Name       string   `json:"name" binding:"max=255"`
+	Address    string   `json:"address" binding:"max=500"`
+	Latitude   *float64 `json:"latitude" binding:"required_with=Longitude"`
+	Longitude  *float64 `json:"longitude" binding:"required_with=Latitude"`
+}
+
+type siteListQuery struct {
+	CustomerID int64 `form:"customer_id" binding:"omitempty,min=1"`
+}
+
+type siteResponse struct {
+	ID         int64    `json:"id"`
+	CustomerID int64    `json:"customer_id"`
+	Name       string   `json:"name"`
+	Address    string   `json:"address"`
+	Latitude   *float64 `json:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty"`
+}
+
+type SiteAPIHandler struct {
+	router        *gin.Engine
+	authenticator domain.Authenticator
+	siteUsecase   domain.SiteUsecase
+}
+
+func NewSite(r *gin.Engine, authenticator domain.Authenticator, siteUsecase domain.SiteUsecase) (*SiteAPIHandler, error) {
+	if r == nil {
+		return &SiteAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &SiteAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if siteUsecase == nil {
+		return &SiteAPIHandler{}, errors.New("siteUsecase must not be nil")
+	}
+
+	return &SiteAPIHandler{
+		router:        r,
+		authenticator: authenticator,
+		siteUsecase:   siteUsecase,
+	}, nil
+}
+
+func (h *SiteAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	sites := v1.Group("sites")
+	sites.Use(Authenticator(h.authenticator))
+
+	sites.GET("", h.get)
+	sites.GET("/:id", h.getByID)
+	sites.POST("", h.post)
+	sites.PATCH("/:id", h.patch)
+	sites.DELETE("/:id", h.remove)
+}
+
+func (h *SiteAPIHandler) get(c *gin.Context) {
+	var query siteListQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.siteUsecase.List(c.Request.Context(), user, query.CustomerID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []siteResponse
+
+	for _, site := range result {
+		response = append(response, formatSite(site))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *SiteAPIHandler) getByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.siteUsecase.ListByID(c.Request.Context(), id, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSite(result)))
+}
+
+func (h *SiteAPIHandler) post(c *gin.Context) {
+	var request siteCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	site, err := domain.NewSite(request.CustomerID, request.Name, request.Address, toCoordinates(request.Latitude, request.Longitude))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.siteUsecase.Add(c.Request.Context(), site, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSite(result)))
+}
+
+func (h *SiteAPIHandler) patch(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request siteUpdateRequest
+
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	site := domain.Site{
+		ID:          id,
+		CustomerID:  request.CustomerID,
+		Name:        request.Name,
+		Address:     request.Address,
+		Coordinates: toCoordinates(request.Latitude, request.Longitude),
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.siteUsecase.Update(c.Request.Context(), site, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatSite(result)))
+}
+
+func (h *SiteAPIHandler) remove(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	if err := h.siteUsecase.Remove(c.Request.Context(), id, user); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func toCoordinates(latitude, longitude *float64) *domain.Coordinates {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+
+	return &domain.Coordinates{Latitude: *latitude, Longitude: *longitude}
+}
+
+func formatSite(site domain.Site) siteResponse {
+	response := siteResponse{
+		ID:         site.ID,
+		CustomerID: site.CustomerID,
+		Name:       site.Name,
+		Address:    site.Address,
+	}
+
+	if site.Coordinates != nil {
+		response.Latitude = &site.Coordinates.Latitude
+		response.Longitude = &site.Coordinates.Longitude
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	siteUsecase := domain.NewMockSiteUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r             *gin.Engine
+		authenticator domain.Authenticator
+		siteUsecase   domain.SiteUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SiteAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:             nil,
+				authenticator: authenticator,
+				siteUsecase:   siteUsecase,
+			},
+			want:    &SiteAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:             r,
+				authenticator: nil,
+				siteUsecase:   siteUsecase,
+			},
+			want:    &SiteAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without siteUsecase",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				siteUsecase:   nil,
+			},
+			want:    &SiteAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				siteUsecase:   siteUsecase,
+			},
+			want: &SiteAPIHandler{
+				router:        r,
+				authenticator: authenticator,
+				siteUsecase:   siteUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSite(tt.args.r, tt.args.authenticator, tt.args.siteUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSite() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,13 +1,13 @@
 package api
 
 import (
-	"context"
 	"errors"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,29 +15,68 @@ const (
 	badRequestMessage     = "malformed request"
 	internalServerMessage = "internal server error"
 	forbiddenMessage      = "not allowed to perform this action"
+	conflictMessage       = "task overlaps another booking of the technician"
+	invalidETagMessage    = "must be an ETag returned by the API"
 )
 
 type taskCreateRequest struct {
-	Summary string `json:"summary" binding:"required,max=2500"`
-	Date    string `json:"date"`
+	Summary         string `json:"summary" binding:"required,max=2500"`
+	Date            string `json:"date"`
+	DurationMinutes int    `json:"duration_minutes" binding:"min=0"`
+	WindowStart     string `json:"window_start"`
+	WindowEnd       string `json:"window_end"`
+	SiteID          int64  `json:"site_id" binding:"min=0"`
+	Type            string `json:"type" binding:"max=64"`
+	Priority        string `json:"priority" binding:"omitempty,oneof=P1 P2 P3 P4"`
+	DueAt           string `json:"due_at"`
 }
 
 type taskUpdateRequest struct {
-	Summary string `json:"summary" binding:"max=2500"`
-	Date    string `json:"date"`
+	Summary         string `json:"summary" binding:"max=2500"`
+	Date            string `json:"date"`
+	DurationMinutes int    `json:"duration_minutes" binding:"min=0"`
+	WindowStart     string `json:"window_start"`
+	WindowEnd       string `json:"window_end"`
+	SiteID          int64  `json:"site_id" binding:"min=0"`
+	Priority        string `json:"priority" binding:"omitempty,oneof=P1 P2 P3 P4"`
+	Status          string `json:"status" binding:"omitempty,oneof=open in_progress completed"`
+	DueAt           string `json:"due_at"`
+}
+
+type taskListQuery struct {
+	SLA        string `form:"sla" binding:"omitempty,oneof=overdue at_risk"`
+	Priority   string `form:"priority" binding:"omitempty,oneof=P1 P2 P3 P4"`
+	Sort       string `form:"sort" binding:"omitempty,oneof=priority due_at date"`
+	CustomerID int64  `form:"customer_id" binding:"omitempty,min=1"`
+	SiteID     int64  `form:"site_id" binding:"omitempty,min=1"`
 }
 
 type taskResponse struct {
-	ID      int64  `json:"id"`
-	Summary string `json:"summary"`
-	Date    string `json:"date"`
-	UserID  int64  `json:"user_id"`
+	ID              int64  `json:"id"`
+	Summary         string `json:"summary"`
+	Date            string `json:"date"`
+	End             string `json:"end,omitempty"`
+	DurationMinutes int64  `json:"duration_minutes"`
+	WindowStart     string `json:"window_start,omitempty"`
+	WindowEnd       string `json:"window_end,omitempty"`
+	UserID          int64  `json:"user_id"`
+	SiteID          int64  `json:"site_id,omitempty"`
+	Type            string `json:"type"`
+	Priority        string `json:"priority"`
+	Status          string `json:"status"`
+	DueAt           string `json:"due_at,omitempty"`
+	Overdue         bool   `json:"overdue"`
+	Version         int64  `json:"version"`
 }
 
 type taskHandlerResponse struct {
-	Status bool   `json:"status"`
-	Result any    `json:"result,omitempty"`
-	Error  string `json:"error,omitempty"`
+	Status    bool          `json:"status"`
+	Result    any           `json:"result,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Code      string        `json:"code,omitempty"`
+	Details   []errorDetail `json:"details,omitempty"`
+	Conflicts []int64       `json:"conflicting_task_ids,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
 }
 
 type TaskAPIHandler struct {
@@ -72,136 +111,239 @@ func (h *TaskAPIHandler) CreateRouter() {
 	tasks.Use(Authenticator(h.authenticator))
 
 	tasks.GET("", h.get)
+	tasks.GET("/next", h.next)
+	tasks.GET("/export", h.export)
+	tasks.GET("/:id", h.getByID)
 	tasks.POST("", h.post)
+	tasks.POST("/import", h.importTasks)
 	tasks.PATCH("/:id", h.patch)
 	tasks.DELETE("/:id", h.remove)
+
+	// gin reads ":batch" as a parameter, so the route also matches paths like
+	// /v1/tasksfoo; batchAction turns those away before authentication.
+	v1.POST("/tasks:batch", batchAction, Authenticator(h.authenticator), h.batch)
 }
 
 func (h *TaskAPIHandler) get(c *gin.Context) {
+	var query taskListQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
 	user := identifyUserRequester(c)
 
-	result, err := h.taskUsecase.ListByUser(context.Background(), user)
+	result, err := h.taskUsecase.ListByUser(c.Request.Context(), user, domain.TaskFilter{
+		SLA:        query.SLA,
+		Priority:   query.Priority,
+		Sort:       query.Sort,
+		CustomerID: query.CustomerID,
+		SiteID:     query.SiteID,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrTasksNotFound) {
-			c.JSON(http.StatusBadRequest, toResponse(false, err.Error()))
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, toResponse(false, internalServerMessage))
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toResponse(true, formatResponseSlice(result)))
+	c.JSON(http.StatusOK, toResponse(true, formatResponseSlice(result, requestDates(c))))
 }
 
-func (h *TaskAPIHandler) post(c *gin.Context) {
-	var request taskCreateRequest
-
-	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+func (h *TaskAPIHandler) getByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
 		return
 	}
 
-	parsedDate, err := parseDate(request.Date)
+	user := identifyUserRequester(c)
+
+	result, err := h.taskUsecase.Get(c.Request.Context(), id, user)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondError(c, err)
 		return
 	}
 
+	c.Header("ETag", versionETag(result.Version))
+	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result, requestDates(c))))
+}
+
+func (h *TaskAPIHandler) next(c *gin.Context) {
 	user := identifyUserRequester(c)
 
-	task, err := domain.NewTask(request.Summary, parsedDate, user.ID)
+	result, err := h.taskUsecase.Next(c.Request.Context(), user)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondError(c, err)
 		return
 	}
 
-	result, err := h.taskUsecase.Add(context.Background(), task, user)
+	c.Header("ETag", versionETag(result.Version))
+
+	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result, requestDates(c))))
+}
+
+func (h *TaskAPIHandler) post(c *gin.Context) {
+	var request taskCreateRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+	user := identifyUserRequester(c)
+
+	task, err := request.toTask(dates, user)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotAllowed) {
-			c.JSON(http.StatusForbidden, toResponse(false, forbiddenMessage))
-			return
-		}
+		respondError(c, err)
+		return
+	}
 
-		c.JSON(http.StatusInternalServerError, toResponse(false, internalServerMessage))
+	result, err := h.taskUsecase.Add(c.Request.Context(), task, user)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result)))
+	c.Header("ETag", versionETag(result.Version))
+	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result, dates)))
 }
 
 func (h *TaskAPIHandler) patch(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		respondInvalidField(c, "If-Match", invalidETagMessage)
 		return
 	}
 
 	var request taskUpdateRequest
 
 	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondBadRequest(c, err)
 		return
 	}
 
-	parsedDate, err := parseDate(request.Date)
+	dates := requestDates(c)
+
+	task, err := request.toTask(dates, id, version)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondError(c, err)
 		return
 	}
 
-	task := domain.Task{
-		ID:      id,
-		Summary: request.Summary,
-		Date:    parsedDate,
-	}
-
 	user := identifyUserRequester(c)
 
-	result, err := h.taskUsecase.Update(context.Background(), task, user)
+	result, err := h.taskUsecase.Update(c.Request.Context(), task, user)
 	if err != nil {
-		if errors.Is(err, domain.ErrTasksNotFound) {
-			c.JSON(http.StatusBadRequest, toResponse(false, err.Error()))
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, toResponse(false, internalServerMessage))
+		respondError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result)))
+	c.Header("ETag", versionETag(result.Version))
+
+	c.JSON(http.StatusOK, toResponse(true, formatResponseSingle(result, dates)))
 }
 
 func (h *TaskAPIHandler) remove(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, toResponse(false, badRequestMessage))
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		respondInvalidField(c, "If-Match", invalidETagMessage)
 		return
 	}
 
 	user := identifyUserRequester(c)
 
-	err = h.taskUsecase.Remove(context.Background(), id, user)
+	err = h.taskUsecase.Remove(c.Request.Context(), id, version, user)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotAllowed) {
-			c.JSON(http.StatusForbidden, toResponse(false, forbiddenMessage))
-			return
-		}
-
-		c.JSON(http.StatusInternalServerError, toResponse(false, internalServerMessage))
+		respondError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (r taskCreateRequest) toTask(dates dateCodec, user domain.User) (domain.Task, error) {
+	parsedDate, err := dates.parse(r.Date)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: "date", Message: invalidDateMessage}
+	}
+
+	parsedDueAt, err := dates.parse(r.DueAt)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: "due_at", Message: invalidDateMessage}
+	}
+
+	windowStart, windowEnd, err := dates.parseWindow(r.WindowStart, r.WindowEnd)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: windowField(err, "window_start", "window_end"), Message: invalidDateMessage}
+	}
+
+	task, err := domain.NewTask(r.Summary, parsedDate, user.ID, r.Type, r.Priority)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	task.DueAt = parsedDueAt
+	task.SiteID = r.SiteID
+	task.Duration = time.Duration(r.DurationMinutes) * time.Minute
+	task.WindowStart = windowStart
+	task.WindowEnd = windowEnd
+
+	return task, nil
+}
+
+func (r taskUpdateRequest) toTask(dates dateCodec, id, version int64) (domain.Task, error) {
+	parsedDate, err := dates.parse(r.Date)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: "date", Message: invalidDateMessage}
+	}
+
+	parsedDueAt, err := dates.parse(r.DueAt)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: "due_at", Message: invalidDateMessage}
+	}
+
+	windowStart, windowEnd, err := dates.parseWindow(r.WindowStart, r.WindowEnd)
+	if err != nil {
+		return domain.Task{}, &fieldError{Field: windowField(err, "window_start", "window_end"), Message: invalidDateMessage}
+	}
+
+	return domain.Task{
+		ID:          id,
+		Summary:     r.Summary,
+		Date:        parsedDate,
+		Duration:    time.Duration(r.DurationMinutes) * time.Minute,
+		WindowStart: windowStart,
+		WindowEnd:   windowEnd,
+		SiteID:      r.SiteID,
+		Priority:    r.Priority,
+		Status:      r.Status,
+		DueAt:       parsedDueAt,
+		Version:     version,
+	}, nil
+}
+
 func identifyUserRequester(c *gin.Context) domain.User {
 	userID := c.MustGet("user_id").(float64)
 	roleID := c.MustGet("role_id").(float64)
+	timezone, _ := c.Get("timezone")
+	tz, _ := timezone.(string)
 
 	return domain.User{
-		ID:     int64(userID),
-		RoleID: int64(roleID),
+		ID:       int64(userID),
+		RoleID:   int64(roleID),
+		Timezone: tz,
 	}
 }
 
@@ -222,40 +364,136 @@ func toResponse(status bool, result any) taskHandlerResponse {
 	return taskHandlerResponse{}
 }
 
-func formatResponseSingle(task domain.Task) taskResponse {
-	var date string
-
-	if task.Date != nil {
-		date = task.Date.Format(domain.TaskDateLayout)
-	}
-
+func formatResponseSingle(task domain.Task, dates dateCodec) taskResponse {
 	return taskResponse{
-		ID:      task.ID,
-		Summary: task.Summary,
-		Date:    date,
-		UserID:  task.UserID,
+		ID:              task.ID,
+		Summary:         task.Summary,
+		Date:            dates.format(task.Date),
+		End:             dates.format(task.End()),
+		DurationMinutes: int64(task.Duration / time.Minute),
+		WindowStart:     dates.format(task.WindowStart),
+		WindowEnd:       dates.format(task.WindowEnd),
+		UserID:          task.UserID,
+		SiteID:          task.SiteID,
+		Type:            task.Type,
+		Priority:        task.Priority,
+		Status:          task.Status,
+		DueAt:           dates.format(task.DueAt),
+		Overdue:         task.IsOverdue(time.Now()),
+		Version:         task.Version,
 	}
 }
 
-func formatResponseSlice(task []domain.Task) []taskResponse {
+func formatResponseSlice(task []domain.Task, dates dateCodec) []taskResponse {
 	var response []taskResponse
 
 	for _, t := range task {
-		response = append(response, formatResponseSingle(t))
+		response = append(response, formatResponseSingle(t, dates))
 	}
 
 	return response
 }
 
-func parseDate(date string) (*time.Time, error) {
+func versionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatchVersion reads the version a client expects from If-Match. It is 0
+// when the header is missing or "*", meaning any version is accepted.
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	return etagVersion(c.GetHeader("If-Match"))
+}
+
+func etagVersion(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	if len(value) < 3 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return 0, errInvalidETag
+	}
+
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil || version < 1 {
+		return 0, errInvalidETag
+	}
+
+	return version, nil
+}
+
+var (
+	errInvalidETag        = errors.New("etag is invalid")
+	errInvalidWindowStart = errors.New("window start is invalid")
+	errInvalidWindowEnd   = errors.New("window end is invalid")
+)
+
+type dateCodec struct {
+	location *time.Location
+	legacy   bool
+}
+
+func requestDates(c *gin.Context) dateCodec {
+	timezone, _ := c.Get("timezone")
+	tz, _ := timezone.(string)
+
+	return dateCodec{
+		location: domain.LoadLocation(tz),
+		legacy:   c.GetBool("legacy_dates"),
+	}
+}
+
+func (d dateCodec) format(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+
+	local := date.In(d.location)
+
+	if d.legacy {
+		return local.Format(domain.TaskDateLayout)
+	}
+
+	return local.Format(domain.DateTimeLayout)
+}
+
+func (d dateCodec) parseWindow(start, end string) (*time.Time, *time.Time, error) {
+	windowStart, err := d.parse(start)
+	if err != nil {
+		return nil, nil, errInvalidWindowStart
+	}
+
+	windowEnd, err := d.parse(end)
+	if err != nil {
+		return nil, nil, errInvalidWindowEnd
+	}
+
+	return windowStart, windowEnd, nil
+}
+
+func windowField(err error, start, end string) string {
+	if errors.Is(err, errInvalidWindowEnd) {
+		return end
+	}
+
+	return start
+}
+
+func (d dateCodec) parse(date string) (*time.Time, error) {
 	if date == "" {
 		return nil, nil
 	}
 
-	parsedTime, err := time.Parse(domain.TaskDateLayout, date)
+	parsedTime, err := time.Parse(domain.DateTimeLayout, date)
+	if err != nil && d.legacy {
+		parsedTime, err = time.ParseInLocation(domain.TaskDateLayout, date, d.location)
+	}
+
 	if err != nil {
 		return nil, errors.New(badRequestMessage)
 	}
 
+	parsedTime = parsedTime.UTC()
+
 	return &parsedTime, nil
 }
@@ -1,10 +1,14 @@
 package api
 
 import (
+	"encoding/json"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -82,3 +86,90 @@ func TestNewTask(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskAPIHandler_post(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := map[string]interface{}{"user_id": float64(2), "role_id": float64(2)}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		want       taskHandlerResponse
+	}{
+		{
+			// The binding counts characters and the domain counts bytes, so
+			// only the domain rejects this summary.
+			name:       "Expect domain validation to be a bad request",
+			body:       `{"summary": "` + strings.Repeat("é", 2500) + `"}`,
+			wantStatus: http.StatusBadRequest,
+			want:       taskHandlerResponse{Error: "task fields are invalid: summary size is too big", Code: "invalid_task"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			r := gin.New()
+
+			h, err := NewTask(r, authenticator, domain.NewMockTaskUsecase(ctrl))
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var got taskHandlerResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ifMatchVersion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		header  string
+		want    int64
+		wantErr bool
+	}{
+		{name: "Expect any version without the header"},
+		{name: "Expect any version with a wildcard", header: "*"},
+		{name: "Expect version from a strong ETag", header: `"3"`, want: 3},
+		{name: "Expect error on unquoted ETag", header: "3", wantErr: true},
+		{name: "Expect error on weak ETag", header: `W/"3"`, wantErr: true},
+		{name: "Expect error on non numeric ETag", header: `"abc"`, wantErr: true},
+		{name: "Expect error on zero version", header: `"0"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPatch, "/v1/tasks/1", nil)
+			if tt.header != "" {
+				c.Request.Header.Set("If-Match", tt.header)
+			}
+
+			got, err := ifMatchVersion(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ifMatchVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"net/http"
+)
+
+const (
+	taskBatchModeAtomic     = "atomic"
+	taskBatchModeBestEffort = "best_effort"
+)
+
+type taskBatchRequest struct {
+	Mode       string                      `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Operations []taskBatchOperationRequest `json:"operations" binding:"required,min=1,max=100,dive"`
+}
+
+type taskBatchOperationRequest struct {
+	Method  string          `json:"method" binding:"required,oneof=create update delete"`
+	ID      int64           `json:"id" binding:"min=0"`
+	IfMatch string          `json:"if_match"`
+	Task    json.RawMessage `json:"task"`
+}
+
+type taskBatchResponse struct {
+	Mode    string                    `json:"mode"`
+	Results []taskBatchResultResponse `json:"results"`
+}
+
+type taskBatchResultResponse struct {
+	Method    string        `json:"method"`
+	ID        int64         `json:"id,omitempty"`
+	Status    int           `json:"status"`
+	Task      *taskResponse `json:"task,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Code      string        `json:"code,omitempty"`
+	Details   []errorDetail `json:"details,omitempty"`
+	Conflicts []int64       `json:"conflicting_task_ids,omitempty"`
+}
+
+func batchAction(c *gin.Context) {
+	if c.Param("batch") != ":batch" {
+		c.AbortWithStatus(http.StatusNotFound)
+	}
+}
+
+// batch applies a list of create, update and delete operations. Atomic
+// batches, the default, answer with the status of the operation that failed
+// and roll every other one back; best effort batches always answer 200 and
+// report the outcome of each operation.
+func (h *TaskAPIHandler) batch(c *gin.Context) {
+	var request taskBatchRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	if request.Mode == "" {
+		request.Mode = taskBatchModeAtomic
+	}
+
+	dates := requestDates(c)
+	user := identifyUserRequester(c)
+
+	operations := make([]domain.TaskOperation, len(request.Operations))
+	for i, o := range request.Operations {
+		operation, err := o.toOperation(dates, user)
+		if err != nil {
+			respondError(c, prefixFieldError(fmt.Sprintf("operations[%d].", i), err))
+			return
+		}
+
+		operations[i] = operation
+	}
+
+	atomic := request.Mode == taskBatchModeAtomic
+
+	results, err := h.taskUsecase.Batch(c.Request.Context(), operations, atomic, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := taskBatchResponse{Mode: request.Mode, Results: make([]taskBatchResultResponse, len(results))}
+	status, failure := http.StatusOK, taskHandlerResponse{}
+
+	for i, result := range results {
+		item := taskBatchResultResponse{Method: operations[i].Method, ID: result.Task.ID}
+
+		switch {
+		case result.Err != nil:
+			var itemResponse taskHandlerResponse
+			item.Status, itemResponse = errorResponse(c, result.Err)
+			item.Error = itemResponse.Error
+			item.Code = itemResponse.Code
+			item.Details = itemResponse.Details
+			item.Conflicts = itemResponse.Conflicts
+
+			if atomic && !errors.Is(result.Err, domain.ErrTaskBatchAborted) {
+				status, failure = item.Status, itemResponse
+			}
+		case operations[i].Method == domain.TaskOperationDelete:
+			item.Status = http.StatusNoContent
+		default:
+			task := formatResponseSingle(result.Task, dates)
+			item.Status = http.StatusOK
+			item.Task = &task
+		}
+
+		response.Results[i] = item
+	}
+
+	if status != http.StatusOK {
+		failure.Result = response
+		c.JSON(status, failure)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (r taskBatchOperationRequest) toOperation(dates dateCodec, user domain.User) (domain.TaskOperation, error) {
+	version, err := etagVersion(r.IfMatch)
+	if err != nil {
+		return domain.TaskOperation{}, &fieldError{Field: "if_match", Message: invalidETagMessage}
+	}
+
+	if r.Method != domain.TaskOperationCreate && r.ID == 0 {
+		return domain.TaskOperation{}, &fieldError{Field: "id", Message: "is required"}
+	}
+
+	switch r.Method {
+	case domain.TaskOperationCreate:
+		var request taskCreateRequest
+		if err := decodeOperationTask(r.Task, &request); err != nil {
+			return domain.TaskOperation{}, err
+		}
+
+		task, err := request.toTask(dates, user)
+		if err != nil {
+			return domain.TaskOperation{}, prefixFieldError("task.", err)
+		}
+
+		return domain.TaskOperation{Method: r.Method, Task: task}, nil
+	case domain.TaskOperationUpdate:
+		var request taskUpdateRequest
+		if err := decodeOperationTask(r.Task, &request); err != nil {
+			return domain.TaskOperation{}, err
+		}
+
+		task, err := request.toTask(dates, r.ID, version)
+		if err != nil {
+			return domain.TaskOperation{}, prefixFieldError("task.", err)
+		}
+
+		return domain.TaskOperation{Method: r.Method, Task: task}, nil
+	default:
+		return domain.TaskOperation{Method: r.Method, Task: domain.Task{ID: r.ID, Version: version}}, nil
+	}
+}
+
+func decodeOperationTask(data json.RawMessage, request any) error {
+	if len(data) == 0 {
+		return &fieldError{Field: "task", Message: "is required"}
+	}
+
+	if err := json.Unmarshal(data, request); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return &fieldError{Field: "task." + typeErr.Field, Message: typeMessage(typeErr.Type.Kind())}
+		}
+
+		return &fieldError{Field: "task", Message: "must be an object"}
+	}
+
+	if err := binding.Validator.ValidateStruct(request); err != nil {
+		var validation validator.ValidationErrors
+		if errors.As(err, &validation) {
+			return &fieldError{Field: "task." + validation[0].Field(), Message: validationMessage(validation[0])}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// prefixFieldError nests the field of a field error under prefix, so clients
+// can tell which operation and which part of it to fix.
+func prefixFieldError(prefix string, err error) error {
+	var fieldErr *fieldError
+	if errors.As(err, &fieldErr) {
+		return &fieldError{Field: prefix + fieldErr.Field, Message: fieldErr.Message}
+	}
+
+	return err
+}
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTaskAPIHandler_batch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		claims  = map[string]any{"user_id": float64(1), "role_id": float64(1)}
+		manager = domain.User{ID: 1, RoleID: 1}
+		body    = `{"operations":[{"method":"delete","id":1,"if_match":"\"3\""},{"method":"update","id":2,"task":{"priority":"P1"}}]}`
+		deletes = []domain.TaskOperation{
+			{Method: domain.TaskOperationDelete, Task: domain.Task{ID: 1, Version: 3}},
+			{Method: domain.TaskOperationUpdate, Task: domain.Task{ID: 2, Priority: domain.TaskPriorityP1}},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		path            string
+		body            string
+		setDependencies func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase)
+		wantStatus      int
+		wantCode        string
+		wantField       string
+		wantResults     []int
+	}{
+		{
+			name:       "Expect paths that only look like the batch route to be not found",
+			path:       "/v1/tasksbatch",
+			body:       body,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Expect atomic batch to be the default",
+			path: "/v1/tasks:batch",
+			body: body,
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				taskUsecase.EXPECT().Batch(gomock.Any(), deletes, true, manager).Return([]domain.TaskOperationResult{
+					{Task: domain.Task{ID: 1}},
+					{Task: domain.Task{ID: 2, Priority: domain.TaskPriorityP1, Version: 4}},
+				}, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantResults: []int{http.StatusNoContent, http.StatusOK},
+		},
+		{
+			name: "Expect failed atomic batch to answer with the failing operation",
+			path: "/v1/tasks:batch",
+			body: body,
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				taskUsecase.EXPECT().Batch(gomock.Any(), deletes, true, manager).Return([]domain.TaskOperationResult{
+					{Task: domain.Task{ID: 1}, Err: domain.ErrTaskBatchAborted},
+					{Task: domain.Task{ID: 2}, Err: domain.ErrTasksNotFound},
+				}, nil)
+			},
+			wantStatus:  http.StatusNotFound,
+			wantCode:    "task_not_found",
+			wantResults: []int{http.StatusFailedDependency, http.StatusNotFound},
+		},
+		{
+			name: "Expect best effort batch to succeed with failed operations",
+			path: "/v1/tasks:batch",
+			body: `{"mode":"best_effort","operations":[{"method":"delete","id":1,"if_match":"\"3\""},{"method":"update","id":2,"task":{"priority":"P1"}}]}`,
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				taskUsecase.EXPECT().Batch(gomock.Any(), deletes, false, manager).Return([]domain.TaskOperationResult{
+					{Task: domain.Task{ID: 1}, Err: &domain.TaskVersionError{TaskID: 1, Expected: 3, Current: 4}},
+					{Task: domain.Task{ID: 2, Priority: domain.TaskPriorityP1, Version: 4}},
+				}, nil)
+			},
+			wantStatus:  http.StatusOK,
+			wantResults: []int{http.StatusPreconditionFailed, http.StatusOK},
+		},
+		{
+			name: "Expect invalid operation to point at its field",
+			path: "/v1/tasks:batch",
+			body: `{"operations":[{"method":"delete","id":1},{"method":"create","task":{"summary":"hello","date":"tomorrow"}}]}`,
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   codeValidationFailed,
+			wantField:  "operations[1].task.date",
+		},
+		{
+			name: "Expect update without id to be rejected",
+			path: "/v1/tasks:batch",
+			body: `{"operations":[{"method":"update","task":{"priority":"P1"}}]}`,
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   codeValidationFailed,
+			wantField:  "operations[0].id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			taskUsecase := domain.NewMockTaskUsecase(ctrl)
+
+			if tt.setDependencies != nil {
+				tt.setDependencies(authenticator, taskUsecase)
+			}
+
+			r := gin.New()
+
+			h, err := NewTask(r, authenticator, taskUsecase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			if tt.wantStatus == http.StatusNotFound && tt.wantCode == "" {
+				return
+			}
+
+			var response struct {
+				Code    string        `json:"code"`
+				Details []errorDetail `json:"details"`
+				Result  struct {
+					Results []taskBatchResultResponse `json:"results"`
+				} `json:"result"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantCode, response.Code)
+
+			if tt.wantField != "" {
+				assert.Equal(t, tt.wantField, response.Details[0].Field)
+			}
+
+			var statuses []int
+			for _, result := range response.Result.Results {
+				statuses = append(statuses, result.Status)
+			}
+			assert.Equal(t, tt.wantResults, statuses)
+		})
+	}
+}
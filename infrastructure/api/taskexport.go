@@ -0,0 +1,346 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatXLSX = "xlsx"
+
+	exportSheet      = "Tasks"
+	exportFlushEvery = 100
+
+	invalidColumnsMessage = "must be a comma separated list of export columns"
+)
+
+type taskExportQuery struct {
+	taskListQuery
+	Format  string `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	Columns string `form:"columns"`
+	Locale  string `form:"locale" binding:"max=35"`
+}
+
+type exportColumn func(task domain.Task, dates exportDates) string
+
+var (
+	exportColumns = map[string]exportColumn{
+		"id":               func(t domain.Task, _ exportDates) string { return strconv.FormatInt(t.ID, 10) },
+		"summary":          func(t domain.Task, _ exportDates) string { return t.Summary },
+		"date":             func(t domain.Task, d exportDates) string { return d.format(t.Date) },
+		"end":              func(t domain.Task, d exportDates) string { return d.format(t.End()) },
+		"duration_minutes": func(t domain.Task, _ exportDates) string { return strconv.FormatInt(int64(t.Duration/time.Minute), 10) },
+		"window_start":     func(t domain.Task, d exportDates) string { return d.format(t.WindowStart) },
+		"window_end":       func(t domain.Task, d exportDates) string { return d.format(t.WindowEnd) },
+		"user_id":          func(t domain.Task, _ exportDates) string { return strconv.FormatInt(t.UserID, 10) },
+		"site_id":          func(t domain.Task, _ exportDates) string { return formatOptionalID(t.SiteID) },
+		"type":             func(t domain.Task, _ exportDates) string { return t.Type },
+		"priority":         func(t domain.Task, _ exportDates) string { return t.Priority },
+		"status":           func(t domain.Task, _ exportDates) string { return t.Status },
+		"due_at":           func(t domain.Task, d exportDates) string { return d.format(t.DueAt) },
+		"overdue":          func(t domain.Task, d exportDates) string { return strconv.FormatBool(t.IsOverdue(d.now)) },
+		"version":          func(t domain.Task, _ exportDates) string { return strconv.FormatInt(t.Version, 10) },
+	}
+
+	defaultExportColumns = []string{"id", "summary", "date", "duration_minutes", "user_id", "site_id", "type", "priority", "status", "due_at", "overdue"}
+
+	// exportDateLayouts holds how spreadsheets of each locale expect dates,
+	// keyed by lower case language tag or bare language.
+	exportDateLayouts = map[string]string{
+		"en":    "2006-01-02 15:04",
+		"en-us": "01/02/2006 03:04 PM",
+		"en-gb": "02/01/2006 15:04",
+		"pt":    "02/01/2006 15:04",
+		"es":    "02/01/2006 15:04",
+		"fr":    "02/01/2006 15:04",
+		"it":    "02/01/2006 15:04",
+		"de":    "02.01.2006 15:04",
+		"nl":    "02-01-2006 15:04",
+		"ja":    "2006/01/02 15:04",
+	}
+)
+
+type exportDates struct {
+	location *time.Location
+	layout   string
+	now      time.Time
+}
+
+func (d exportDates) format(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+
+	return date.In(d.location).Format(d.layout)
+}
+
+type rowWriter interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+// export streams the tasks a manager can list as a CSV or XLSX file. Once rows
+// have been sent an error can no longer change the status, so it is logged and
+// the response is cut short.
+func (h *TaskAPIHandler) export(c *gin.Context) {
+	var query taskExportQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	columns, err := parseExportColumns(query.Columns)
+	if err != nil {
+		respondInvalidField(c, "columns", invalidColumnsMessage)
+		return
+	}
+
+	locale := query.Locale
+	if locale == "" {
+		locale = c.GetHeader("Accept-Language")
+	}
+
+	dates := exportDates{
+		location: requestDates(c).location,
+		layout:   exportDateLayout(locale),
+		now:      time.Now(),
+	}
+
+	user := identifyUserRequester(c)
+
+	var writer rowWriter
+
+	start := func() error {
+		writer, err = startExport(c, query.Format, columns)
+		return err
+	}
+
+	err = h.taskUsecase.Export(c.Request.Context(), user, domain.TaskFilter{
+		SLA:        query.SLA,
+		Priority:   query.Priority,
+		Sort:       query.Sort,
+		CustomerID: query.CustomerID,
+		SiteID:     query.SiteID,
+	}, func(task domain.Task) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = exportColumns[column](task, dates)
+		}
+
+		return writer.WriteRow(row)
+	})
+	if err == nil && writer == nil {
+		err = start()
+	}
+
+	if err == nil {
+		err = writer.Close()
+	}
+
+	if err != nil {
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Type")
+			c.Writer.Header().Del("Content-Disposition")
+			respondError(c, err)
+			return
+		}
+
+		log.Printf("error exporting tasks %s: %v", c.GetString(requestIDKey), err) // Later: send to metrics/observability
+		c.Abort()
+	}
+}
+
+func startExport(c *gin.Context, format string, columns []string) (rowWriter, error) {
+	filename := "tasks-" + time.Now().UTC().Format("20060102T150405Z")
+
+	var (
+		writer rowWriter
+		err    error
+	)
+
+	switch format {
+	case exportFormatXLSX:
+		c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		filename += ".xlsx"
+		writer, err = newXLSXRowWriter(c.Writer)
+	default:
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		filename += ".csv"
+		writer = newCSVRowWriter(c.Writer)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	return writer, writer.WriteRow(columns)
+}
+
+func parseExportColumns(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return defaultExportColumns, nil
+	}
+
+	var columns []string
+	for _, column := range strings.Split(value, ",") {
+		column = strings.TrimSpace(column)
+		if _, ok := exportColumns[column]; !ok {
+			return nil, fmt.Errorf("column %q is not supported", column)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// exportDateLayout picks the layout for the first language of an
+// Accept-Language style value, falling back to its bare language and then to
+// RFC 3339.
+func exportDateLayout(locale string) string {
+	tag := strings.ToLower(strings.TrimSpace(strings.Split(strings.Split(locale, ",")[0], ";")[0]))
+	tag = strings.ReplaceAll(tag, "_", "-")
+
+	if layout, ok := exportDateLayouts[tag]; ok {
+		return layout
+	}
+
+	if layout, ok := exportDateLayouts[strings.Split(tag, "-")[0]]; ok {
+		return layout
+	}
+
+	return domain.DateTimeLayout
+}
+
+func formatOptionalID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
+
+// spreadsheetCell keeps spreadsheet applications from evaluating text that
+// looks like a formula, such as a summary starting with "=".
+func spreadsheetCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+type csvRowWriter struct {
+	out    io.Writer
+	writer *csv.Writer
+	rows   int
+}
+
+func newCSVRowWriter(out io.Writer) *csvRowWriter {
+	return &csvRowWriter{out: out, writer: csv.NewWriter(out)}
+}
+
+func (w *csvRowWriter) WriteRow(row []string) error {
+	cells := make([]string, len(row))
+	for i, value := range row {
+		cells[i] = spreadsheetCell(value)
+	}
+
+	if err := w.writer.Write(cells); err != nil {
+		return err
+	}
+
+	w.rows++
+	if w.rows%exportFlushEvery == 0 {
+		return w.flush()
+	}
+
+	return nil
+}
+
+func (w *csvRowWriter) Close() error {
+	return w.flush()
+}
+
+func (w *csvRowWriter) flush() error {
+	w.writer.Flush()
+
+	if flusher, ok := w.out.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return w.writer.Error()
+}
+
+// xlsxRowWriter streams rows into the sheet, which excelize spills to a
+// temporary file once it grows, and writes the workbook out on Close.
+type xlsxRowWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func newXLSXRowWriter(out io.Writer) (*xlsxRowWriter, error) {
+	file := excelize.NewFile()
+
+	if err := file.SetSheetName("Sheet1", exportSheet); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	stream, err := file.NewStreamWriter(exportSheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxRowWriter{out: out, file: file, stream: stream}, nil
+}
+
+func (w *xlsxRowWriter) WriteRow(row []string) error {
+	w.rows++
+
+	cell, err := excelize.CoordinatesToCellName(1, w.rows)
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(row))
+	for i, value := range row {
+		values[i] = spreadsheetCell(value)
+	}
+
+	return w.stream.SetRow(cell, values)
+}
+
+func (w *xlsxRowWriter) Close() error {
+	defer w.file.Close()
+
+	if err := w.stream.Flush(); err != nil {
+		return err
+	}
+
+	_, err := w.file.WriteTo(w.out)
+
+	return err
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"bytes"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTaskAPIHandler_export(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		date  = time.Date(2023, 11, 15, 18, 4, 0, 0, time.UTC)
+		tasks = []domain.Task{
+			{ID: 1, Summary: "Fix the pump", Date: &date, UserID: 2, Priority: domain.TaskPriorityP1, Status: domain.TaskStatusOpen},
+			{ID: 2, Summary: "=HYPERLINK(\"x\")", UserID: 3, Priority: domain.TaskPriorityP3, Status: domain.TaskStatusOpen},
+		}
+		export = func(_ any, _ domain.User, _ domain.TaskFilter, fn func(domain.Task) error) error {
+			for _, task := range tasks {
+				if err := fn(task); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	)
+
+	tests := []struct {
+		name            string
+		query           string
+		claims          map[string]any
+		setDependencies func(taskUsecase *domain.MockTaskUsecase)
+		wantStatus      int
+		wantType        string
+		wantBody        string
+		wantRows        [][]string
+	}{
+		{
+			name:   "Expect CSV with the picked columns and locale dates",
+			query:  "?format=csv&columns=id,summary,date&locale=pt-BR&priority=P1",
+			claims: map[string]any{"user_id": float64(1), "role_id": float64(1)},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Export(gomock.Any(), domain.User{ID: 1, RoleID: 1}, domain.TaskFilter{Priority: domain.TaskPriorityP1}, gomock.Any()).DoAndReturn(export)
+			},
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv; charset=utf-8",
+			wantBody:   "id,summary,date\n1,Fix the pump,15/11/2023 18:04\n2,\"'=HYPERLINK(\"\"x\"\")\",\n",
+		},
+		{
+			name:   "Expect XLSX workbook",
+			query:  "?format=xlsx&columns=id,status",
+			claims: map[string]any{"user_id": float64(1), "role_id": float64(1)},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(export)
+			},
+			wantStatus: http.StatusOK,
+			wantType:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			wantRows:   [][]string{{"id", "status"}, {"1", "open"}, {"2", "open"}},
+		},
+		{
+			name:   "Expect header row when nothing matches",
+			query:  "?columns=id",
+			claims: map[string]any{"user_id": float64(1), "role_id": float64(1)},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv; charset=utf-8",
+			wantBody:   "id\n",
+		},
+		{
+			name:   "Expect technicians to be forbidden",
+			claims: map[string]any{"user_id": float64(2), "role_id": float64(2)},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.ErrUserNotAllowed)
+			},
+			wantStatus: http.StatusForbidden,
+			wantType:   "application/json; charset=utf-8",
+		},
+		{
+			name:       "Expect unknown columns to be rejected",
+			query:      "?columns=id,secret",
+			claims:     map[string]any{"user_id": float64(1), "role_id": float64(1)},
+			wantStatus: http.StatusBadRequest,
+			wantType:   "application/json; charset=utf-8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, tt.claims, nil)
+
+			taskUsecase := domain.NewMockTaskUsecase(ctrl)
+			if tt.setDependencies != nil {
+				tt.setDependencies(taskUsecase)
+			}
+
+			r := gin.New()
+
+			h, err := NewTask(r, authenticator, taskUsecase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/export"+tt.query, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantType, w.Header().Get("Content-Type"))
+
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			}
+
+			if tt.wantRows != nil {
+				file, err := excelize.OpenReader(bytes.NewReader(w.Body.Bytes()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer file.Close()
+
+				rows, err := file.GetRows(exportSheet)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRows, rows)
+			}
+		})
+	}
+}
+
+func Test_exportDateLayout(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "", want: domain.DateTimeLayout},
+		{locale: "pt-BR", want: "02/01/2006 15:04"},
+		{locale: "en-US,en;q=0.9", want: "01/02/2006 03:04 PM"},
+		{locale: "de_AT", want: "02.01.2006 15:04"},
+		{locale: "xx", want: domain.DateTimeLayout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			assert.Equal(t, tt.want, exportDateLayout(tt.locale))
+		})
+	}
+}
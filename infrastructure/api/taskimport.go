@@ -0,0 +1,298 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxImportSize = 5 << 20
+
+	importTechnicianColumn = "technician_email"
+	importSummaryColumn    = "summary"
+)
+
+var importColumns = map[string]bool{
+	importTechnicianColumn: true,
+	importSummaryColumn:    true,
+	"date":                 true,
+	"duration_minutes":     true,
+	"window_start":         true,
+	"window_end":           true,
+	"type":                 true,
+	"priority":             true,
+	"site_id":              true,
+	"due_at":               true,
+}
+
+type taskImportQuery struct {
+	DryRun bool `form:"dry_run"`
+}
+
+type taskImportResponse struct {
+	DryRun   bool                      `json:"dry_run"`
+	Rows     int                       `json:"rows"`
+	Valid    int                       `json:"valid"`
+	Imported int                       `json:"imported"`
+	Failed   int                       `json:"failed"`
+	Errors   []taskImportErrorResponse `json:"errors,omitempty"`
+}
+
+type taskImportErrorResponse struct {
+	Line      int           `json:"line"`
+	Error     string        `json:"error"`
+	Code      string        `json:"code"`
+	Details   []errorDetail `json:"details,omitempty"`
+	Conflicts []int64       `json:"conflicting_task_ids,omitempty"`
+}
+
+// importTasks creates tasks for technicians from a CSV file, sent either as
+// the "file" field of a multipart form or as a text/csv body. With dry_run the
+// rows are only checked, so the report can be fixed before importing for real.
+func (h *TaskAPIHandler) importTasks(c *gin.Context) {
+	var query taskImportQuery
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	content, err := importContent(c)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+
+	rows, err := parseImport(content, dates)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	results, err := h.taskUsecase.Import(c.Request.Context(), rows, query.DryRun, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := taskImportResponse{DryRun: query.DryRun, Rows: len(results)}
+
+	for _, result := range results {
+		if result.Err == nil {
+			response.Valid++
+			if !query.DryRun {
+				response.Imported++
+			}
+
+			continue
+		}
+
+		_, failure := errorResponse(c, result.Err)
+
+		response.Failed++
+		response.Errors = append(response.Errors, taskImportErrorResponse{
+			Line:      result.Line,
+			Error:     failure.Error,
+			Code:      failure.Code,
+			Details:   failure.Details,
+			Conflicts: failure.Conflicts,
+		})
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func importContent(c *gin.Context) ([]byte, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	var (
+		content []byte
+		err     error
+	)
+
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if mediaType == gin.MIMEMultipartPOSTForm {
+		header, formErr := c.FormFile("file")
+		if formErr != nil {
+			return nil, importReadError(formErr)
+		}
+
+		file, openErr := header.Open()
+		if openErr != nil {
+			return nil, openErr
+		}
+		defer file.Close()
+
+		content, err = io.ReadAll(file)
+	} else {
+		content, err = io.ReadAll(c.Request.Body)
+	}
+
+	if err != nil {
+		return nil, importReadError(err)
+	}
+
+	return content, nil
+}
+
+func importReadError(err error) error {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return fmt.Errorf("%w: the file is larger than %d MiB", domain.ErrInvalidTaskImport, maxImportSize>>20)
+	}
+
+	if errors.Is(err, http.ErrMissingFile) {
+		return &fieldError{Field: "file", Message: "is required"}
+	}
+
+	return err
+}
+
+// parseImport reads the header row to find the columns, then turns every
+// other row into a TaskImportRow. Rows that cannot be parsed carry the error
+// instead of failing the whole file. Files saved by spreadsheets in locales
+// that separate fields with ";" are accepted too.
+func parseImport(content []byte, dates dateCodec) ([]domain.TaskImportRow, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	firstLine, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: the file is empty", domain.ErrInvalidTaskImport)
+		}
+
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidTaskImport, err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !importColumns[name] {
+			return nil, fmt.Errorf("%w: column %q is not supported", domain.ErrInvalidTaskImport, name)
+		}
+
+		columns[name] = i
+	}
+
+	for _, required := range []string{importTechnicianColumn, importSummaryColumn} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: column %q is required", domain.ErrInvalidTaskImport, required)
+		}
+	}
+
+	var rows []domain.TaskImportRow
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("%w: line %d: %v", domain.ErrInvalidTaskImport, parseErr.Line, parseErr.Err)
+			}
+
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		rows = append(rows, importRow(line, record, columns, dates))
+
+		if len(rows) > domain.MaxTaskImportRows {
+			return nil, fmt.Errorf("%w: at most %d rows are allowed", domain.ErrInvalidTaskImport, domain.MaxTaskImportRows)
+		}
+	}
+
+	return rows, nil
+}
+
+func importRow(line int, record []string, columns map[string]int, dates dateCodec) domain.TaskImportRow {
+	value := func(column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	row := domain.TaskImportRow{
+		Line:            line,
+		TechnicianEmail: strings.ToLower(value(importTechnicianColumn)),
+		Task: domain.Task{
+			Summary:  value(importSummaryColumn),
+			Type:     value("type"),
+			Priority: value("priority"),
+		},
+	}
+
+	if row.TechnicianEmail == "" {
+		row.Err = &fieldError{Field: importTechnicianColumn, Message: "is required"}
+		return row
+	}
+
+	var err error
+
+	if row.Task.Date, err = dates.parse(value("date")); err != nil {
+		row.Err = &fieldError{Field: "date", Message: invalidDateMessage}
+		return row
+	}
+
+	if row.Task.DueAt, err = dates.parse(value("due_at")); err != nil {
+		row.Err = &fieldError{Field: "due_at", Message: invalidDateMessage}
+		return row
+	}
+
+	if row.Task.WindowStart, row.Task.WindowEnd, err = dates.parseWindow(value("window_start"), value("window_end")); err != nil {
+		row.Err = &fieldError{Field: windowField(err, "window_start", "window_end"), Message: invalidDateMessage}
+		return row
+	}
+
+	if duration := value("duration_minutes"); duration != "" {
+		minutes, err := strconv.Atoi(duration)
+		if err != nil || minutes < 0 {
+			row.Err = &fieldError{Field: "duration_minutes", Message: "must be a positive integer"}
+			return row
+		}
+
+		row.Task.Duration = time.Duration(minutes) * time.Minute
+	}
+
+	if site := value("site_id"); site != "" {
+		siteID, err := strconv.ParseInt(site, 10, 64)
+		if err != nil || siteID < 0 {
+			row.Err = &fieldError{Field: "site_id", Message: invalidIntegerMessage}
+			return row
+		}
+
+		row.Task.SiteID = siteID
+	}
+
+	return row
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTaskAPIHandler_importTasks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		claims = map[string]any{"user_id": float64(1), "role_id": float64(1)}
+		file   = "technician_email,summary,date\ntech@example.com,Fix the pump,2023-11-15T15:04:00-03:00\nother@example.com,Replace the meter,tomorrow\n"
+		date   = time.Date(2023, 11, 15, 18, 4, 0, 0, time.UTC)
+		rows   = []domain.TaskImportRow{
+			{Line: 2, TechnicianEmail: "tech@example.com", Task: domain.Task{Summary: "Fix the pump", Date: &date}},
+			{Line: 3, TechnicianEmail: "other@example.com", Task: domain.Task{Summary: "Replace the meter"}, Err: &fieldError{Field: "date", Message: invalidDateMessage}},
+		}
+		multipartBody = func() (*bytes.Buffer, string) {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("file", "jobs.csv")
+			part.Write([]byte(file))
+			writer.Close()
+
+			return body, writer.FormDataContentType()
+		}
+	)
+
+	tests := []struct {
+		name            string
+		query           string
+		multipart       bool
+		body            string
+		setDependencies func(taskUsecase *domain.MockTaskUsecase)
+		wantStatus      int
+		wantCode        string
+		wantReport      taskImportResponse
+	}{
+		{
+			name:  "Expect dry run to report the failing rows",
+			query: "?dry_run=true",
+			body:  file,
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Import(gomock.Any(), rows, true, domain.User{ID: 1, RoleID: 1}).Return([]domain.TaskImportResult{
+					{Line: 2, Task: domain.Task{UserID: 2}},
+					{Line: 3, Err: rows[1].Err},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantReport: taskImportResponse{
+				DryRun: true,
+				Rows:   2,
+				Valid:  1,
+				Failed: 1,
+				Errors: []taskImportErrorResponse{{Line: 3, Error: badRequestMessage, Code: codeValidationFailed, Details: []errorDetail{{Field: "date", Message: invalidDateMessage}}}},
+			},
+		},
+		{
+			name:      "Expect uploaded file to be imported",
+			multipart: true,
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Import(gomock.Any(), rows, false, gomock.Any()).Return([]domain.TaskImportResult{
+					{Line: 2, Task: domain.Task{ID: 7, UserID: 2}},
+					{Line: 3, Err: domain.ErrInvalidTaskImport},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantReport: taskImportResponse{
+				Rows:     2,
+				Valid:    1,
+				Imported: 1,
+				Failed:   1,
+				Errors:   []taskImportErrorResponse{{Line: 3, Error: domain.ErrInvalidTaskImport.Error(), Code: "invalid_task_import"}},
+			},
+		},
+		{
+			name:  "Expect domain validation reasons to be reported per row",
+			query: "?dry_run=true",
+			body:  file,
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				_, invalid := domain.NewTask("", nil, 2, "", "")
+				taskUsecase.EXPECT().Import(gomock.Any(), rows, true, gomock.Any()).Return([]domain.TaskImportResult{
+					{Line: 2, Err: invalid},
+					{Line: 3, Err: rows[1].Err},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantReport: taskImportResponse{
+				DryRun: true,
+				Rows:   2,
+				Failed: 2,
+				Errors: []taskImportErrorResponse{
+					{Line: 2, Error: "task fields are invalid: summary must not be empty", Code: "invalid_task"},
+					{Line: 3, Error: badRequestMessage, Code: codeValidationFailed, Details: []errorDetail{{Field: "date", Message: invalidDateMessage}}},
+				},
+			},
+		},
+		{
+			name:       "Expect unknown columns to reject the file",
+			body:       "technician_email,summary,color\ntech@example.com,Fix the pump,red\n",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_task_import",
+		},
+		{
+			name:       "Expect file without technician column to be rejected",
+			body:       "summary\nFix the pump\n",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "invalid_task_import",
+		},
+		{
+			name: "Expect technicians to be forbidden",
+			body: file,
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Import(gomock.Any(), gomock.Any(), false, gomock.Any()).Return(nil, domain.ErrUserNotAllowed)
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   codeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+
+			taskUsecase := domain.NewMockTaskUsecase(ctrl)
+			if tt.setDependencies != nil {
+				tt.setDependencies(taskUsecase)
+			}
+
+			r := gin.New()
+
+			h, err := NewTask(r, authenticator, taskUsecase)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.CreateRouter()
+
+			var req *http.Request
+			if tt.multipart {
+				body, contentType := multipartBody()
+				req = httptest.NewRequest(http.MethodPost, "/v1/tasks/import"+tt.query, body)
+				req.Header.Set("Content-Type", contentType)
+			} else {
+				req = httptest.NewRequest(http.MethodPost, "/v1/tasks/import"+tt.query, strings.NewReader(tt.body))
+				req.Header.Set("Content-Type", "text/csv")
+			}
+			req.Header.Set("Authorization", "Bearer token")
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+
+			var response struct {
+				Code   string             `json:"code"`
+				Result taskImportResponse `json:"result"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, tt.wantCode, response.Code)
+			assert.Equal(t, tt.wantReport, response.Result)
+		})
+	}
+}
+
+func Test_parseImport(t *testing.T) {
+	content := "\xef\xbb\xbfTechnician_Email;Summary;Duration_Minutes;Site_ID\nTech@Example.com;\"Fix the pump; then test\";90;4\n\nother@example.com;Replace;-5;\n"
+
+	rows, err := parseImport([]byte(content), dateCodec{location: time.UTC})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []domain.TaskImportRow{
+		{Line: 2, TechnicianEmail: "tech@example.com", Task: domain.Task{Summary: "Fix the pump; then test", Duration: 90 * time.Minute, SiteID: 4}},
+		{Line: 4, TechnicianEmail: "other@example.com", Task: domain.Task{Summary: "Replace"}, Err: &fieldError{Field: "duration_minutes", Message: "must be a positive integer"}},
+	}, rows)
+
+	_, err = parseImport([]byte(""), dateCodec{location: time.UTC})
+	assert.ErrorIs(t, err, domain.ErrInvalidTaskImport)
+}
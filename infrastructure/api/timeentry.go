@@ -0,0 +1,261 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type timerRequest struct {
+	Note string `json:"note"`
+}
+
+type timeEntryRequest struct {
+	Start string `json:"start" binding:"required"`
+	End   string `json:"end" binding:"required"`
+	Note  string `json:"note"`
+}
+
+type timeEntryResponse struct {
+	ID              int64  `json:"id"`
+	TaskID          int64  `json:"task_id"`
+	UserID          int64  `json:"user_id"`
+	Start           string `json:"start"`
+	End             string `json:"end,omitempty"`
+	DurationMinutes int64  `json:"duration_minutes"`
+	Note            string `json:"note,omitempty"`
+	ApprovedBy      int64  `json:"approved_by,omitempty"`
+	ApprovedAt      string `json:"approved_at,omitempty"`
+}
+
+type timeSummaryResponse struct {
+	TaskID          int64 `json:"task_id,omitempty"`
+	UserID          int64 `json:"user_id,omitempty"`
+	TotalMinutes    int64 `json:"total_minutes"`
+	ApprovedMinutes int64 `json:"approved_minutes"`
+}
+
+type TimeEntryAPIHandler struct {
+	router           *gin.Engine
+	authenticator    domain.Authenticator
+	timeEntryUsecase domain.TimeEntryUsecase
+}
+
+func NewTimeEntry(r *gin.Engine, authenticator domain.Authenticator, timeEntryUsecase domain.TimeEntryUsecase) (*TimeEntryAPIHandler, error) {
+	if r == nil {
+		return &TimeEntryAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &TimeEntryAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if timeEntryUsecase == nil {
+		return &TimeEntryAPIHandler{}, errors.New("timeEntryUsecase must not be nil")
+	}
+
+	return &TimeEntryAPIHandler{
+		router:           r,
+		authenticator:    authenticator,
+		timeEntryUsecase: timeEntryUsecase,
+	}, nil
+}
+
+func (h *TimeEntryAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/time-entries", h.get)
+	tasks.POST("/:id/time-entries", h.post)
+	tasks.POST("/:id/time-entries/start", h.start)
+	tasks.POST("/:id/time-entries/stop", h.stop)
+
+	entries := v1.Group("time-entries")
+	entries.Use(Authenticator(h.authenticator))
+
+	entries.GET("/summary", h.summary)
+	entries.POST("/:id/approve", h.approve)
+}
+
+func (h *TimeEntryAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.timeEntryUsecase.ListByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []timeEntryResponse
+
+	dates := requestDates(c)
+
+	for _, e := range result {
+		response = append(response, formatTimeEntry(e, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *TimeEntryAPIHandler) post(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request timeEntryRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+
+	start, end, err := dates.parseWindow(request.Start, request.End)
+	if err != nil {
+		respondInvalidField(c, windowField(err, "start", "end"), invalidDateMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	entry, err := domain.NewTimeEntry(taskID, user.ID, start, end, request.Note)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.timeEntryUsecase.Add(c.Request.Context(), entry, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatTimeEntry(result, dates)))
+}
+
+func (h *TimeEntryAPIHandler) start(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request timerRequest
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+			respondBadRequest(c, err)
+			return
+		}
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.timeEntryUsecase.Start(c.Request.Context(), taskID, request.Note, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toResponse(true, formatTimeEntry(result, requestDates(c))))
+}
+
+func (h *TimeEntryAPIHandler) stop(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.timeEntryUsecase.Stop(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatTimeEntry(result, requestDates(c))))
+}
+
+func (h *TimeEntryAPIHandler) approve(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.timeEntryUsecase.Approve(c.Request.Context(), id, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatTimeEntry(result, requestDates(c))))
+}
+
+func (h *TimeEntryAPIHandler) summary(c *gin.Context) {
+	from, to, err := requestDates(c).parseWindow(c.Query("from"), c.Query("to"))
+	if err != nil {
+		respondInvalidField(c, windowField(err, "from", "to"), invalidDateMessage)
+		return
+	}
+
+	filter, err := domain.NewTimeSummaryFilter(from, to, c.Query("group_by"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.timeEntryUsecase.Summarize(c.Request.Context(), filter, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []timeSummaryResponse
+
+	for _, s := range result {
+		response = append(response, timeSummaryResponse{
+			TaskID:          s.TaskID,
+			UserID:          s.UserID,
+			TotalMinutes:    int64(s.Total / time.Minute),
+			ApprovedMinutes: int64(s.Approved / time.Minute),
+		})
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func formatTimeEntry(entry domain.TimeEntry, dates dateCodec) timeEntryResponse {
+	return timeEntryResponse{
+		ID:              entry.ID,
+		TaskID:          entry.TaskID,
+		UserID:          entry.UserID,
+		Start:           dates.format(&entry.Start),
+		End:             dates.format(entry.End),
+		DurationMinutes: int64(entry.Duration() / time.Minute),
+		Note:            entry.Note,
+		ApprovedBy:      entry.ApprovedBy,
+		ApprovedAt:      dates.format(entry.ApprovedAt),
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewTimeEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	timeEntryUsecase := domain.NewMockTimeEntryUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r                *gin.Engine
+		authenticator    domain.Authenticator
+		timeEntryUsecase domain.TimeEntryUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *TimeEntryAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:                nil,
+				authenticator:    authenticator,
+				timeEntryUsecase: timeEntryUsecase,
+			},
+			want:    &TimeEntryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:                r,
+				authenticator:    nil,
+				timeEntryUsecase: timeEntryUsecase,
+			},
+			want:    &TimeEntryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without timeEntryUsecase",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				timeEntryUsecase: nil,
+			},
+			want:    &TimeEntryAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:                r,
+				authenticator:    authenticator,
+				timeEntryUsecase: timeEntryUsecase,
+			},
+			want: &TimeEntryAPIHandler{
+				router:           r,
+				authenticator:    authenticator,
+				timeEntryUsecase: timeEntryUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTimeEntry(tt.args.r, tt.args.authenticator, tt.args.timeEntryUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTimeEntry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+)
+
+type userTimezoneRequest struct {
+	Timezone string `json:"timezone" binding:"required,max=64"`
+}
+
+type userTimezoneResponse struct {
+	UserID   int64  `json:"user_id"`
+	Timezone string `json:"timezone"`
+	Token    string `json:"token"`
+}
+
+type UserAPIHandler struct {
+	router        *gin.Engine
+	authenticator domain.Authenticator
+	userUsecase   domain.UserUsecase
+}
+
+func NewUser(r *gin.Engine, authenticator domain.Authenticator, userUsecase domain.UserUsecase) (*UserAPIHandler, error) {
+	if r == nil {
+		return &UserAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &UserAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if userUsecase == nil {
+		return &UserAPIHandler{}, errors.New("userUsecase must not be nil")
+	}
+
+	return &UserAPIHandler{
+		router:        r,
+		authenticator: authenticator,
+		userUsecase:   userUsecase,
+	}, nil
+}
+
+func (h *UserAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	users := v1.Group("users")
+	users.Use(Authenticator(h.authenticator))
+
+	users.PUT("/me/timezone", h.putTimezone)
+}
+
+func (h *UserAPIHandler) putTimezone(c *gin.Context) {
+	var request userTimezoneRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, token, err := h.userUsecase.UpdateTimezone(c.Request.Context(), user, request.Timezone)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, userTimezoneResponse{
+		UserID:   result.ID,
+		Timezone: result.Timezone,
+		Token:    token,
+	}))
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	userUsecase := domain.NewMockUserUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r             *gin.Engine
+		authenticator domain.Authenticator
+		userUsecase   domain.UserUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *UserAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:             nil,
+				authenticator: authenticator,
+				userUsecase:   userUsecase,
+			},
+			want:    &UserAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:             r,
+				authenticator: nil,
+				userUsecase:   userUsecase,
+			},
+			want:    &UserAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without userUsecase",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				userUsecase:   nil,
+			},
+			want:    &UserAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				userUsecase:   userUsecase,
+			},
+			want: &UserAPIHandler{
+				router:        r,
+				authenticator: authenticator,
+				userUsecase:   userUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewUser(tt.args.r, tt.args.authenticator, tt.args.userUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"net/http"
+	"strconv"
+)
+
+type checkpointRequest struct {
+	Latitude   *float64 `json:"latitude" binding:"required"`
+	Longitude  *float64 `json:"longitude" binding:"required"`
+	DeviceTime string   `json:"device_time" binding:"required"`
+}
+
+type checkpointResponse struct {
+	At             string   `json:"at"`
+	DeviceTime     string   `json:"device_time"`
+	Latitude       float64  `json:"latitude"`
+	Longitude      float64  `json:"longitude"`
+	DistanceMeters *float64 `json:"distance_meters,omitempty"`
+	OutOfRange     bool     `json:"out_of_range"`
+}
+
+type visitResponse struct {
+	ID       int64               `json:"id"`
+	TaskID   int64               `json:"task_id"`
+	UserID   int64               `json:"user_id"`
+	CheckIn  checkpointResponse  `json:"check_in"`
+	CheckOut *checkpointResponse `json:"check_out,omitempty"`
+}
+
+type VisitAPIHandler struct {
+	router        *gin.Engine
+	authenticator domain.Authenticator
+	visitUsecase  domain.VisitUsecase
+}
+
+func NewVisit(r *gin.Engine, authenticator domain.Authenticator, visitUsecase domain.VisitUsecase) (*VisitAPIHandler, error) {
+	if r == nil {
+		return &VisitAPIHandler{}, errors.New("router must not be nil")
+	}
+
+	if authenticator == nil {
+		return &VisitAPIHandler{}, errors.New("authenticator must not be nil")
+	}
+
+	if visitUsecase == nil {
+		return &VisitAPIHandler{}, errors.New("visitUsecase must not be nil")
+	}
+
+	return &VisitAPIHandler{
+		router:        r,
+		authenticator: authenticator,
+		visitUsecase:  visitUsecase,
+	}, nil
+}
+
+func (h *VisitAPIHandler) CreateRouter() {
+	v1 := h.router.Group("v1")
+	tasks := v1.Group("tasks")
+	tasks.Use(Authenticator(h.authenticator))
+
+	tasks.GET("/:id/visits", h.get)
+	tasks.POST("/:id/check-in", h.checkIn)
+	tasks.POST("/:id/check-out", h.checkOut)
+}
+
+func (h *VisitAPIHandler) get(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := h.visitUsecase.ListByTask(c.Request.Context(), taskID, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response []visitResponse
+
+	dates := requestDates(c)
+
+	for _, v := range result {
+		response = append(response, formatVisit(v, dates))
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, response))
+}
+
+func (h *VisitAPIHandler) checkIn(c *gin.Context) {
+	h.handleCheckpoint(c, h.visitUsecase.CheckIn)
+}
+
+func (h *VisitAPIHandler) checkOut(c *gin.Context) {
+	h.handleCheckpoint(c, h.visitUsecase.CheckOut)
+}
+
+func (h *VisitAPIHandler) handleCheckpoint(c *gin.Context, action func(ctx context.Context, taskID int64, checkpoint domain.Checkpoint, user domain.User) (domain.Visit, error)) {
+	taskID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		respondInvalidField(c, "id", invalidIntegerMessage)
+		return
+	}
+
+	var request checkpointRequest
+
+	if err := c.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		respondBadRequest(c, err)
+		return
+	}
+
+	dates := requestDates(c)
+
+	deviceTime, err := dates.parse(request.DeviceTime)
+	if err != nil {
+		respondInvalidField(c, "device_time", invalidDateMessage)
+		return
+	}
+
+	checkpoint, err := domain.NewCheckpoint(toCoordinates(request.Latitude, request.Longitude), deviceTime)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	user := identifyUserRequester(c)
+
+	result, err := action(c.Request.Context(), taskID, checkpoint, user)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toResponse(true, formatVisit(result, dates)))
+}
+
+func formatCheckpoint(checkpoint domain.Checkpoint, dates dateCodec) checkpointResponse {
+	return checkpointResponse{
+		At:             dates.format(&checkpoint.At),
+		DeviceTime:     dates.format(&checkpoint.DeviceTime),
+		Latitude:       checkpoint.Coordinates.Latitude,
+		Longitude:      checkpoint.Coordinates.Longitude,
+		DistanceMeters: checkpoint.Distance,
+		OutOfRange:     checkpoint.OutOfRange,
+	}
+}
+
+func formatVisit(visit domain.Visit, dates dateCodec) visitResponse {
+	response := visitResponse{
+		ID:      visit.ID,
+		TaskID:  visit.TaskID,
+		UserID:  visit.UserID,
+		CheckIn: formatCheckpoint(visit.CheckIn, dates),
+	}
+
+	if visit.CheckOut != nil {
+		checkOut := formatCheckpoint(*visit.CheckOut, dates)
+		response.CheckOut = &checkOut
+	}
+
+	return response
+}
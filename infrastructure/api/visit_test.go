@@ -0,0 +1,84 @@
+package api
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewVisit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	visitUsecase := domain.NewMockVisitUsecase(ctrl)
+	r := gin.Default()
+
+	type args struct {
+		r             *gin.Engine
+		authenticator domain.Authenticator
+		visitUsecase  domain.VisitUsecase
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *VisitAPIHandler
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without router",
+			args: args{
+				r:             nil,
+				authenticator: authenticator,
+				visitUsecase:  visitUsecase,
+			},
+			want:    &VisitAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without authenticator",
+			args: args{
+				r:             r,
+				authenticator: nil,
+				visitUsecase:  visitUsecase,
+			},
+			want:    &VisitAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without visitUsecase",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				visitUsecase:  nil,
+			},
+			want:    &VisitAPIHandler{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				r:             r,
+				authenticator: authenticator,
+				visitUsecase:  visitUsecase,
+			},
+			want: &VisitAPIHandler{
+				router:        r,
+				authenticator: authenticator,
+				visitUsecase:  visitUsecase,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewVisit(tt.args.r, tt.args.authenticator, tt.args.visitUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVisit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
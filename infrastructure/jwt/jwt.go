@@ -19,13 +19,17 @@ func New(key string) (domain.Authenticator, error) {
 }
 
 func (a *authenticator) GenerateAccessToken(user domain.User) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"user_id": user.ID,
-			"email":   user.Email,
-			"role_id": user.RoleID,
-		},
-	)
+	claims := jwt.MapClaims{
+		"user_id": user.ID,
+		"email":   user.Email,
+		"role_id": user.RoleID,
+	}
+
+	if user.Timezone != "" {
+		claims["timezone"] = user.Timezone
+	}
+
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	token, err := t.SignedString([]byte(a.key))
 	if err != nil {
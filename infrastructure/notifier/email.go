@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+type Email struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+
+	// rootCAs verifies the server certificate on STARTTLS; nil uses the
+	// system pool.
+	rootCAs *x509.CertPool
+}
+
+func NewEmail(host, port, username, password, from string) (*Email, error) {
+	if host == "" {
+		return &Email{}, errors.New("host must not be empty")
+	}
+
+	if port == "" {
+		return &Email{}, errors.New("port must not be empty")
+	}
+
+	if from == "" {
+		return &Email{}, errors.New("from must not be empty")
+	}
+
+	return &Email{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}, nil
+}
+
+func (e *Email) Send(ctx context.Context, to string, message domain.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(e.host, e.port))
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: e.host, RootCAs: e.rootCAs}); err != nil {
+			return err
+		}
+	}
+
+	if e.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", e.username, e.password, e.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(e.from); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(e.build(to, message)); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	log.Printf("email sent to: %s\n", to)
+	return client.Quit()
+}
+
+func (e *Email) build(to string, message domain.Message) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", e.from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(message.Body)
+	b.WriteString("\r\n")
+
+	return b.Bytes()
+}
@@ -0,0 +1,203 @@
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+func TestNewEmail(t *testing.T) {
+	type args struct {
+		host     string
+		port     string
+		username string
+		password string
+		from     string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *Email
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without host",
+			args: args{
+				host: "",
+				port: "1025",
+				from: "noreply@example.com",
+			},
+			want:    &Email{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without port",
+			args: args{
+				host: "localhost",
+				port: "",
+				from: "noreply@example.com",
+			},
+			want:    &Email{},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without from",
+			args: args{
+				host: "localhost",
+				port: "1025",
+				from: "",
+			},
+			want:    &Email{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				host:     "localhost",
+				port:     "1025",
+				username: "user",
+				password: "pass",
+				from:     "noreply@example.com",
+			},
+			want: &Email{
+				host:     "localhost",
+				port:     "1025",
+				username: "user",
+				password: "pass",
+				from:     "noreply@example.com",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEmail(tt.args.host, tt.args.port, tt.args.username, tt.args.password, tt.args.from)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEmail_Send(t *testing.T) {
+	// httptest issues a certificate valid for 127.0.0.1.
+	issuer := httptest.NewUnstartedServer(nil)
+	issuer.StartTLS()
+	certificate := issuer.TLS.Certificates[0]
+	trusted := x509.NewCertPool()
+	trusted.AddCert(issuer.Certificate())
+	issuer.Close()
+
+	tests := []struct {
+		name     string
+		rootCAs  *x509.CertPool
+		wantErr  bool
+		wantSent bool
+	}{
+		{
+			name:     "Expect email to be sent after STARTTLS",
+			rootCAs:  trusted,
+			wantSent: true,
+		},
+		{
+			name:    "Expect error when the server certificate is not trusted",
+			rootCAs: x509.NewCertPool(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer listener.Close()
+
+			sent := make(chan string, 1)
+			go serveSMTP(listener, &tls.Config{Certificates: []tls.Certificate{certificate}}, sent)
+
+			host, port, _ := net.SplitHostPort(listener.Addr().String())
+
+			e, err := NewEmail(host, port, "", "", "noreply@example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+			e.rootCAs = tt.rootCAs
+
+			err = e.Send(context.Background(), "tech@example.com", domain.Message{Subject: "Task assigned", Body: "Fix the pump"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantSent {
+				assert.Contains(t, <-sent, "Fix the pump")
+			}
+		})
+	}
+}
+
+// serveSMTP answers one session that requires STARTTLS before the mail is
+// accepted, and sends the received data on sent.
+func serveSMTP(listener net.Listener, config *tls.Config, sent chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	_ = text.PrintfLine("220 localhost ESMTP")
+
+	secure := false
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.Fields(line + " ")[0]); {
+		case command == "EHLO" && !secure:
+			_ = text.PrintfLine("250-localhost")
+			_ = text.PrintfLine("250 STARTTLS")
+		case command == "EHLO":
+			_ = text.PrintfLine("250 localhost")
+		case command == "STARTTLS":
+			_ = text.PrintfLine("220 ready to start TLS")
+
+			tlsConn := tls.Server(conn, config)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+
+			text = textproto.NewConn(tlsConn)
+			secure = true
+		case !secure:
+			_ = text.PrintfLine("530 must issue STARTTLS first")
+		case command == "DATA":
+			_ = text.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			sent <- string(data)
+
+			_ = text.PrintfLine("250 ok")
+		case command == "QUIT":
+			_ = text.PrintfLine("221 bye")
+			return
+		default:
+			_ = text.PrintfLine("250 ok")
+		}
+	}
+}
@@ -3,6 +3,7 @@ package notifier
 import (
 	"context"
 	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"log"
 	"time"
@@ -37,7 +38,7 @@ func (n *Notifier) SendNotification(ctx context.Context, body string) error {
 		n.queueName,
 		false,
 		false,
-		amqp.Publishing{ContentType: "text/plain", Body: []byte(body)},
+		publishing(ctx, body),
 	)
 	if err != nil {
 		return err
@@ -46,3 +47,17 @@ func (n *Notifier) SendNotification(ctx context.Context, body string) error {
 	log.Printf("message sent: %s\n", body)
 	return nil
 }
+
+func publishing(ctx context.Context, body string) amqp.Publishing {
+	message := amqp.Publishing{ContentType: "text/plain", Body: []byte(body)}
+
+	if id := domain.RequestIDFrom(ctx); id != "" {
+		message.CorrelationId = id
+	}
+
+	if traceParent := domain.TraceParentFrom(ctx); traceParent != "" {
+		message.Headers = amqp.Table{"traceparent": traceParent}
+	}
+
+	return message
+}
@@ -1,6 +1,8 @@
 package notifier
 
 import (
+	"context"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -63,3 +65,18 @@ func TestNewNotifier(t *testing.T) {
 		})
 	}
 }
+
+func Test_publishing(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	ctx := domain.WithTraceParent(domain.WithRequestID(context.Background(), "req-1"), traceParent)
+
+	got := publishing(ctx, "hello")
+	assert.Equal(t, "req-1", got.CorrelationId)
+	assert.Equal(t, amqp.Table{"traceparent": traceParent}, got.Headers)
+	assert.Equal(t, []byte("hello"), got.Body)
+
+	got = publishing(context.Background(), "hello")
+	assert.Empty(t, got.CorrelationId)
+	assert.Nil(t, got.Headers)
+}
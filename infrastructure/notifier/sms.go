@@ -0,0 +1,98 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"log"
+	"net/http"
+	"time"
+)
+
+type SMSProvider interface {
+	SendSMS(ctx context.Context, phone, text string) error
+}
+
+type SMS struct {
+	provider SMSProvider
+}
+
+func NewSMS(provider SMSProvider) (*SMS, error) {
+	if provider == nil {
+		return &SMS{}, errors.New("provider must not be nil")
+	}
+
+	return &SMS{provider}, nil
+}
+
+func (s *SMS) Send(ctx context.Context, to string, message domain.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := s.provider.SendSMS(ctx, to, message.Body); err != nil {
+		return err
+	}
+
+	log.Printf("sms sent to: %s\n", to)
+	return nil
+}
+
+type HTTPSMSProvider struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+type httpSMSRequest struct {
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+func NewHTTPSMSProvider(url, token string, client *http.Client) (*HTTPSMSProvider, error) {
+	if url == "" {
+		return &HTTPSMSProvider{}, errors.New("url must not be empty")
+	}
+
+	if client == nil {
+		return &HTTPSMSProvider{}, errors.New("client must not be nil")
+	}
+
+	return &HTTPSMSProvider{
+		url:    url,
+		token:  token,
+		client: client,
+	}, nil
+}
+
+func (p *HTTPSMSProvider) SendSMS(ctx context.Context, phone, text string) error {
+	body, err := json.Marshal(httpSMSRequest{To: phone, Text: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sms provider responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewSMS(t *testing.T) {
+	provider := &HTTPSMSProvider{}
+
+	type args struct {
+		provider SMSProvider
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SMS
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without provider",
+			args: args{
+				provider: nil,
+			},
+			want:    &SMS{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				provider: provider,
+			},
+			want:    &SMS{provider: provider},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSMS(tt.args.provider)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSMS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHTTPSMSProvider_SendSMS(t *testing.T) {
+	var received httpSMSRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if received.To == "" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	provider, err := NewHTTPSMSProvider(server.URL, "token", server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		phone   string
+		wantErr bool
+	}{
+		{
+			name:    "Expect error when provider rejects the message",
+			phone:   "",
+			wantErr: true,
+		},
+		{
+			name:    "Expect success",
+			phone:   "+5511999999999",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := provider.SendSMS(context.Background(), tt.phone, "hello")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SendSMS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, httpSMSRequest{To: tt.phone, Text: "hello"}, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package notifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"strings"
+	"text/template"
+)
+
+type messageTemplate struct {
+	subject string
+	body    string
+}
+
+var defaultTemplates = map[string]map[string]messageTemplate{
+	"en": {
+		domain.EventTaskAssigned: {
+			subject: "New task #{{.task_id}} assigned",
+			body:    "You have been assigned task #{{.task_id}} scheduled for {{.date}}.",
+		},
+		domain.EventTaskRescheduled: {
+			subject: "Task #{{.task_id}} rescheduled",
+			body:    "Task #{{.task_id}} has been rescheduled to {{.date}}.",
+		},
+		domain.EventTaskReminder: {
+			subject: "Reminder: task #{{.task_id}} at {{.date}}",
+			body:    "This is a reminder that task #{{.task_id}} is scheduled for {{.date}}.",
+		},
+	},
+	"pt-BR": {
+		domain.EventTaskAssigned: {
+			subject: "Nova tarefa #{{.task_id}} atribuída",
+			body:    "A tarefa #{{.task_id}} foi atribuída a você para {{.date}}.",
+		},
+		domain.EventTaskRescheduled: {
+			subject: "Tarefa #{{.task_id}} reagendada",
+			body:    "A tarefa #{{.task_id}} foi reagendada para {{.date}}.",
+		},
+		domain.EventTaskReminder: {
+			subject: "Lembrete: tarefa #{{.task_id}} em {{.date}}",
+			body:    "Este é um lembrete de que a tarefa #{{.task_id}} está agendada para {{.date}}.",
+		},
+	},
+}
+
+type Templater struct {
+	templates map[string]map[string]*template.Template
+}
+
+func NewTemplater() (*Templater, error) {
+	return newTemplater(defaultTemplates)
+}
+
+func newTemplater(source map[string]map[string]messageTemplate) (*Templater, error) {
+	if len(source) == 0 {
+		return &Templater{}, errors.New("templates must not be empty")
+	}
+
+	templates := make(map[string]map[string]*template.Template)
+
+	for locale, events := range source {
+		templates[locale] = make(map[string]*template.Template)
+
+		for event, t := range events {
+			name := fmt.Sprintf("%s/%s", locale, event)
+
+			parsed, err := template.New(name).
+				Option("missingkey=zero").
+				Parse(fmt.Sprintf(`{{define "subject"}}%s{{end}}{{define "body"}}%s{{end}}`, t.subject, t.body))
+			if err != nil {
+				return &Templater{}, err
+			}
+
+			templates[locale][event] = parsed
+		}
+	}
+
+	return &Templater{templates}, nil
+}
+
+func (t *Templater) Render(event, locale string, data map[string]string) (domain.Message, error) {
+	tmpl, ok := t.lookup(event, locale)
+	if !ok {
+		return domain.Message{}, fmt.Errorf("%v: %s", domain.ErrNotificationTemplateNotFound, event)
+	}
+
+	var subject, body bytes.Buffer
+
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return domain.Message{}, err
+	}
+
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return domain.Message{}, err
+	}
+
+	return domain.Message{Subject: subject.String(), Body: body.String()}, nil
+}
+
+func (t *Templater) lookup(event, locale string) (*template.Template, bool) {
+	candidates := []string{locale}
+
+	if i := strings.Index(locale, "-"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+
+	candidates = append(candidates, domain.DefaultLocale)
+
+	for _, c := range candidates {
+		if tmpl, ok := t.templates[c][event]; ok {
+			return tmpl, true
+		}
+	}
+
+	return nil, false
+}
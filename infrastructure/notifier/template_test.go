@@ -0,0 +1,99 @@
+package notifier
+
+import (
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTemplater_Render(t *testing.T) {
+	templater, err := NewTemplater()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := map[string]string{
+		"task_id": "7",
+		"date":    "11/15/2023 18:00",
+	}
+
+	type args struct {
+		event  string
+		locale string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    domain.Message
+		wantErr bool
+	}{
+		{
+			name: "Expect error when event has no template",
+			args: args{
+				event:  "task.unknown",
+				locale: domain.DefaultLocale,
+			},
+			want:    domain.Message{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success for default locale",
+			args: args{
+				event:  domain.EventTaskAssigned,
+				locale: domain.DefaultLocale,
+			},
+			want: domain.Message{
+				Subject: "New task #7 assigned",
+				Body:    "You have been assigned task #7 scheduled for 11/15/2023 18:00.",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect success for localized template",
+			args: args{
+				event:  domain.EventTaskRescheduled,
+				locale: "pt-BR",
+			},
+			want: domain.Message{
+				Subject: "Tarefa #7 reagendada",
+				Body:    "A tarefa #7 foi reagendada para 11/15/2023 18:00.",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect fallback to language when region is unknown",
+			args: args{
+				event:  domain.EventTaskRescheduled,
+				locale: "en-GB",
+			},
+			want: domain.Message{
+				Subject: "Task #7 rescheduled",
+				Body:    "Task #7 has been rescheduled to 11/15/2023 18:00.",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Expect fallback to default locale when locale is unknown",
+			args: args{
+				event:  domain.EventTaskAssigned,
+				locale: "fr",
+			},
+			want: domain.Message{
+				Subject: "New task #7 assigned",
+				Body:    "You have been assigned task #7 scheduled for 11/15/2023 18:00.",
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := templater.Render(tt.args.event, tt.args.locale, data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
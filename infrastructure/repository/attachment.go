@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const attachmentColumns = `id, task_id, user_id, name, content_type, size, storage_key, created_at`
+
+type AttachmentRepository struct {
+	db *sqlx.DB
+}
+
+func NewAttachment(db *sqlx.DB) (*AttachmentRepository, error) {
+	if db == nil {
+		return &AttachmentRepository{}, errors.New("db must not be nil")
+	}
+
+	return &AttachmentRepository{db}, nil
+}
+
+func (r *AttachmentRepository) Add(ctx context.Context, attachment domain.Attachment) (int64, error) {
+	var id int64
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO task_attachments (task_id, user_id, name, content_type, size, storage_key, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		attachment.TaskID, attachment.UserID, attachment.Name, attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.CreatedAt.UTC())
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *AttachmentRepository) ListByTaskID(ctx context.Context, taskID int64) ([]domain.Attachment, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+attachmentColumns+` FROM task_attachments WHERE task_id=? AND deleted=FALSE ORDER BY created_at, id`, taskID)
+	if err != nil {
+		return []domain.Attachment{}, err
+	}
+
+	var result []domain.Attachment
+
+	defer rows.Close()
+
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return []domain.Attachment{}, err
+		}
+
+		result = append(result, attachment)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *AttachmentRepository) ListByID(ctx context.Context, id int64) (domain.Attachment, error) {
+	result, err := scanAttachment(r.db.QueryRowContext(ctx, `SELECT `+attachmentColumns+` FROM task_attachments WHERE id=? AND deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrAttachmentNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *AttachmentRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE task_attachments SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanAttachment(row rowScanner) (domain.Attachment, error) {
+	var attachment domain.Attachment
+
+	err := row.Scan(&attachment.ID, &attachment.TaskID, &attachment.UserID, &attachment.Name, &attachment.ContentType, &attachment.Size, &attachment.StorageKey, &attachment.CreatedAt)
+	if err != nil {
+		return domain.Attachment{}, err
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type CalendarTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewCalendarToken(db *sqlx.DB) (*CalendarTokenRepository, error) {
+	if db == nil {
+		return &CalendarTokenRepository{}, errors.New("db must not be nil")
+	}
+
+	return &CalendarTokenRepository{db}, nil
+}
+
+func (r *CalendarTokenRepository) Add(ctx context.Context, token domain.CalendarToken) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO calendar_tokens (user_id, token_hash, created_at) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE token_hash=VALUES(token_hash), created_at=VALUES(created_at)`,
+		token.UserID, token.Hash, token.CreatedAt.UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CalendarTokenRepository) ListByHash(ctx context.Context, hash string) (domain.CalendarToken, error) {
+	var result domain.CalendarToken
+
+	err := r.db.QueryRowContext(ctx, `SELECT user_id, token_hash, created_at FROM calendar_tokens WHERE token_hash=?`, hash).
+		Scan(&result.UserID, &result.Hash, &result.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.CalendarToken{}, domain.ErrCalendarFeedNotFound
+		}
+
+		return domain.CalendarToken{}, err
+	}
+
+	return result, nil
+}
+
+func (r *CalendarTokenRepository) Remove(ctx context.Context, userID int64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM calendar_tokens WHERE user_id=?`, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
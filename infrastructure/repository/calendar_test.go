@@ -0,0 +1,101 @@
+//go:build unit
+
+package repository
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewCalendarToken(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	type args struct {
+		db *sqlx.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *CalendarTokenRepository
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without db",
+			args: args{
+				db: nil,
+			},
+			want:    &CalendarTokenRepository{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				db: sqlxDB,
+			},
+			want:    &CalendarTokenRepository{db: sqlxDB},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCalendarToken(tt.args.db)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCalendarToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalendarTokenRepository_ListByHash(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &CalendarTokenRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+	createdAt := time.Date(2023, 12, 7, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    domain.CalendarToken
+		wantErr error
+	}{
+		{
+			name: "Expect token to be found by its hash",
+			rows: sqlmock.NewRows([]string{"user_id", "token_hash", "created_at"}).AddRow(2, "abc", createdAt),
+			want: domain.CalendarToken{UserID: 2, Hash: "abc", CreatedAt: createdAt},
+		},
+		{
+			name:    "Expect unknown token to have no feed",
+			rows:    sqlmock.NewRows([]string{"user_id", "token_hash", "created_at"}),
+			wantErr: domain.ErrCalendarFeedNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectQuery(`SELECT user_id, token_hash, created_at FROM calendar_tokens WHERE token_hash=\?`).WithArgs("abc").WillReturnRows(tt.rows)
+
+			got, err := r.ListByHash(context.Background(), "abc")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
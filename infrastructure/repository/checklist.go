@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type ChecklistTemplateRepository struct {
+	db *sqlx.DB
+}
+
+func NewChecklistTemplate(db *sqlx.DB) (*ChecklistTemplateRepository, error) {
+	if db == nil {
+		return &ChecklistTemplateRepository{}, errors.New("db must not be nil")
+	}
+
+	return &ChecklistTemplateRepository{db}, nil
+}
+
+func (r *ChecklistTemplateRepository) Add(ctx context.Context, template domain.ChecklistTemplate) (int64, error) {
+	var id int64
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return id, err
+	}
+	defer tx.Rollback()
+
+	record, err := tx.ExecContext(ctx, `INSERT INTO checklist_templates (name) VALUES (?)`, template.Name)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	if err := addTemplateItems(ctx, tx, id, template.Items); err != nil {
+		return id, err
+	}
+
+	return id, tx.Commit()
+}
+
+func (r *ChecklistTemplateRepository) List(ctx context.Context) ([]domain.ChecklistTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT t.id, t.name, i.label, i.required FROM checklist_templates t JOIN checklist_template_items i ON i.template_id = t.id WHERE t.deleted=FALSE ORDER BY t.name, t.id, i.position`)
+	if err != nil {
+		return []domain.ChecklistTemplate{}, err
+	}
+
+	var result []domain.ChecklistTemplate
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			template domain.ChecklistTemplate
+			item     domain.ChecklistTemplateItem
+		)
+
+		if err := rows.Scan(&template.ID, &template.Name, &item.Label, &item.Required); err != nil {
+			return []domain.ChecklistTemplate{}, err
+		}
+
+		if len(result) == 0 || result[len(result)-1].ID != template.ID {
+			result = append(result, template)
+		}
+
+		last := &result[len(result)-1]
+		last.Items = append(last.Items, item)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ChecklistTemplateRepository) ListByID(ctx context.Context, id int64) (domain.ChecklistTemplate, error) {
+	var template domain.ChecklistTemplate
+
+	err := r.db.QueryRowContext(ctx, `SELECT id, name FROM checklist_templates WHERE id=? AND deleted=FALSE`, id).Scan(&template.ID, &template.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ChecklistTemplate{}, domain.ErrChecklistTemplateNotFound
+		}
+
+		return domain.ChecklistTemplate{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT label, required FROM checklist_template_items WHERE template_id=? ORDER BY position`, id)
+	if err != nil {
+		return domain.ChecklistTemplate{}, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var item domain.ChecklistTemplateItem
+
+		if err := rows.Scan(&item.Label, &item.Required); err != nil {
+			return domain.ChecklistTemplate{}, err
+		}
+
+		template.Items = append(template.Items, item)
+	}
+
+	return template, rows.Err()
+}
+
+func (r *ChecklistTemplateRepository) Update(ctx context.Context, template domain.ChecklistTemplate) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE checklist_templates SET name=? WHERE id=? AND deleted=FALSE`, template.Name, template.ID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM checklist_template_items WHERE template_id=?`, template.ID); err != nil {
+		return err
+	}
+
+	if err := addTemplateItems(ctx, tx, template.ID, template.Items); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ChecklistTemplateRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE checklist_templates SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addTemplateItems(ctx context.Context, tx *sqlx.Tx, templateID int64, items []domain.ChecklistTemplateItem) error {
+	for i, item := range items {
+		_, err := tx.ExecContext(ctx, `INSERT INTO checklist_template_items (template_id, position, label, required) VALUES (?, ?, ?, ?)`,
+			templateID, i+1, item.Label, item.Required)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+//go:build unit
+
+package repository
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewChecklistTemplate(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	type args struct {
+		db *sqlx.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ChecklistTemplateRepository
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without db",
+			args: args{
+				db: nil,
+			},
+			want:    &ChecklistTemplateRepository{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				db: sqlxDB,
+			},
+			want:    &ChecklistTemplateRepository{db: sqlxDB},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChecklistTemplate(tt.args.db)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewChecklistTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const checklistItemColumns = `id, task_id, template_id, position, label, required, checked_by, checked_at`
+
+type ChecklistItemRepository struct {
+	db *sqlx.DB
+}
+
+func NewChecklistItem(db *sqlx.DB) (*ChecklistItemRepository, error) {
+	if db == nil {
+		return &ChecklistItemRepository{}, errors.New("db must not be nil")
+	}
+
+	return &ChecklistItemRepository{db}, nil
+}
+
+func (r *ChecklistItemRepository) Add(ctx context.Context, taskID int64, items []domain.ChecklistItem) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	if err := tx.QueryRowContext(ctx, `SELECT id FROM tasks WHERE id=? AND deleted=FALSE FOR UPDATE`, taskID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrTasksNotFound
+		}
+
+		return err
+	}
+
+	for _, item := range items {
+		_, err := tx.ExecContext(ctx, `INSERT INTO task_checklist_items (task_id, template_id, position, label, required) VALUES (?, ?, ?, ?, ?)`,
+			taskID, item.TemplateID, item.Position, item.Label, item.Required)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *ChecklistItemRepository) ListByTaskID(ctx context.Context, taskID int64) ([]domain.ChecklistItem, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+checklistItemColumns+` FROM task_checklist_items WHERE task_id=? ORDER BY template_id, position`, taskID)
+	if err != nil {
+		return []domain.ChecklistItem{}, err
+	}
+
+	var result []domain.ChecklistItem
+
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanChecklistItem(rows)
+		if err != nil {
+			return []domain.ChecklistItem{}, err
+		}
+
+		result = append(result, item)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ChecklistItemRepository) ListByID(ctx context.Context, id int64) (domain.ChecklistItem, error) {
+	result, err := scanChecklistItem(r.db.QueryRowContext(ctx, `SELECT `+checklistItemColumns+` FROM task_checklist_items WHERE id=?`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrChecklistItemNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *ChecklistItemRepository) Update(ctx context.Context, item domain.ChecklistItem) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE task_checklist_items SET checked_by=?, checked_at=? WHERE id=?`,
+		nullableID(item.CheckedBy), item.CheckedAt, item.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanChecklistItem(row rowScanner) (domain.ChecklistItem, error) {
+	var (
+		item      domain.ChecklistItem
+		checkedBy sql.NullInt64
+	)
+
+	err := row.Scan(&item.ID, &item.TaskID, &item.TemplateID, &item.Position, &item.Label, &item.Required, &checkedBy, &item.CheckedAt)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	item.CheckedBy = checkedBy.Int64
+
+	return item, nil
+}
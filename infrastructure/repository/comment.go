@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const commentColumns = `id, task_id, user_id, body, internal, created_at, edited_at`
+
+type CommentRepository struct {
+	db *sqlx.DB
+}
+
+func NewComment(db *sqlx.DB) (*CommentRepository, error) {
+	if db == nil {
+		return &CommentRepository{}, errors.New("db must not be nil")
+	}
+
+	return &CommentRepository{db}, nil
+}
+
+func (r *CommentRepository) Add(ctx context.Context, comment domain.Comment) (int64, error) {
+	var id int64
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO task_comments (task_id, user_id, body, internal, created_at) VALUES (?, ?, ?, ?, ?)`,
+		comment.TaskID, comment.UserID, comment.Body, comment.Internal, comment.CreatedAt.UTC())
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *CommentRepository) ListByTaskID(ctx context.Context, taskID int64, internal bool) ([]domain.Comment, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+commentColumns+` FROM task_comments WHERE task_id=? AND deleted=FALSE AND (internal=FALSE OR ?) ORDER BY created_at, id`, taskID, internal)
+	if err != nil {
+		return []domain.Comment{}, err
+	}
+
+	var result []domain.Comment
+
+	defer rows.Close()
+
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return []domain.Comment{}, err
+		}
+
+		result = append(result, comment)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *CommentRepository) ListByID(ctx context.Context, id int64) (domain.Comment, error) {
+	result, err := scanComment(r.db.QueryRowContext(ctx, `SELECT `+commentColumns+` FROM task_comments WHERE id=? AND deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrCommentNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment domain.Comment) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE task_comments SET body=?, edited_at=? WHERE id=? AND deleted=FALSE`, comment.Body, comment.EditedAt, comment.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE task_comments SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanComment(row rowScanner) (domain.Comment, error) {
+	var comment domain.Comment
+
+	err := row.Scan(&comment.ID, &comment.TaskID, &comment.UserID, &comment.Body, &comment.Internal, &comment.CreatedAt, &comment.EditedAt)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	return comment, nil
+}
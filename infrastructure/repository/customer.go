@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const customerColumns = `id, name, email, phone`
+
+type CustomerRepository struct {
+	db *sqlx.DB
+}
+
+func NewCustomer(db *sqlx.DB) (*CustomerRepository, error) {
+	if db == nil {
+		return &CustomerRepository{}, errors.New("db must not be nil")
+	}
+
+	return &CustomerRepository{db}, nil
+}
+
+func (r *CustomerRepository) Add(ctx context.Context, customer domain.Customer) (int64, error) {
+	var id int64
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO customers (name, email, phone) VALUES (?, ?, ?)`,
+		customer.Name, customer.Email, customer.Phone)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *CustomerRepository) List(ctx context.Context) ([]domain.Customer, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+customerColumns+` FROM customers WHERE deleted=FALSE ORDER BY name`)
+	if err != nil {
+		return []domain.Customer{}, err
+	}
+
+	var result []domain.Customer
+
+	defer rows.Close()
+
+	for rows.Next() {
+		customer, err := scanCustomer(rows)
+		if err != nil {
+			return []domain.Customer{}, err
+		}
+
+		result = append(result, customer)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *CustomerRepository) ListByID(ctx context.Context, id int64) (domain.Customer, error) {
+	result, err := scanCustomer(r.db.QueryRowContext(ctx, `SELECT `+customerColumns+` FROM customers WHERE id=? AND deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrCustomerNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *CustomerRepository) Update(ctx context.Context, customer domain.Customer) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE customers SET name=?, email=?, phone=? WHERE id=? AND deleted=FALSE`,
+		customer.Name, customer.Email, customer.Phone, customer.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CustomerRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE customers c LEFT JOIN sites s ON s.customer_id = c.id SET c.deleted=TRUE, s.deleted=TRUE WHERE c.id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanCustomer(row rowScanner) (domain.Customer, error) {
+	var customer domain.Customer
+
+	if err := row.Scan(&customer.ID, &customer.Name, &customer.Email, &customer.Phone); err != nil {
+		return domain.Customer{}, err
+	}
+
+	return customer, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+const idempotencyColumns = `user_id, idempotency_key, fingerprint, status_code, content_type, body, created_at, expires_at`
+
+type IdempotencyRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdempotency(db *sqlx.DB) (*IdempotencyRepository, error) {
+	if db == nil {
+		return &IdempotencyRepository{}, errors.New("db must not be nil")
+	}
+
+	return &IdempotencyRepository{db}, nil
+}
+
+func (r *IdempotencyRepository) Add(ctx context.Context, key domain.IdempotencyKey) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO idempotency_keys (user_id, idempotency_key, fingerprint, body, created_at, expires_at) VALUES (?, ?, ?, '', ?, ?)`,
+		key.UserID, key.Key, key.Fingerprint, key.CreatedAt.UTC(), key.ExpiresAt.UTC())
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+			return domain.ErrIdempotencyKeyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) ListByKey(ctx context.Context, userID int64, key string) (domain.IdempotencyKey, error) {
+	var result domain.IdempotencyKey
+
+	err := r.db.QueryRowContext(ctx, `SELECT `+idempotencyColumns+` FROM idempotency_keys WHERE user_id=? AND idempotency_key=?`, userID, key).
+		Scan(&result.UserID, &result.Key, &result.Fingerprint, &result.StatusCode, &result.ContentType, &result.Body, &result.CreatedAt, &result.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.IdempotencyKey{}, domain.ErrIdempotencyKeyNotFound
+		}
+
+		return domain.IdempotencyKey{}, err
+	}
+
+	return result, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, key domain.IdempotencyKey) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE idempotency_keys SET status_code=?, content_type=?, body=? WHERE user_id=? AND idempotency_key=?`,
+		key.StatusCode, key.ContentType, key.Body, key.UserID, key.Key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Remove(ctx context.Context, userID int64, key string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE user_id=? AND idempotency_key=?`, userID, key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) RemoveExpired(ctx context.Context, before time.Time) (int64, error) {
+	record, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at<=?`, before.UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	return record.RowsAffected()
+}
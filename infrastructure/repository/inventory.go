@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const stockColumns = `s.user_id, s.quantity, s.min_quantity, p.id, p.sku, p.name, p.unit`
+
+type InventoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewInventory(db *sqlx.DB) (*InventoryRepository, error) {
+	if db == nil {
+		return &InventoryRepository{}, errors.New("db must not be nil")
+	}
+
+	return &InventoryRepository{db}, nil
+}
+
+func (r *InventoryRepository) ListByUserID(ctx context.Context, userID int64) ([]domain.Stock, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+stockColumns+` FROM part_stock s JOIN parts p ON p.id = s.part_id WHERE p.deleted=FALSE AND (?=0 OR s.user_id=?) ORDER BY s.user_id, p.name`, userID, userID)
+	if err != nil {
+		return []domain.Stock{}, err
+	}
+
+	var result []domain.Stock
+
+	defer rows.Close()
+
+	for rows.Next() {
+		stock, err := scanStock(rows)
+		if err != nil {
+			return []domain.Stock{}, err
+		}
+
+		result = append(result, stock)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *InventoryRepository) Upsert(ctx context.Context, stock domain.Stock) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO part_stock (user_id, part_id, quantity, min_quantity) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE quantity=VALUES(quantity), min_quantity=VALUES(min_quantity)`,
+		stock.UserID, stock.Part.ID, stock.Quantity, stock.MinQuantity)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *InventoryRepository) Consume(ctx context.Context, usage domain.PartUsage) (int64, domain.Stock, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, domain.Stock{}, err
+	}
+	defer tx.Rollback()
+
+	stock, err := scanStock(tx.QueryRowContext(ctx, `SELECT `+stockColumns+` FROM part_stock s JOIN parts p ON p.id = s.part_id WHERE s.user_id=? AND s.part_id=? AND p.deleted=FALSE FOR UPDATE`, usage.UserID, usage.PartID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.Stock{}, fmt.Errorf("%w: part %d is not stocked", domain.ErrInsufficientStock, usage.PartID)
+		}
+
+		return 0, domain.Stock{}, err
+	}
+
+	if stock.Quantity < usage.Quantity {
+		return 0, domain.Stock{}, fmt.Errorf("%w: %d left of part %d", domain.ErrInsufficientStock, stock.Quantity, usage.PartID)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE part_stock SET quantity=quantity-? WHERE user_id=? AND part_id=?`, usage.Quantity, usage.UserID, usage.PartID); err != nil {
+		return 0, domain.Stock{}, err
+	}
+
+	record, err := tx.ExecContext(ctx, `INSERT INTO task_parts (task_id, part_id, user_id, quantity, consumed_at) VALUES (?, ?, ?, ?, ?)`,
+		usage.TaskID, usage.PartID, usage.UserID, usage.Quantity, usage.CreatedAt.UTC())
+	if err != nil {
+		return 0, domain.Stock{}, err
+	}
+
+	id, err := record.LastInsertId()
+	if err != nil {
+		return 0, domain.Stock{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, domain.Stock{}, err
+	}
+
+	stock.Quantity -= usage.Quantity
+
+	return id, stock, nil
+}
+
+func (r *InventoryRepository) ListByTaskID(ctx context.Context, taskID int64) ([]domain.PartUsage, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, task_id, part_id, user_id, quantity, consumed_at FROM task_parts WHERE task_id=? ORDER BY consumed_at, id`, taskID)
+	if err != nil {
+		return []domain.PartUsage{}, err
+	}
+
+	var result []domain.PartUsage
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var usage domain.PartUsage
+		if err := rows.Scan(&usage.ID, &usage.TaskID, &usage.PartID, &usage.UserID, &usage.Quantity, &usage.CreatedAt); err != nil {
+			return []domain.PartUsage{}, err
+		}
+
+		result = append(result, usage)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *InventoryRepository) Report(ctx context.Context, filter domain.PartUsageFilter) ([]domain.PartUsageReport, error) {
+	var (
+		clause string
+		args   []any
+	)
+
+	if filter.From != nil {
+		clause += ` AND u.consumed_at >= ?`
+		args = append(args, filter.From.UTC())
+	}
+
+	if filter.To != nil {
+		clause += ` AND u.consumed_at < ?`
+		args = append(args, filter.To.UTC())
+	}
+
+	if filter.CustomerID != 0 {
+		clause += ` AND c.id = ?`
+		args = append(args, filter.CustomerID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT COALESCE(c.id, 0), COALESCE(c.name, ''), p.id, p.sku, p.name, p.unit, SUM(u.quantity)
+		FROM task_parts u
+		JOIN parts p ON p.id = u.part_id
+		JOIN tasks t ON t.id = u.task_id
+		LEFT JOIN sites s ON s.id = t.site_id
+		LEFT JOIN customers c ON c.id = s.customer_id
+		WHERE t.deleted=FALSE`+clause+`
+		GROUP BY c.id, c.name, p.id, p.sku, p.name, p.unit
+		ORDER BY c.name, p.name`, args...)
+	if err != nil {
+		return []domain.PartUsageReport{}, err
+	}
+
+	var result []domain.PartUsageReport
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var report domain.PartUsageReport
+		if err := rows.Scan(&report.CustomerID, &report.CustomerName, &report.Part.ID, &report.Part.SKU, &report.Part.Name, &report.Part.Unit, &report.Quantity); err != nil {
+			return []domain.PartUsageReport{}, err
+		}
+
+		result = append(result, report)
+	}
+
+	return result, rows.Err()
+}
+
+func scanStock(row rowScanner) (domain.Stock, error) {
+	var stock domain.Stock
+
+	err := row.Scan(&stock.UserID, &stock.Quantity, &stock.MinQuantity, &stock.Part.ID, &stock.Part.SKU, &stock.Part.Name, &stock.Part.Unit)
+	if err != nil {
+		return domain.Stock{}, err
+	}
+
+	return stock, nil
+}
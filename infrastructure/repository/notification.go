@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+type NotificationPreferenceRepository struct {
+	db *sqlx.DB
+}
+
+func NewNotificationPreference(db *sqlx.DB) (*NotificationPreferenceRepository, error) {
+	if db == nil {
+		return &NotificationPreferenceRepository{}, errors.New("db must not be nil")
+	}
+
+	return &NotificationPreferenceRepository{db}, nil
+}
+
+func (r *NotificationPreferenceRepository) ListByUserID(ctx context.Context, userID int64) (domain.NotificationPreference, error) {
+	var (
+		result   domain.NotificationPreference
+		channels sql.NullString
+		locale   sql.NullString
+		phone    sql.NullString
+	)
+
+	err := r.db.QueryRowContext(ctx, `SELECT u.id, u.email, u.timezone, p.channels, p.locale, p.phone FROM users u LEFT JOIN notification_preferences p ON p.user_id = u.id WHERE u.id=? AND u.deleted=FALSE`, userID).
+		Scan(&result.UserID, &result.Email, &result.Timezone, &channels, &locale, &phone)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrUserNotFound
+		}
+
+		return result, err
+	}
+
+	result.Channels = []string{domain.EmailChannel}
+	if channels.Valid {
+		result.Channels = splitChannels(channels.String)
+	}
+
+	result.Locale = domain.DefaultLocale
+	if locale.Valid && locale.String != "" {
+		result.Locale = locale.String
+	}
+
+	result.Phone = phone.String
+
+	return result, nil
+}
+
+func (r *NotificationPreferenceRepository) Upsert(ctx context.Context, preference domain.NotificationPreference) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO notification_preferences (user_id, channels, locale, phone) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE channels=VALUES(channels), locale=VALUES(locale), phone=VALUES(phone)`,
+		preference.UserID, strings.Join(preference.Channels, ","), preference.Locale, preference.Phone)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func splitChannels(channels string) []string {
+	result := []string{}
+
+	for _, c := range strings.Split(channels, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	partColumns = `id, sku, name, unit`
+
+	mysqlDuplicateEntry = 1062
+)
+
+type PartRepository struct {
+	db *sqlx.DB
+}
+
+func NewPart(db *sqlx.DB) (*PartRepository, error) {
+	if db == nil {
+		return &PartRepository{}, errors.New("db must not be nil")
+	}
+
+	return &PartRepository{db}, nil
+}
+
+func (r *PartRepository) Add(ctx context.Context, part domain.Part) (int64, error) {
+	var id int64
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO parts (sku, name, unit) VALUES (?, ?, ?)`, part.SKU, part.Name, part.Unit)
+	if err != nil {
+		return id, partError(err, part)
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *PartRepository) List(ctx context.Context) ([]domain.Part, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+partColumns+` FROM parts WHERE deleted=FALSE ORDER BY name`)
+	if err != nil {
+		return []domain.Part{}, err
+	}
+
+	var result []domain.Part
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var part domain.Part
+		if err := rows.Scan(&part.ID, &part.SKU, &part.Name, &part.Unit); err != nil {
+			return []domain.Part{}, err
+		}
+
+		result = append(result, part)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *PartRepository) ListByID(ctx context.Context, id int64) (domain.Part, error) {
+	var result domain.Part
+
+	err := r.db.QueryRowContext(ctx, `SELECT `+partColumns+` FROM parts WHERE id=? AND deleted=FALSE`, id).Scan(&result.ID, &result.SKU, &result.Name, &result.Unit)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Part{}, domain.ErrPartNotFound
+		}
+
+		return domain.Part{}, err
+	}
+
+	return result, nil
+}
+
+func (r *PartRepository) Update(ctx context.Context, part domain.Part) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE parts SET sku=?, name=?, unit=? WHERE id=? AND deleted=FALSE`, part.SKU, part.Name, part.Unit, part.ID)
+	if err != nil {
+		return partError(err, part)
+	}
+
+	return nil
+}
+
+func (r *PartRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE parts SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func partError(err error, part domain.Part) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+		return fmt.Errorf("%w: sku %q is already in use", domain.ErrInvalidPart, part.SKU)
+	}
+
+	return err
+}
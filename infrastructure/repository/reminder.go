@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+)
+
+const (
+	reminderMaxAttempts = 3
+
+	// reminderClaimLease is how long a claim holds before another owner may
+	// take the reminder over, so reminders claimed by a replica that stopped
+	// before sending them are not lost.
+	reminderClaimLease = 10 * time.Minute
+)
+
+type ReminderRepository struct {
+	db *sqlx.DB
+}
+
+func NewReminder(db *sqlx.DB) (*ReminderRepository, error) {
+	if db == nil {
+		return &ReminderRepository{}, errors.New("db must not be nil")
+	}
+
+	return &ReminderRepository{db}, nil
+}
+
+func (r *ReminderRepository) Add(ctx context.Context, reminders []domain.Reminder) error {
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	var (
+		placeholders []string
+		args         []any
+	)
+
+	for _, reminder := range reminders {
+		placeholders = append(placeholders, "(?, ?, ?)")
+		args = append(args, reminder.TaskID, int64(reminder.Offset.Seconds()), reminder.RemindAt.UTC())
+	}
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, `INSERT IGNORE INTO task_reminders (task_id, offset_seconds, remind_at) VALUES `+strings.Join(placeholders, ", "), args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemovePendingByTaskID removes the reminders not sent yet, including claimed
+// ones, so a dispatch in flight loses its claim instead of reminding of the
+// old date.
+func (r *ReminderRepository) RemovePendingByTaskID(ctx context.Context, taskID int64) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM task_reminders WHERE task_id=? AND sent_at IS NULL`, taskID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Claim takes the due reminders that are unclaimed or whose claim outlived the
+// lease. Taking over a stale claim counts as a failed attempt, so a reminder
+// that keeps stopping its owner is eventually given up.
+func (r *ReminderRepository) Claim(ctx context.Context, owner string, now time.Time, limit int) ([]domain.Reminder, error) {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_reminders SET attempts=attempts+(claimed_by IS NOT NULL), claimed_by=?, claimed_at=? WHERE (claimed_by IS NULL OR claimed_at < ?) AND sent_at IS NULL AND attempts < ? AND remind_at <= ? ORDER BY remind_at LIMIT ?`,
+		owner, now.UTC(), now.Add(-reminderClaimLease).UTC(), reminderMaxAttempts, now.UTC(), limit)
+	if err != nil {
+		return []domain.Reminder{}, err
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT r.id, r.task_id, t.user_id, r.offset_seconds, r.remind_at, t.date FROM task_reminders r INNER JOIN tasks t ON t.id = r.task_id WHERE r.claimed_by=? AND r.sent_at IS NULL AND t.deleted=FALSE AND t.date IS NOT NULL`, owner)
+	if err != nil {
+		return []domain.Reminder{}, err
+	}
+	defer rows.Close()
+
+	var result []domain.Reminder
+
+	for rows.Next() {
+		var (
+			reminder domain.Reminder
+			offset   int64
+		)
+
+		if err := rows.Scan(&reminder.ID, &reminder.TaskID, &reminder.UserID, &offset, &reminder.RemindAt, &reminder.TaskDate); err != nil {
+			return []domain.Reminder{}, err
+		}
+
+		reminder.Offset = time.Duration(offset) * time.Second
+		result = append(result, reminder)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ReminderRepository) MarkSent(ctx context.Context, id int64, owner string) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_reminders SET sent_at=? WHERE id=? AND claimed_by=?`, time.Now().UTC(), id, owner)
+	if err != nil {
+		return err
+	}
+
+	return claimHeld(result)
+}
+
+func (r *ReminderRepository) Release(ctx context.Context, id int64, owner string) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_reminders SET claimed_by=NULL, claimed_at=NULL, attempts=attempts+1 WHERE id=? AND claimed_by=? AND sent_at IS NULL`, id, owner)
+	if err != nil {
+		return err
+	}
+
+	return claimHeld(result)
+}
+
+func claimHeld(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return domain.ErrReminderClaimLost
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+//go:build unit
+
+package repository
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewReminder(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	type args struct {
+		db *sqlx.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ReminderRepository
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without db",
+			args: args{
+				db: nil,
+			},
+			want:    &ReminderRepository{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				db: sqlxDB,
+			},
+			want:    &ReminderRepository{db: sqlxDB},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewReminder(tt.args.db)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewReminder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReminderRepository_Claim(t *testing.T) {
+	var (
+		now   = time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+		date  = now.Add(time.Hour)
+		owner = "pod-1:abc"
+	)
+
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want []domain.Reminder
+	}{
+		{
+			name: "Expect due reminders to be claimed",
+			rows: sqlmock.NewRows([]string{"id", "task_id", "user_id", "offset_seconds", "remind_at", "date"}).
+				AddRow(1, 2, 3, 3600, now, date),
+			want: []domain.Reminder{
+				{ID: 1, TaskID: 2, UserID: 3, Offset: time.Hour, RemindAt: now, TaskDate: date},
+			},
+		},
+		{
+			name: "Expect reminders whose claim outlived the lease to be taken over",
+			rows: sqlmock.NewRows([]string{"id", "task_id", "user_id", "offset_seconds", "remind_at", "date"}).
+				AddRow(4, 5, 3, 900, now.Add(-time.Hour), date),
+			want: []domain.Reminder{
+				{ID: 4, TaskID: 5, UserID: 3, Offset: 15 * time.Minute, RemindAt: now.Add(-time.Hour), TaskDate: date},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			r := &ReminderRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+			mock.ExpectExec(`UPDATE task_reminders SET attempts=attempts\+\(claimed_by IS NOT NULL\), claimed_by=\?, claimed_at=\? WHERE \(claimed_by IS NULL OR claimed_at < \?\)`).
+				WithArgs(owner, now, now.Add(-reminderClaimLease), reminderMaxAttempts, now, 10).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery(`SELECT r.id, r.task_id, t.user_id, r.offset_seconds, r.remind_at, t.date FROM task_reminders r`).
+				WithArgs(owner).
+				WillReturnRows(tt.rows)
+
+			got, err := r.Claim(context.Background(), owner, now, 10)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReminderRepository_RemovePendingByTaskID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &ReminderRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+	mock.ExpectExec(`DELETE FROM task_reminders WHERE task_id=\? AND sent_at IS NULL$`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, r.RemovePendingByTaskID(context.Background(), 1))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReminderRepository_MarkSent(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{
+			name:     "Expect reminder held by the owner to be marked as sent",
+			affected: 1,
+		},
+		{
+			name:     "Expect error when the claim was taken over",
+			affected: 0,
+			wantErr:  domain.ErrReminderClaimLost,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			r := &ReminderRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+			mock.ExpectExec(`UPDATE task_reminders SET sent_at=\? WHERE id=\? AND claimed_by=\?`).
+				WithArgs(sqlmock.AnyArg(), int64(1), "pod-1:abc").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = r.MarkSent(context.Background(), 1, "pod-1:abc")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReminderRepository_Release(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{
+			name:     "Expect reminder held by the owner to be released",
+			affected: 1,
+		},
+		{
+			name:     "Expect error when the claim was taken over",
+			affected: 0,
+			wantErr:  domain.ErrReminderClaimLost,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			r := &ReminderRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+			mock.ExpectExec(`UPDATE task_reminders SET claimed_by=NULL, claimed_at=NULL, attempts=attempts\+1 WHERE id=\? AND claimed_by=\? AND sent_at IS NULL`).
+				WithArgs(int64(1), "pod-1:abc").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = r.Release(context.Background(), 1, "pod-1:abc")
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
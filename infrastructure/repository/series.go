@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+const seriesColumns = `id, summary, rrule, start, user_id, type, priority, site_id, duration_minutes, window_minutes, timezone, generated_until`
+
+type SeriesRepository struct {
+	db *sqlx.DB
+}
+
+func NewSeries(db *sqlx.DB) (*SeriesRepository, error) {
+	if db == nil {
+		return &SeriesRepository{}, errors.New("db must not be nil")
+	}
+
+	return &SeriesRepository{db}, nil
+}
+
+func (r *SeriesRepository) Add(ctx context.Context, series domain.TaskSeries) (int64, error) {
+	var id int64
+
+	record, err := conn(ctx, r.db).ExecContext(ctx, `INSERT INTO task_series (summary, rrule, start, user_id, type, priority, site_id, duration_minutes, window_minutes, timezone, generated_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		series.Summary, series.RRule, series.Start.UTC(), series.UserID, series.Type, series.Priority, nullableID(series.SiteID), durationMinutes(series.Duration), durationMinutes(series.Window), series.Timezone, series.GeneratedUntil)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *SeriesRepository) List(ctx context.Context) ([]domain.TaskSeries, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+seriesColumns+` FROM task_series WHERE deleted=FALSE`)
+	if err != nil {
+		return []domain.TaskSeries{}, err
+	}
+
+	return rowsToSeries(rows)
+}
+
+func (r *SeriesRepository) ListByID(ctx context.Context, id int64) (domain.TaskSeries, error) {
+	result, err := scanSeries(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+seriesColumns+` FROM task_series WHERE id=? AND deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrTaskSeriesNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *SeriesRepository) ListByUserID(ctx context.Context, userID int64) ([]domain.TaskSeries, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+seriesColumns+` FROM task_series WHERE user_id=? AND deleted=FALSE`, userID)
+	if err != nil {
+		return []domain.TaskSeries{}, err
+	}
+
+	return rowsToSeries(rows)
+}
+
+func (r *SeriesRepository) ListPending(ctx context.Context, until time.Time) ([]domain.TaskSeries, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+seriesColumns+` FROM task_series WHERE deleted=FALSE AND (generated_until IS NULL OR generated_until<?)`, until.UTC())
+	if err != nil {
+		return []domain.TaskSeries{}, err
+	}
+
+	return rowsToSeries(rows)
+}
+
+func (r *SeriesRepository) Update(ctx context.Context, series domain.TaskSeries) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_series SET summary=?, rrule=?, start=?, type=?, priority=?, site_id=?, duration_minutes=?, window_minutes=?, timezone=?, generated_until=? WHERE id=? AND deleted=FALSE`,
+		series.Summary, series.RRule, series.Start.UTC(), series.Type, series.Priority, nullableID(series.SiteID), durationMinutes(series.Duration), durationMinutes(series.Window), series.Timezone, series.GeneratedUntil, series.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *SeriesRepository) MarkGenerated(ctx context.Context, id int64, until time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_series SET generated_until=? WHERE id=? AND (generated_until IS NULL OR generated_until<?)`,
+		until.UTC(), id, until.UTC())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *SeriesRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_series SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanSeries(row rowScanner) (domain.TaskSeries, error) {
+	var (
+		series   domain.TaskSeries
+		siteID   sql.NullInt64
+		duration int64
+		window   int64
+	)
+
+	err := row.Scan(&series.ID, &series.Summary, &series.RRule, &series.Start, &series.UserID, &series.Type, &series.Priority, &siteID, &duration, &window, &series.Timezone, &series.GeneratedUntil)
+	if err != nil {
+		return domain.TaskSeries{}, err
+	}
+
+	series.SiteID = siteID.Int64
+	series.Duration = time.Duration(duration) * time.Minute
+	series.Window = time.Duration(window) * time.Minute
+
+	return series, nil
+}
+
+func rowsToSeries(rows *sql.Rows) ([]domain.TaskSeries, error) {
+	var result []domain.TaskSeries
+
+	defer rows.Close()
+
+	for rows.Next() {
+		series, err := scanSeries(rows)
+		if err != nil {
+			return []domain.TaskSeries{}, err
+		}
+
+		result = append(result, series)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,125 @@
+//go:build unit
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewSeries(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	type args struct {
+		db *sqlx.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SeriesRepository
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without db",
+			args: args{
+				db: nil,
+			},
+			want:    &SeriesRepository{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				db: sqlxDB,
+			},
+			want:    &SeriesRepository{db: sqlxDB},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSeries(tt.args.db)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSeries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSeriesRepository_Add(t *testing.T) {
+	var (
+		start = time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+		from  = start.AddDate(0, 0, 14)
+	)
+
+	tests := []struct {
+		name          string
+		moveErr       error
+		wantCommitted bool
+	}{
+		{
+			name:          "Expect series to join the transaction of the context",
+			wantCommitted: true,
+		},
+		{
+			name:    "Expect rollback when moving the occurrences fails",
+			moveErr: errors.New("err"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+			r := &SeriesRepository{db: db}
+			tasks := &TaskRepository{db: db}
+
+			series := domain.TaskSeries{Summary: "encrypted", RRule: "FREQ=WEEKLY", Start: from, UserID: 2, Type: "standard", Priority: "P1", SiteID: 4, Duration: time.Hour, Window: 2 * time.Hour, Timezone: "UTC"}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`INSERT INTO task_series \(summary, rrule, start, user_id, type, priority, site_id, duration_minutes, window_minutes, timezone, generated_until\)`).
+				WithArgs("encrypted", "FREQ=WEEKLY", from, 2, "standard", "P1", 4, 60, 120, "UTC", nil).
+				WillReturnResult(sqlmock.NewResult(9, 1))
+			move := mock.ExpectExec(`UPDATE tasks SET series_id=\?, version=version\+1 WHERE series_id=\? AND occurrence_at>=\? AND deleted=FALSE`).
+				WithArgs(9, 7, from)
+			if tt.wantCommitted {
+				move.WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			} else {
+				move.WillReturnError(tt.moveErr)
+				mock.ExpectRollback()
+			}
+
+			err = tasks.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				id, err := r.Add(ctx, series)
+				if err != nil {
+					return err
+				}
+
+				return tasks.MoveOccurrences(ctx, 7, id, from)
+			})
+
+			assert.Equal(t, tt.moveErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type SignatureRepository struct {
+	db *sqlx.DB
+}
+
+func NewSignature(db *sqlx.DB) (*SignatureRepository, error) {
+	if db == nil {
+		return &SignatureRepository{}, errors.New("db must not be nil")
+	}
+
+	return &SignatureRepository{db}, nil
+}
+
+func (r *SignatureRepository) Add(ctx context.Context, signature domain.Signature) (int64, error) {
+	var id int64
+
+	record, err := conn(ctx, r.db).ExecContext(ctx, `INSERT INTO task_signatures (task_id, signer_name, format, content, content_hash, task_hash, signed_by, signed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		signature.TaskID, signature.SignerName, signature.Format, signature.Content, signature.ContentHash, signature.TaskHash, signature.SignedBy, signature.SignedAt.UTC())
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *SignatureRepository) ListByTaskID(ctx context.Context, taskID int64) (domain.Signature, error) {
+	var result domain.Signature
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT id, task_id, signer_name, format, content, content_hash, task_hash, signed_by, signed_at FROM task_signatures WHERE task_id=?`, taskID).
+		Scan(&result.ID, &result.TaskID, &result.SignerName, &result.Format, &result.Content, &result.ContentHash, &result.TaskHash, &result.SignedBy, &result.SignedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Signature{}, domain.ErrSignatureNotFound
+		}
+
+		return domain.Signature{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const siteColumns = `s.id, s.customer_id, s.name, s.address, s.latitude, s.longitude`
+
+type SiteRepository struct {
+	db *sqlx.DB
+}
+
+func NewSite(db *sqlx.DB) (*SiteRepository, error) {
+	if db == nil {
+		return &SiteRepository{}, errors.New("db must not be nil")
+	}
+
+	return &SiteRepository{db}, nil
+}
+
+func (r *SiteRepository) Add(ctx context.Context, site domain.Site) (int64, error) {
+	var id int64
+
+	latitude, longitude := siteCoordinates(site)
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO sites (customer_id, name, address, latitude, longitude) VALUES (?, ?, ?, ?, ?)`,
+		site.CustomerID, site.Name, site.Address, latitude, longitude)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *SiteRepository) List(ctx context.Context, customerID int64) ([]domain.Site, error) {
+	query := `SELECT ` + siteColumns + ` FROM sites s WHERE s.deleted=FALSE`
+
+	var args []any
+	if customerID != 0 {
+		query += ` AND s.customer_id=?`
+		args = append(args, customerID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+` ORDER BY s.name`, args...)
+	if err != nil {
+		return []domain.Site{}, err
+	}
+
+	var result []domain.Site
+
+	defer rows.Close()
+
+	for rows.Next() {
+		site, err := scanSite(rows)
+		if err != nil {
+			return []domain.Site{}, err
+		}
+
+		result = append(result, site)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *SiteRepository) ListByID(ctx context.Context, id int64) (domain.Site, error) {
+	result, err := scanSite(r.db.QueryRowContext(ctx, `SELECT `+siteColumns+` FROM sites s WHERE s.id=? AND s.deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrSiteNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *SiteRepository) Update(ctx context.Context, site domain.Site) error {
+	latitude, longitude := siteCoordinates(site)
+
+	_, err := r.db.ExecContext(ctx, `UPDATE sites SET customer_id=?, name=?, address=?, latitude=?, longitude=? WHERE id=? AND deleted=FALSE`,
+		site.CustomerID, site.Name, site.Address, latitude, longitude, site.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *SiteRepository) Remove(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE sites SET deleted=TRUE WHERE id=?`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func siteCoordinates(site domain.Site) (sql.NullFloat64, sql.NullFloat64) {
+	if site.Coordinates == nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: site.Coordinates.Latitude, Valid: true}, sql.NullFloat64{Float64: site.Coordinates.Longitude, Valid: true}
+}
+
+func scanSite(row rowScanner) (domain.Site, error) {
+	var (
+		site      domain.Site
+		latitude  sql.NullFloat64
+		longitude sql.NullFloat64
+	)
+
+	if err := row.Scan(&site.ID, &site.CustomerID, &site.Name, &site.Address, &latitude, &longitude); err != nil {
+		return domain.Site{}, err
+	}
+
+	if latitude.Valid && longitude.Valid {
+		site.Coordinates = &domain.Coordinates{Latitude: latitude.Float64, Longitude: longitude.Float64}
+	}
+
+	return site, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type SLAPolicyRepository struct {
+	db *sqlx.DB
+}
+
+func NewSLAPolicy(db *sqlx.DB) (*SLAPolicyRepository, error) {
+	if db == nil {
+		return &SLAPolicyRepository{}, errors.New("db must not be nil")
+	}
+
+	return &SLAPolicyRepository{db}, nil
+}
+
+func (r *SLAPolicyRepository) ListByTaskTypeAndPriority(ctx context.Context, taskType, priority string) (domain.SLAPolicy, error) {
+	var (
+		result     domain.SLAPolicy
+		resolution int64
+		atRisk     int64
+	)
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT task_type, priority, resolution_minutes, at_risk_minutes FROM sla_policies WHERE task_type IN (?, '') AND priority IN (?, '') ORDER BY priority='', task_type='' LIMIT 1`, taskType, priority).
+		Scan(&result.TaskType, &result.Priority, &resolution, &atRisk)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrSLAPolicyNotFound
+		}
+
+		return result, err
+	}
+
+	result.ResolutionTime = time.Duration(resolution) * time.Minute
+	result.AtRiskThreshold = time.Duration(atRisk) * time.Minute
+
+	return result, nil
+}
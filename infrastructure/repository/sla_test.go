@@ -0,0 +1,109 @@
+//go:build unit
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewSLAPolicy(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	sqlxDB := sqlx.NewDb(mockDB, "sqlmock")
+
+	type args struct {
+		db *sqlx.DB
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SLAPolicyRepository
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without db",
+			args: args{
+				db: nil,
+			},
+			want:    &SLAPolicyRepository{},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				db: sqlxDB,
+			},
+			want:    &SLAPolicyRepository{db: sqlxDB},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSLAPolicy(tt.args.db)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSLAPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSLAPolicyRepository_ListByTaskTypeAndPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		err     error
+		want    domain.SLAPolicy
+		wantErr error
+	}{
+		{
+			name: "Expect the most specific policy",
+			rows: sqlmock.NewRows([]string{"task_type", "priority", "resolution_minutes", "at_risk_minutes"}).
+				AddRow("", "P1", 240, 60),
+			want: domain.SLAPolicy{Priority: "P1", ResolutionTime: 4 * time.Hour, AtRiskThreshold: time.Hour},
+		},
+		{
+			name:    "Expect not found when no policy matches",
+			err:     sql.ErrNoRows,
+			wantErr: domain.ErrSLAPolicyNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			r := &SLAPolicyRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+			query := mock.ExpectQuery(`SELECT task_type, priority, resolution_minutes, at_risk_minutes FROM sla_policies WHERE task_type IN \(\?, ''\) AND priority IN \(\?, ''\) ORDER BY priority='', task_type='' LIMIT 1`).
+				WithArgs("emergency", "P1")
+			if tt.err != nil {
+				query.WillReturnError(tt.err)
+			} else {
+				query.WillReturnRows(tt.rows)
+			}
+
+			got, err := r.ListByTaskTypeAndPriority(context.Background(), "emergency", "P1")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
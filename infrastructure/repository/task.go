@@ -6,6 +6,16 @@ import (
 	"errors"
 	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+)
+
+const (
+	taskColumns       = `t.id, t.summary, t.date, t.user_id, t.site_id, t.type, t.priority, t.status, t.due_at, t.overdue_at, t.created_at, t.series_id, t.occurrence_at, t.duration_minutes, t.window_start, t.window_end, t.version`
+	taskOrderPriority = `t.priority, t.due_at IS NULL, t.due_at, t.date IS NULL, t.date, t.id`
+	// taskSLAAtRisk is the at-risk threshold of the policy of each task, picked
+	// the same way as SLAPolicyRepository.ListByTaskTypeAndPriority.
+	taskSLAAtRisk = `(SELECT p.at_risk_minutes FROM sla_policies p WHERE p.task_type IN (t.type, '') AND p.priority IN (t.priority, '') ORDER BY p.priority='', p.task_type='' LIMIT 1)`
 )
 
 type TaskRepository struct {
@@ -23,7 +33,8 @@ func NewTask(db *sqlx.DB) (*TaskRepository, error) {
 func (r *TaskRepository) Add(ctx context.Context, task domain.Task) (int64, error) {
 	var id int64
 
-	record, err := r.db.ExecContext(ctx, `INSERT INTO tasks (summary, date, user_id) VALUES (?, ?, ?)`, task.Summary, task.Date, task.UserID)
+	record, err := conn(ctx, r.db).ExecContext(ctx, `INSERT INTO tasks (summary, date, duration_minutes, window_start, window_end, user_id, site_id, type, priority, status, due_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		task.Summary, task.Date, durationMinutes(task.Duration), task.WindowStart, task.WindowEnd, task.UserID, nullableID(task.SiteID), task.Type, task.Priority, task.Status, task.DueAt)
 	if err != nil {
 		return id, err
 	}
@@ -35,8 +46,10 @@ func (r *TaskRepository) Add(ctx context.Context, task domain.Task) (int64, erro
 	return id, nil
 }
 
-func (r *TaskRepository) List(ctx context.Context) ([]domain.Task, error) {
-	rows, err := r.db.QueryContext(ctx, `SELECT id, summary, date, user_id FROM tasks WHERE deleted=FALSE`)
+func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]domain.Task, error) {
+	clause, args := taskFilterClause(filter)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.deleted=FALSE`+clause, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []domain.Task{}, domain.ErrTasksNotFound
@@ -53,12 +66,58 @@ func (r *TaskRepository) List(ctx context.Context) ([]domain.Task, error) {
 	return result, nil
 }
 
+func (r *TaskRepository) Stream(ctx context.Context, filter domain.TaskFilter, fn func(task domain.Task) error) error {
+	clause, args := taskFilterClause(filter)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.deleted=FALSE`+clause, args...)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(task); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *TaskRepository) ListByID(ctx context.Context, id int64) (domain.Task, error) {
+	result, err := scanTask(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.id=? AND t.deleted=FALSE`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrTasksNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
 func (r *TaskRepository) ListByIDAndUserID(ctx context.Context, id, userID int64) (domain.Task, error) {
-	var result domain.Task
+	result, err := scanTask(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.id=? AND t.user_id=? AND t.deleted=FALSE`, id, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrTasksNotFound
+		}
 
-	err := r.db.QueryRowContext(ctx, `SELECT id, summary, date, user_id FROM tasks WHERE id=? AND user_id=? AND deleted=FALSE`, id, userID).
-		Scan(&result.ID, &result.Summary, &result.Date, &result.UserID)
+		return result, err
+	}
 
+	return result, nil
+}
+
+func (r *TaskRepository) LockByIDAndUserID(ctx context.Context, id, userID int64) (domain.Task, error) {
+	result, err := scanTask(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.id=? AND t.user_id=? AND t.deleted=FALSE FOR UPDATE`, id, userID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return result, domain.ErrTasksNotFound
@@ -70,8 +129,10 @@ func (r *TaskRepository) ListByIDAndUserID(ctx context.Context, id, userID int64
 	return result, nil
 }
 
-func (r *TaskRepository) ListByUserID(ctx context.Context, userID int64) ([]domain.Task, error) {
-	rows, err := r.db.QueryContext(ctx, `SELECT id, summary, date, user_id FROM tasks WHERE user_id=? AND deleted=FALSE`, userID)
+func (r *TaskRepository) ListByUserID(ctx context.Context, userID int64, filter domain.TaskFilter) ([]domain.Task, error) {
+	clause, args := taskFilterClause(filter)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.user_id=? AND t.deleted=FALSE`+clause, append([]any{userID}, args...)...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return []domain.Task{}, domain.ErrTasksNotFound
@@ -88,39 +149,321 @@ func (r *TaskRepository) ListByUserID(ctx context.Context, userID int64) ([]doma
 	return result, nil
 }
 
-func (r *TaskRepository) Update(ctx context.Context, task domain.Task) error {
-	_, err := r.db.ExecContext(ctx, `UPDATE tasks SET summary=?, date=? WHERE id=? AND user_id=? AND deleted=FALSE`, task.Summary, task.Date, task.ID, task.UserID)
+// ListOverlapping returns the open bookings of the user within [start, end).
+// Stored tasks without a duration are booked for MinBookingDuration, as in
+// Task.BookingEnd.
+func (r *TaskRepository) ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]domain.Task, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.user_id=? AND t.id<>? AND t.deleted=FALSE AND t.status<>? AND t.date IS NOT NULL AND t.date<? AND DATE_ADD(t.date, INTERVAL GREATEST(t.duration_minutes, ?) MINUTE)>?`,
+		userID, excludeID, domain.TaskStatusCompleted, end, int64(domain.MinBookingDuration/time.Minute), start)
+	if err != nil {
+		return []domain.Task{}, err
+	}
+
+	return rowsToTask(rows)
+}
+
+func (r *TaskRepository) ListNextByUserID(ctx context.Context, userID int64) (domain.Task, error) {
+	result, err := scanTask(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.user_id=? AND t.status=? AND t.deleted=FALSE ORDER BY `+taskOrderPriority+` LIMIT 1`,
+		userID, domain.TaskStatusOpen))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrTasksNotFound
+			return result, domain.ErrTasksNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *TaskRepository) ListCalendarEvents(ctx context.Context, userID int64, since time.Time) ([]domain.CalendarEvent, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+`, t.deleted, t.updated_at FROM tasks t WHERE t.user_id=? AND t.date>=? AND (t.deleted=FALSE OR t.updated_at>=?) ORDER BY t.date, t.id`,
+		userID, since.UTC(), since.UTC())
+	if err != nil {
+		return []domain.CalendarEvent{}, err
+	}
+
+	defer rows.Close()
+
+	var result []domain.CalendarEvent
+
+	for rows.Next() {
+		var event domain.CalendarEvent
+
+		event.Task, err = scanTask(rows, &event.Cancelled, &event.UpdatedAt)
+		if err != nil {
+			return []domain.CalendarEvent{}, err
+		}
+
+		result = append(result, event)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *TaskRepository) ListChanged(ctx context.Context, userID int64, cursor domain.TaskCursor, until time.Time, limit int) ([]domain.TaskChange, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+`, t.deleted, t.updated_at FROM tasks t WHERE (t.updated_at>? OR (t.updated_at=? AND t.id>?)) AND t.updated_at<? AND (?=0 OR t.user_id=?) ORDER BY t.updated_at, t.id LIMIT ?`,
+		cursor.ChangedAt.UTC(), cursor.ChangedAt.UTC(), cursor.TaskID, until.UTC(), userID, userID, limit)
+	if err != nil {
+		return []domain.TaskChange{}, err
+	}
+
+	defer rows.Close()
+
+	var result []domain.TaskChange
+
+	for rows.Next() {
+		var (
+			change  domain.TaskChange
+			deleted bool
+		)
+
+		change.Task, err = scanTask(rows, &deleted, &change.ChangedAt)
+		if err != nil {
+			return []domain.TaskChange{}, err
 		}
 
+		change.Type = domain.TaskChangeType(change.Task, deleted)
+		result = append(result, change)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *TaskRepository) Update(ctx context.Context, task domain.Task) error {
+	record, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE tasks SET summary=?, date=?, duration_minutes=?, window_start=?, window_end=?, site_id=?, priority=?, status=?, due_at=?, overdue_at=?, version=version+1 WHERE id=? AND user_id=? AND version=? AND deleted=FALSE`,
+		task.Summary, task.Date, durationMinutes(task.Duration), task.WindowStart, task.WindowEnd, nullableID(task.SiteID), task.Priority, task.Status, task.DueAt, task.OverdueAt, task.ID, task.UserID, task.Version)
+	if err != nil {
+		return err
+	}
+
+	affected, err := record.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return r.versionError(ctx, task.ID, task.Version)
+	}
+
+	return nil
+}
+
+// Remove soft deletes the task. A version of 0 removes it whatever its
+// current version is.
+func (r *TaskRepository) Remove(ctx context.Context, id, version int64) error {
+	record, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE tasks SET deleted=TRUE, version=version+1 WHERE id=? AND (?=0 OR version=?) AND deleted=FALSE`, id, version, version)
+	if err != nil {
+		return err
+	}
+
+	if version == 0 {
+		return nil
+	}
+
+	affected, err := record.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return r.versionError(ctx, id, version)
+	}
+
+	return nil
+}
+
+func (r *TaskRepository) ListOverdue(ctx context.Context, now time.Time) ([]domain.Task, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+taskColumns+` FROM tasks t WHERE t.deleted=FALSE AND t.status<>? AND t.overdue_at IS NULL AND t.due_at IS NOT NULL AND t.due_at<?`,
+		domain.TaskStatusCompleted, now.UTC())
+	if err != nil {
+		return []domain.Task{}, err
+	}
+
+	return rowsToTask(rows)
+}
+
+// FlagOverdue only records when the task was found overdue, which clients never
+// see, so neither its version nor updated_at change and ETags stay valid.
+func (r *TaskRepository) FlagOverdue(ctx context.Context, id int64, now time.Time) (bool, error) {
+	record, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE tasks SET overdue_at=?, updated_at=updated_at WHERE id=? AND overdue_at IS NULL AND deleted=FALSE`, now.UTC(), id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := record.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (r *TaskRepository) AddOccurrence(ctx context.Context, task domain.Task) (int64, bool, error) {
+	record, err := conn(ctx, r.db).ExecContext(ctx, `INSERT IGNORE INTO tasks (summary, date, duration_minutes, window_start, window_end, user_id, site_id, type, priority, status, due_at, series_id, occurrence_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		task.Summary, task.Date, durationMinutes(task.Duration), task.WindowStart, task.WindowEnd, task.UserID, nullableID(task.SiteID), task.Type, task.Priority, task.Status, task.DueAt, task.SeriesID, task.OccurrenceAt)
+	if err != nil {
+		return 0, false, err
+	}
+
+	affected, err := record.RowsAffected()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if affected == 0 {
+		return 0, false, nil
+	}
+
+	id, err := record.LastInsertId()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+func (r *TaskRepository) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withinTransaction(ctx, r.db, fn)
+}
+
+func (r *TaskRepository) RemoveOpenOccurrences(ctx context.Context, seriesID int64, from time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE tasks SET deleted=TRUE, occurrence_at=NULL, version=version+1 WHERE series_id=? AND occurrence_at>=? AND status=? AND deleted=FALSE`,
+		seriesID, from.UTC(), domain.TaskStatusOpen)
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *TaskRepository) Remove(ctx context.Context, id int64) error {
-	if _, err := r.db.ExecContext(ctx, `UPDATE tasks SET deleted=TRUE WHERE id=?`, id); err != nil {
+func (r *TaskRepository) MoveOccurrences(ctx context.Context, seriesID, toSeriesID int64, from time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE tasks SET series_id=?, version=version+1 WHERE series_id=? AND occurrence_at>=? AND deleted=FALSE`,
+		toSeriesID, seriesID, from.UTC())
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func taskFilterClause(filter domain.TaskFilter) (string, []any) {
+	var (
+		clauses []string
+		args    []any
+	)
+
+	switch filter.SLA {
+	case domain.SLAOverdue:
+		clauses = append(clauses, `t.status<>? AND t.due_at IS NOT NULL AND t.due_at<?`)
+		args = append(args, domain.TaskStatusCompleted, filter.Now.UTC())
+	case domain.SLAAtRisk:
+		clauses = append(clauses, `t.status<>? AND t.due_at IS NOT NULL AND t.due_at>=? AND t.due_at<=DATE_ADD(?, INTERVAL COALESCE(`+taskSLAAtRisk+`, 0) MINUTE)`)
+		args = append(args, domain.TaskStatusCompleted, filter.Now.UTC(), filter.Now.UTC())
+	}
+
+	if filter.Priority != "" {
+		clauses = append(clauses, `t.priority=?`)
+		args = append(args, filter.Priority)
+	}
+
+	if filter.SiteID != 0 {
+		clauses = append(clauses, `t.site_id=?`)
+		args = append(args, filter.SiteID)
+	}
+
+	if filter.CustomerID != 0 {
+		clauses = append(clauses, `t.site_id IN (SELECT s.id FROM sites s WHERE s.customer_id=?)`)
+		args = append(args, filter.CustomerID)
+	}
+
+	var clause string
+	if len(clauses) > 0 {
+		clause = " AND " + strings.Join(clauses, " AND ")
+	}
+
+	switch filter.Sort {
+	case domain.TaskSortPriority:
+		clause += " ORDER BY " + taskOrderPriority
+	case domain.TaskSortDueAt:
+		clause += " ORDER BY t.due_at IS NULL, t.due_at, t.id"
+	case domain.TaskSortDate:
+		clause += " ORDER BY t.date IS NULL, t.date, t.id"
+	}
+
+	return clause, args
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// versionError explains why a versioned write matched no row: the task is gone,
+// or another request changed it first.
+func (r *TaskRepository) versionError(ctx context.Context, id, expected int64) error {
+	var current int64
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT version FROM tasks WHERE id=? AND deleted=FALSE`, id).Scan(&current)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrTasksNotFound
+		}
+
+		return err
+	}
+
+	if current == expected {
+		return domain.ErrTasksNotFound
+	}
+
+	return &domain.TaskVersionError{TaskID: id, Expected: expected, Current: current}
+}
+
+// scanTask reads a row selected with taskColumns, followed by the columns
+// scanned into extra.
+func scanTask(row rowScanner, extra ...any) (domain.Task, error) {
+	var (
+		task     domain.Task
+		seriesID sql.NullInt64
+		siteID   sql.NullInt64
+		duration int64
+	)
+
+	dest := []any{&task.ID, &task.Summary, &task.Date, &task.UserID, &siteID, &task.Type, &task.Priority, &task.Status, &task.DueAt, &task.OverdueAt, &task.CreatedAt, &seriesID, &task.OccurrenceAt, &duration, &task.WindowStart, &task.WindowEnd, &task.Version}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task.SeriesID = seriesID.Int64
+	task.SiteID = siteID.Int64
+	task.Duration = time.Duration(duration) * time.Minute
+
+	return task, nil
+}
+
+func nullableID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+func durationMinutes(d time.Duration) int64 {
+	return int64(d / time.Minute)
+}
+
 func rowsToTask(rows *sql.Rows) ([]domain.Task, error) {
 	var result []domain.Task
 
-	for rows.Next() {
-		var task domain.Task
+	defer rows.Close()
 
-		if err := rows.Scan(&task.ID, &task.Summary, &task.Date, &task.UserID); err != nil {
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
 			return []domain.Task{}, err
 		}
 
 		result = append(result, task)
 	}
 
-	return result, nil
+	return result, rows.Err()
 }
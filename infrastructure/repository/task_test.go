@@ -3,10 +3,14 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestNewTask(t *testing.T) {
@@ -56,3 +60,295 @@ func TestNewTask(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRepository_FlagOverdue(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+	now := time.Date(2023, 11, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{
+			name:     "Expect false when task was already flagged",
+			affected: 0,
+			want:     false,
+		},
+		{
+			name:     "Expect true when task gets flagged",
+			affected: 1,
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectExec(`UPDATE tasks SET overdue_at=\?, updated_at=updated_at WHERE id=\? AND overdue_at IS NULL AND deleted=FALSE`).
+				WithArgs(now, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			got, err := r.FlagOverdue(context.Background(), 1, now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskRepository_Update(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+	task := domain.Task{ID: 1, Summary: "encrypted", UserID: 2, Priority: domain.TaskPriorityP3, Status: domain.TaskStatusOpen, Version: 3}
+
+	tests := []struct {
+		name     string
+		affected int64
+		current  *int64
+		wantErr  error
+	}{
+		{
+			name:     "Expect success when the version matches",
+			affected: 1,
+		},
+		{
+			name:    "Expect version conflict when another request changed the task",
+			current: func() *int64 { v := int64(4); return &v }(),
+			wantErr: &domain.TaskVersionError{TaskID: 1, Expected: 3, Current: 4},
+		},
+		{
+			name:    "Expect not found when the task is gone",
+			wantErr: domain.ErrTasksNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectExec(`UPDATE tasks SET .*version=version\+1 WHERE id=\? AND user_id=\? AND version=\? AND deleted=FALSE`).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			if tt.affected == 0 {
+				rows := sqlmock.NewRows([]string{"version"})
+				if tt.current != nil {
+					rows.AddRow(*tt.current)
+				}
+
+				mock.ExpectQuery(`SELECT version FROM tasks WHERE id=\? AND deleted=FALSE`).WithArgs(1).WillReturnRows(rows)
+			}
+
+			err := r.Update(context.Background(), task)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskRepository_WithinTransaction(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+	tests := []struct {
+		name          string
+		removeErr     error
+		wantCommitted bool
+	}{
+		{
+			name:          "Expect statements to commit together",
+			wantCommitted: true,
+		},
+		{
+			name:      "Expect rollback when a statement fails",
+			removeErr: domain.ErrTasksNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE tasks SET deleted=TRUE`).WithArgs(1, 0, 0).WillReturnResult(sqlmock.NewResult(0, 1))
+			if tt.wantCommitted {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			committed := false
+			err := r.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				domain.AfterCommit(ctx, func() { committed = true })
+
+				if err := r.Remove(ctx, 1, 0); err != nil {
+					return err
+				}
+
+				return tt.removeErr
+			})
+
+			assert.Equal(t, tt.removeErr, err)
+			assert.Equal(t, tt.wantCommitted, committed)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskRepository_LockByIDAndUserID(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .+ FROM tasks t WHERE t.id=\? AND t.user_id=\? AND t.deleted=FALSE FOR UPDATE`).
+		WithArgs(1, 2).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err = r.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		_, err := r.LockByIDAndUserID(ctx, 1, 2)
+		return err
+	})
+
+	assert.ErrorIs(t, err, domain.ErrTasksNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTaskRepository_ListOverlapping(t *testing.T) {
+	var (
+		date      = time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC)
+		createdAt = time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+		columns   = []string{"id", "summary", "date", "user_id", "site_id", "type", "priority", "status", "due_at", "overdue_at", "created_at", "series_id", "occurrence_at", "duration_minutes", "window_start", "window_end", "version"}
+	)
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  []domain.Task
+	}{
+		{
+			name:  "Expect a booking without duration at the same start to overlap",
+			start: date,
+			end:   date.Add(domain.MinBookingDuration),
+			want:  []domain.Task{{ID: 4, Summary: "encrypted", Date: &date, UserID: 2, Type: "standard", Priority: "P3", Status: "open", CreatedAt: createdAt, Version: 1}},
+		},
+		{
+			name:  "Expect a booking with duration at the same start to overlap",
+			start: date,
+			end:   date.Add(time.Hour),
+			want:  []domain.Task{{ID: 4, Summary: "encrypted", Date: &date, UserID: 2, Type: "standard", Priority: "P3", Status: "open", CreatedAt: createdAt, Version: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer mockDB.Close()
+
+			r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+			// Stored tasks without a duration are stretched to the minimum
+			// booking, so one starting at start is found.
+			mock.ExpectQuery(`SELECT .+ FROM tasks t WHERE t.user_id=\? AND t.id<>\? AND t.deleted=FALSE AND t.status<>\? AND t.date IS NOT NULL AND t.date<\? AND DATE_ADD\(t.date, INTERVAL GREATEST\(t.duration_minutes, \?\) MINUTE\)>\?`).
+				WithArgs(2, 1, domain.TaskStatusCompleted, tt.end, 1, tt.start).
+				WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(4, "encrypted", date, 2, nil, "standard", "P3", "open", nil, nil, createdAt, nil, nil, 0, nil, nil, 1))
+
+			got, err := r.ListOverlapping(context.Background(), 2, tt.start, tt.end, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskRepository_ListCalendarEvents(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+	var (
+		since     = time.Date(2023, 9, 9, 10, 0, 0, 0, time.UTC)
+		date      = time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC)
+		createdAt = time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+		updatedAt = time.Date(2023, 12, 2, 10, 0, 0, 0, time.UTC)
+		columns   = []string{"id", "summary", "date", "user_id", "site_id", "type", "priority", "status", "due_at", "overdue_at", "created_at", "series_id", "occurrence_at", "duration_minutes", "window_start", "window_end", "version", "deleted", "updated_at"}
+	)
+
+	mock.ExpectQuery(`SELECT .+, t.deleted, t.updated_at FROM tasks t WHERE t.user_id=\? AND t.date>=\? AND \(t.deleted=FALSE OR t.updated_at>=\?\) ORDER BY t.date, t.id`).
+		WithArgs(2, since, since).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "encrypted", date, 2, nil, "standard", "P3", "open", nil, nil, createdAt, nil, nil, 90, nil, nil, 2, false, updatedAt).
+			AddRow(2, "removed", date, 2, 4, "standard", "P1", "open", nil, nil, createdAt, nil, nil, 0, nil, nil, 3, true, updatedAt))
+
+	got, err := r.ListCalendarEvents(context.Background(), 2, since)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CalendarEvent{
+		{
+			Task:      domain.Task{ID: 1, Summary: "encrypted", Date: &date, UserID: 2, Type: "standard", Priority: "P3", Status: "open", CreatedAt: createdAt, Duration: 90 * time.Minute, Version: 2},
+			UpdatedAt: updatedAt,
+		},
+		{
+			Task:      domain.Task{ID: 2, Summary: "removed", Date: &date, UserID: 2, SiteID: 4, Type: "standard", Priority: "P1", Status: "open", CreatedAt: createdAt, Version: 3},
+			Cancelled: true,
+			UpdatedAt: updatedAt,
+		},
+	}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTaskRepository_ListChanged(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+
+	r := &TaskRepository{db: sqlx.NewDb(mockDB, "sqlmock")}
+
+	var (
+		cursor    = domain.TaskCursor{ChangedAt: time.Date(2023, 12, 8, 9, 0, 0, 0, time.UTC), TaskID: 4}
+		until     = time.Date(2023, 12, 8, 10, 0, 0, 0, time.UTC)
+		createdAt = time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+		changedAt = time.Date(2023, 12, 8, 9, 30, 0, 0, time.UTC)
+		columns   = []string{"id", "summary", "date", "user_id", "site_id", "type", "priority", "status", "due_at", "overdue_at", "created_at", "series_id", "occurrence_at", "duration_minutes", "window_start", "window_end", "version", "deleted", "updated_at"}
+	)
+
+	mock.ExpectQuery(`SELECT .+, t.deleted, t.updated_at FROM tasks t WHERE \(t.updated_at>\? OR \(t.updated_at=\? AND t.id>\?\)\) AND t.updated_at<\? AND \(\?=0 OR t.user_id=\?\) ORDER BY t.updated_at, t.id LIMIT \?`).
+		WithArgs(cursor.ChangedAt, cursor.ChangedAt, 4, until, 2, 2, 500).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(5, "encrypted", nil, 2, nil, "standard", "P3", "open", nil, nil, createdAt, nil, nil, 0, nil, nil, 1, false, changedAt).
+			AddRow(6, "encrypted", nil, 2, nil, "standard", "P3", "open", nil, nil, createdAt, nil, nil, 0, nil, nil, 2, false, changedAt).
+			AddRow(7, "encrypted", nil, 2, nil, "standard", "P3", "open", nil, nil, createdAt, nil, nil, 0, nil, nil, 3, true, changedAt))
+
+	got, err := r.ListChanged(context.Background(), 2, cursor, until, 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{domain.TaskChangeCreated, domain.TaskChangeUpdated, domain.TaskChangeDeleted}, []string{got[0].Type, got[1].Type, got[2].Type})
+	assert.Equal(t, domain.TaskCursor{ChangedAt: changedAt, TaskID: 7}, got[2].Cursor())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+const (
+	timeEntryColumns = `id, task_id, user_id, started_at, ended_at, note, approved_by, approved_at`
+	timeEntrySeconds = `TIMESTAMPDIFF(SECOND, started_at, ended_at)`
+)
+
+type TimeEntryRepository struct {
+	db *sqlx.DB
+}
+
+func NewTimeEntry(db *sqlx.DB) (*TimeEntryRepository, error) {
+	if db == nil {
+		return &TimeEntryRepository{}, errors.New("db must not be nil")
+	}
+
+	return &TimeEntryRepository{db}, nil
+}
+
+func (r *TimeEntryRepository) Add(ctx context.Context, entry domain.TimeEntry) (int64, error) {
+	var id int64
+
+	record, err := r.db.ExecContext(ctx, `INSERT INTO task_time_entries (task_id, user_id, started_at, ended_at, note) VALUES (?, ?, ?, ?, ?)`,
+		entry.TaskID, entry.UserID, entry.Start.UTC(), entry.End, entry.Note)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *TimeEntryRepository) ListByID(ctx context.Context, id int64) (domain.TimeEntry, error) {
+	result, err := scanTimeEntry(r.db.QueryRowContext(ctx, `SELECT `+timeEntryColumns+` FROM task_time_entries WHERE id=?`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrTimeEntryNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *TimeEntryRepository) ListByTaskID(ctx context.Context, taskID int64) ([]domain.TimeEntry, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+timeEntryColumns+` FROM task_time_entries WHERE task_id=? ORDER BY started_at`, taskID)
+	if err != nil {
+		return []domain.TimeEntry{}, err
+	}
+
+	return rowsToTimeEntry(rows)
+}
+
+func (r *TimeEntryRepository) ListRunningByUserID(ctx context.Context, userID int64) (domain.TimeEntry, error) {
+	result, err := scanTimeEntry(r.db.QueryRowContext(ctx, `SELECT `+timeEntryColumns+` FROM task_time_entries WHERE user_id=? AND ended_at IS NULL ORDER BY started_at DESC LIMIT 1`, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrTimeEntryNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *TimeEntryRepository) ListOverlapping(ctx context.Context, userID int64, start, end time.Time, excludeID int64) ([]domain.TimeEntry, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+timeEntryColumns+` FROM task_time_entries WHERE user_id=? AND id<>? AND started_at<? AND (ended_at IS NULL OR ended_at>?)`,
+		userID, excludeID, end.UTC(), start.UTC())
+	if err != nil {
+		return []domain.TimeEntry{}, err
+	}
+
+	return rowsToTimeEntry(rows)
+}
+
+func (r *TimeEntryRepository) Summarize(ctx context.Context, filter domain.TimeSummaryFilter) ([]domain.TimeSummary, error) {
+	group := "task_id"
+	if filter.GroupBy == domain.TimeSummaryByUser {
+		group = "user_id"
+	}
+
+	query := `SELECT ` + group + `, SUM(` + timeEntrySeconds + `), SUM(CASE WHEN approved_at IS NOT NULL THEN ` + timeEntrySeconds + ` ELSE 0 END) FROM task_time_entries WHERE ended_at IS NOT NULL AND started_at>=? AND started_at<?`
+	args := []any{filter.From.UTC(), filter.To.UTC()}
+
+	if filter.UserID != 0 {
+		query += ` AND user_id=?`
+		args = append(args, filter.UserID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+` GROUP BY `+group+` ORDER BY `+group, args...)
+	if err != nil {
+		return []domain.TimeSummary{}, err
+	}
+
+	var result []domain.TimeSummary
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			summary  domain.TimeSummary
+			key      int64
+			total    int64
+			approved int64
+		)
+
+		if err := rows.Scan(&key, &total, &approved); err != nil {
+			return []domain.TimeSummary{}, err
+		}
+
+		if group == "user_id" {
+			summary.UserID = key
+		} else {
+			summary.TaskID = key
+		}
+
+		summary.Total = time.Duration(total) * time.Second
+		summary.Approved = time.Duration(approved) * time.Second
+
+		result = append(result, summary)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *TimeEntryRepository) Update(ctx context.Context, entry domain.TimeEntry) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE task_time_entries SET ended_at=?, note=?, approved_by=?, approved_at=? WHERE id=?`,
+		entry.End, entry.Note, nullableID(entry.ApprovedBy), entry.ApprovedAt, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanTimeEntry(row rowScanner) (domain.TimeEntry, error) {
+	var (
+		entry      domain.TimeEntry
+		approvedBy sql.NullInt64
+	)
+
+	err := row.Scan(&entry.ID, &entry.TaskID, &entry.UserID, &entry.Start, &entry.End, &entry.Note, &approvedBy, &entry.ApprovedAt)
+	if err != nil {
+		return domain.TimeEntry{}, err
+	}
+
+	entry.ApprovedBy = approvedBy.Int64
+
+	return entry, nil
+}
+
+func rowsToTimeEntry(rows *sql.Rows) ([]domain.TimeEntry, error) {
+	var result []domain.TimeEntry
+
+	defer rows.Close()
+
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return []domain.TimeEntry{}, err
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type dbConn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// conn returns the transaction carried by ctx, falling back to db.
+func conn(ctx context.Context, db *sqlx.DB) dbConn {
+	if tx, ok := domain.TransactionFrom(ctx).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return db
+}
+
+// withinTransaction runs fn in a transaction carried by its context. Nested
+// calls join the transaction that is already open.
+func withinTransaction(ctx context.Context, db *sqlx.DB, fn func(ctx context.Context) error) error {
+	if _, ok := domain.TransactionFrom(ctx).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	ctx, committed := domain.WithTransaction(ctx, tx)
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	committed()
+
+	return nil
+}
@@ -23,7 +23,7 @@ func NewUser(db *sqlx.DB) (*UserRepository, error) {
 func (r *UserRepository) ListByEmail(ctx context.Context, email string) (domain.User, error) {
 	var result domain.User
 
-	err := r.db.QueryRowContext(ctx, `SELECT id, email, password, role_id FROM users WHERE email=? AND deleted=FALSE`, email).Scan(&result.ID, &result.Email, &result.Password, &result.RoleID)
+	err := r.db.QueryRowContext(ctx, `SELECT id, email, password, role_id, timezone FROM users WHERE email=? AND deleted=FALSE`, email).Scan(&result.ID, &result.Email, &result.Password, &result.RoleID, &result.Timezone)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return result, domain.ErrUserNotFound
@@ -34,3 +34,26 @@ func (r *UserRepository) ListByEmail(ctx context.Context, email string) (domain.
 
 	return result, nil
 }
+
+func (r *UserRepository) ListByID(ctx context.Context, id int64) (domain.User, error) {
+	var result domain.User
+
+	err := r.db.QueryRowContext(ctx, `SELECT id, email, password, role_id, timezone FROM users WHERE id=? AND deleted=FALSE`, id).Scan(&result.ID, &result.Email, &result.Password, &result.RoleID, &result.Timezone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrUserNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *UserRepository) UpdateTimezone(ctx context.Context, userID int64, timezone string) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE users SET timezone=? WHERE id=? AND deleted=FALSE`, timezone, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const visitColumns = `id, task_id, user_id, check_in_at, check_in_device_at, check_in_latitude, check_in_longitude, check_in_distance, check_in_out_of_range, check_out_at, check_out_device_at, check_out_latitude, check_out_longitude, check_out_distance, check_out_out_of_range`
+
+type VisitRepository struct {
+	db *sqlx.DB
+}
+
+func NewVisit(db *sqlx.DB) (*VisitRepository, error) {
+	if db == nil {
+		return &VisitRepository{}, errors.New("db must not be nil")
+	}
+
+	return &VisitRepository{db}, nil
+}
+
+func (r *VisitRepository) Add(ctx context.Context, visit domain.Visit) (int64, error) {
+	var id int64
+
+	in := visit.CheckIn
+
+	record, err := conn(ctx, r.db).ExecContext(ctx, `INSERT INTO task_visits (task_id, user_id, check_in_at, check_in_device_at, check_in_latitude, check_in_longitude, check_in_distance, check_in_out_of_range) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		visit.TaskID, visit.UserID, in.At.UTC(), in.DeviceTime.UTC(), in.Coordinates.Latitude, in.Coordinates.Longitude, in.Distance, in.OutOfRange)
+	if err != nil {
+		return id, err
+	}
+
+	if id, err = record.LastInsertId(); err != nil {
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (r *VisitRepository) ListByTaskID(ctx context.Context, taskID int64) ([]domain.Visit, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `SELECT `+visitColumns+` FROM task_visits WHERE task_id=? ORDER BY check_in_at`, taskID)
+	if err != nil {
+		return []domain.Visit{}, err
+	}
+
+	var result []domain.Visit
+
+	defer rows.Close()
+
+	for rows.Next() {
+		visit, err := scanVisit(rows)
+		if err != nil {
+			return []domain.Visit{}, err
+		}
+
+		result = append(result, visit)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *VisitRepository) ListOpenByTaskID(ctx context.Context, taskID int64) (domain.Visit, error) {
+	result, err := scanVisit(conn(ctx, r.db).QueryRowContext(ctx, `SELECT `+visitColumns+` FROM task_visits WHERE task_id=? AND check_out_at IS NULL ORDER BY check_in_at DESC LIMIT 1`, taskID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return result, domain.ErrVisitNotFound
+		}
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (r *VisitRepository) CheckOut(ctx context.Context, visit domain.Visit) error {
+	if visit.CheckOut == nil {
+		return errors.New("check-out must not be nil")
+	}
+
+	out := visit.CheckOut
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, `UPDATE task_visits SET check_out_at=?, check_out_device_at=?, check_out_latitude=?, check_out_longitude=?, check_out_distance=?, check_out_out_of_range=? WHERE id=? AND check_out_at IS NULL`,
+		out.At.UTC(), out.DeviceTime.UTC(), out.Coordinates.Latitude, out.Coordinates.Longitude, out.Distance, out.OutOfRange, visit.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanVisit(row rowScanner) (domain.Visit, error) {
+	var (
+		visit        domain.Visit
+		outAt        sql.NullTime
+		outDeviceAt  sql.NullTime
+		outLatitude  sql.NullFloat64
+		outLongitude sql.NullFloat64
+		outDistance  *float64
+		outOfRange   bool
+	)
+
+	err := row.Scan(&visit.ID, &visit.TaskID, &visit.UserID,
+		&visit.CheckIn.At, &visit.CheckIn.DeviceTime, &visit.CheckIn.Coordinates.Latitude, &visit.CheckIn.Coordinates.Longitude, &visit.CheckIn.Distance, &visit.CheckIn.OutOfRange,
+		&outAt, &outDeviceAt, &outLatitude, &outLongitude, &outDistance, &outOfRange)
+	if err != nil {
+		return domain.Visit{}, err
+	}
+
+	if outAt.Valid {
+		visit.CheckOut = &domain.Checkpoint{
+			Coordinates: domain.Coordinates{Latitude: outLatitude.Float64, Longitude: outLongitude.Float64},
+			DeviceTime:  outDeviceAt.Time,
+			At:          outAt.Time,
+			Distance:    outDistance,
+			OutOfRange:  outOfRange,
+		}
+	}
+
+	return visit, nil
+}
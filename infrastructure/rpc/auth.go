@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"google.golang.org/grpc"
+)
+
+type AuthServer struct {
+	pb.UnimplementedAuthServiceServer
+	authUsecase domain.AuthUsecase
+}
+
+func NewAuth(authUsecase domain.AuthUsecase) (*AuthServer, error) {
+	if authUsecase == nil {
+		return &AuthServer{}, errors.New("authUsecase must not be nil")
+	}
+
+	return &AuthServer{authUsecase: authUsecase}, nil
+}
+
+func (s *AuthServer) Register(server *grpc.Server) {
+	pb.RegisterAuthServiceServer(server, s)
+}
+
+func (s *AuthServer) Authenticate(ctx context.Context, request *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
+	token, err := s.authUsecase.Authenticate(ctx, request.GetEmail(), request.GetPassword())
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrUserInvalidPass) {
+			err = errUnauthenticated
+		}
+
+		return nil, statusError(ctx, err)
+	}
+
+	return &pb.AuthenticateResponse{Token: token}, nil
+}
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestNewAuth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authUsecase := domain.NewMockAuthUsecase(ctrl)
+
+	tests := []struct {
+		name        string
+		authUsecase domain.AuthUsecase
+		want        *AuthServer
+		wantErr     bool
+	}{
+		{
+			name:        "Expect server to be created",
+			authUsecase: authUsecase,
+			want:        &AuthServer{authUsecase: authUsecase},
+			wantErr:     false,
+		},
+		{
+			name:        "Expect error when authUsecase is nil",
+			authUsecase: nil,
+			want:        &AuthServer{},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuth(tt.authUsecase)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAuth() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthServer_Authenticate(t *testing.T) {
+	tests := []struct {
+		name            string
+		setDependencies func(authUsecase *domain.MockAuthUsecase)
+		want            string
+		wantCode        codes.Code
+	}{
+		{
+			name: "Expect token without an access token in the call",
+			setDependencies: func(authUsecase *domain.MockAuthUsecase) {
+				authUsecase.EXPECT().Authenticate(gomock.Any(), "tech@field.com", "secret").Return("token", nil)
+			},
+			want:     "token",
+			wantCode: codes.OK,
+		},
+		{
+			name: "Expect Unauthenticated when the password is wrong",
+			setDependencies: func(authUsecase *domain.MockAuthUsecase) {
+				authUsecase.EXPECT().Authenticate(gomock.Any(), "tech@field.com", "secret").Return("", domain.ErrUserInvalidPass)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name: "Expect Internal when authentication fails",
+			setDependencies: func(authUsecase *domain.MockAuthUsecase) {
+				authUsecase.EXPECT().Authenticate(gomock.Any(), "tech@field.com", "secret").Return("", errors.New("err"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authUsecase := domain.NewMockAuthUsecase(ctrl)
+			tt.setDependencies(authUsecase)
+
+			authServer, err := NewAuth(authUsecase)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := pb.NewAuthServiceClient(dial(t, domain.NewMockAuthenticator(ctrl), authServer.Register))
+
+			got, err := client.Authenticate(context.Background(), &pb.AuthenticateRequest{Email: "tech@field.com", Password: "secret"})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.want, got.GetToken())
+		})
+	}
+}
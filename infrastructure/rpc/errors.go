@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+)
+
+const (
+	errorDomain = "field-team-management"
+
+	unauthenticatedMessage = "invalid or missing access token"
+	internalMessage        = "internal server error"
+)
+
+var errUnauthenticated = errors.New(unauthenticatedMessage)
+
+type errorMapping struct {
+	target error
+	code   codes.Code
+	reason string
+}
+
+// errorMappings matches the domain errors to status codes. Reasons are the
+// error codes of the REST API, so clients can share their handling.
+var errorMappings = []errorMapping{
+	{target: errUnauthenticated, code: codes.Unauthenticated, reason: "unauthorized"},
+	{target: domain.ErrUserNotAllowed, code: codes.PermissionDenied, reason: "forbidden"},
+
+	{target: domain.ErrInvalidTask, code: codes.InvalidArgument, reason: "invalid_task"},
+	{target: domain.ErrInvalidTaskTransition, code: codes.InvalidArgument, reason: "invalid_task_transition"},
+	{target: domain.ErrInvalidSite, code: codes.InvalidArgument, reason: "invalid_site"},
+	{target: domain.ErrInvalidTaskBatch, code: codes.InvalidArgument, reason: "invalid_task_batch"},
+	{target: domain.ErrInvalidTaskImport, code: codes.InvalidArgument, reason: "invalid_task_import"},
+
+	{target: domain.ErrTasksNotFound, code: codes.NotFound, reason: "task_not_found"},
+	{target: domain.ErrUserNotFound, code: codes.NotFound, reason: "user_not_found"},
+	{target: domain.ErrSiteNotFound, code: codes.NotFound, reason: "site_not_found"},
+
+	{target: domain.ErrTaskConflict, code: codes.FailedPrecondition, reason: "task_conflict"},
+	{target: domain.ErrChecklistIncomplete, code: codes.FailedPrecondition, reason: "checklist_incomplete"},
+	{target: domain.ErrTaskVersionConflict, code: codes.FailedPrecondition, reason: "task_version_conflict"},
+
+	{target: domain.ErrTaskBatchAborted, code: codes.Aborted, reason: "task_batch_aborted"},
+
+	{target: context.Canceled, code: codes.Canceled, reason: "request_canceled"},
+	{target: context.DeadlineExceeded, code: codes.DeadlineExceeded, reason: "request_timeout"},
+}
+
+// toStatus maps err to a status carrying an ErrorInfo with its reason. Errors
+// without a mapping are logged and reported as internal.
+func toStatus(ctx context.Context, err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+
+	for _, m := range errorMappings {
+		if !errors.Is(err, m.target) {
+			continue
+		}
+
+		info := &errdetails.ErrorInfo{Reason: m.reason, Domain: errorDomain}
+
+		var conflict *domain.TaskConflictError
+		if errors.As(err, &conflict) {
+			ids := make([]string, len(conflict.TaskIDs))
+			for i, id := range conflict.TaskIDs {
+				ids[i] = fmt.Sprint(id)
+			}
+
+			info.Metadata = map[string]string{"conflicting_task_ids": strings.Join(ids, ",")}
+		}
+
+		s := status.New(m.code, err.Error())
+		if detailed, detailErr := s.WithDetails(info); detailErr == nil {
+			return detailed
+		}
+
+		return s
+	}
+
+	log.Printf("error handling call %s: %v", domain.RequestIDFrom(ctx), err) // Later: send to metrics/observability
+
+	return status.New(codes.Internal, internalMessage)
+}
+
+func statusError(ctx context.Context, err error) error {
+	return toStatus(ctx, err).Err()
+}
+
+// toError describes the failure of one item of a batch or import.
+func toError(ctx context.Context, err error) *pb.Error {
+	if err == nil {
+		return nil
+	}
+
+	s := toStatus(ctx, err)
+
+	result := &pb.Error{Code: s.Code().String(), Message: s.Message()}
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			result.Reason = info.Reason
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	authorizationKey = "authorization"
+	requestIDKey     = "x-request-id"
+	traceParentKey   = "traceparent"
+)
+
+var (
+	// publicMethods can be called without an access token.
+	publicMethods = map[string]bool{
+		pb.AuthService_Authenticate_FullMethodName: true,
+	}
+
+	traceParentPattern = regexp.MustCompile(`^00-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)
+)
+
+type contextKey int
+
+const userKey contextKey = iota
+
+// NewServer returns a gRPC server that authenticates calls with the same
+// access tokens as the REST API. Unary calls run under timeout unless the
+// client asked for a shorter deadline; streams last until the client cancels.
+func NewServer(authenticator domain.Authenticator, timeout time.Duration) (*grpc.Server, error) {
+	if authenticator == nil {
+		return nil, errors.New("authenticator must not be nil")
+	}
+
+	if timeout <= 0 {
+		return nil, errors.New("timeout must be positive")
+	}
+
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryDeadline(timeout), unaryAuthenticator(authenticator)),
+		grpc.ChainStreamInterceptor(streamAuthenticator(authenticator)),
+	), nil
+}
+
+func unaryDeadline(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
+
+func unaryAuthenticator(authenticator domain.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, statusError(ctx, err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func streamAuthenticator(authenticator domain.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), authenticator, info.FullMethod)
+		if err != nil {
+			return statusError(ctx, err)
+		}
+
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate carries the request ID, the trace and, unless the method is
+// public, the user of the access token in the context of the call.
+func authenticate(ctx context.Context, authenticator domain.Authenticator, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(md, requestIDKey)
+	if !validRequestID(requestID) {
+		requestID = randomHex(16)
+	}
+	ctx = domain.WithRequestID(ctx, requestID)
+
+	traceParent := firstValue(md, traceParentKey)
+	if !traceParentPattern.MatchString(traceParent) {
+		traceParent = "00-" + randomHex(16) + "-" + randomHex(8) + "-01"
+	}
+	ctx = domain.WithTraceParent(ctx, traceParent)
+
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	token := strings.TrimSpace(strings.TrimPrefix(firstValue(md, authorizationKey), "Bearer"))
+	if token == "" {
+		return ctx, errUnauthenticated
+	}
+
+	valid, claims, err := authenticator.IsAccessTokenValid(token)
+	if !valid || err != nil {
+		return ctx, errUnauthenticated
+	}
+
+	userID, _ := claims["user_id"].(float64)
+	roleID, _ := claims["role_id"].(float64)
+	timezone, _ := claims["timezone"].(string)
+
+	return context.WithValue(ctx, userKey, domain.User{ID: int64(userID), RoleID: int64(roleID), Timezone: timezone}), nil
+}
+
+func userFrom(ctx context.Context) domain.User {
+	user, _ := ctx.Value(userKey).(domain.User)
+
+	return user
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func randomHex(size int) string {
+	b := make([]byte, size)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,170 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+	"time"
+)
+
+// dial serves the registered services over an in-memory listener and returns a
+// connection to them.
+func dial(t *testing.T, authenticator domain.Authenticator, register ...func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	server, err := NewServer(authenticator, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range register {
+		r(server)
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationKey, "Bearer "+token)
+}
+
+func reasonOf(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
+
+func TestNewServer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+
+	type args struct {
+		authenticator domain.Authenticator
+		timeout       time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "Expect server to be created",
+			args:    args{authenticator: authenticator, timeout: time.Second},
+			wantErr: false,
+		},
+		{
+			name:    "Expect error when authenticator is nil",
+			args:    args{authenticator: nil, timeout: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when timeout is not positive",
+			args:    args{authenticator: authenticator, timeout: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewServer(tt.args.authenticator, tt.args.timeout)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			assert.Equal(t, tt.wantErr, got == nil)
+		})
+	}
+}
+
+func TestNewServer_authentication(t *testing.T) {
+	claims := map[string]interface{}{"user_id": float64(2), "role_id": float64(2), "timezone": "America/Sao_Paulo"}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		setDependencies func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase)
+		wantCode        codes.Code
+		wantReason      string
+	}{
+		{
+			name: "Expect user of the token to reach the service",
+			ctx:  withToken("token"),
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(true, claims, nil)
+				taskUsecase.EXPECT().Next(gomock.Any(), domain.User{ID: 2, RoleID: 2, Timezone: "America/Sao_Paulo"}).Return(domain.Task{ID: 1}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:            "Expect Unauthenticated when the token is missing",
+			ctx:             context.Background(),
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {},
+			wantCode:        codes.Unauthenticated,
+			wantReason:      "unauthorized",
+		},
+		{
+			name: "Expect Unauthenticated when the token is invalid",
+			ctx:  withToken("token"),
+			setDependencies: func(authenticator *domain.MockAuthenticator, taskUsecase *domain.MockTaskUsecase) {
+				authenticator.EXPECT().IsAccessTokenValid("token").Return(false, nil, errors.New("err"))
+			},
+			wantCode:   codes.Unauthenticated,
+			wantReason: "unauthorized",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authenticator := domain.NewMockAuthenticator(ctrl)
+			taskUsecase := domain.NewMockTaskUsecase(ctrl)
+			tt.setDependencies(authenticator, taskUsecase)
+
+			taskServer, err := NewTask(taskUsecase, time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client := pb.NewTaskServiceClient(dial(t, authenticator, taskServer.Register))
+
+			_, err = client.NextTask(tt.ctx, &pb.NextTaskRequest{})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantReason, reasonOf(err))
+		})
+	}
+}
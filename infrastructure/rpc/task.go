@@ -0,0 +1,344 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	operationMethods = map[pb.TaskOperation_Method]string{
+		pb.TaskOperation_METHOD_CREATE: domain.TaskOperationCreate,
+		pb.TaskOperation_METHOD_UPDATE: domain.TaskOperationUpdate,
+		pb.TaskOperation_METHOD_DELETE: domain.TaskOperationDelete,
+	}
+
+	changeTypes = map[string]pb.TaskChange_Type{
+		domain.TaskChangeCreated: pb.TaskChange_TYPE_CREATED,
+		domain.TaskChangeUpdated: pb.TaskChange_TYPE_UPDATED,
+		domain.TaskChangeDeleted: pb.TaskChange_TYPE_DELETED,
+	}
+)
+
+type TaskServer struct {
+	pb.UnimplementedTaskServiceServer
+	taskUsecase   domain.TaskUsecase
+	watchInterval time.Duration
+	shutdown      chan struct{}
+	shutdownOnce  sync.Once
+}
+
+// NewTask returns the task service. Watchers look for new changes every
+// watchInterval.
+func NewTask(taskUsecase domain.TaskUsecase, watchInterval time.Duration) (*TaskServer, error) {
+	if taskUsecase == nil {
+		return &TaskServer{}, errors.New("taskUsecase must not be nil")
+	}
+
+	if watchInterval <= 0 {
+		return &TaskServer{}, errors.New("watch interval must be positive")
+	}
+
+	return &TaskServer{taskUsecase: taskUsecase, watchInterval: watchInterval, shutdown: make(chan struct{})}, nil
+}
+
+func (s *TaskServer) Register(server *grpc.Server) {
+	pb.RegisterTaskServiceServer(server, s)
+}
+
+// Shutdown ends the running watches with Unavailable, so clients reconnect to
+// another instance and a graceful stop does not wait for them.
+func (s *TaskServer) Shutdown() {
+	s.shutdownOnce.Do(func() {
+		close(s.shutdown)
+	})
+}
+
+func (s *TaskServer) CreateTask(ctx context.Context, request *pb.CreateTaskRequest) (*pb.Task, error) {
+	user := userFrom(ctx)
+
+	task, err := newTask(request.GetTask(), user)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	result, err := s.taskUsecase.Add(ctx, task, user)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return toTaskMessage(result), nil
+}
+
+func (s *TaskServer) ListTasks(ctx context.Context, request *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	result, err := s.taskUsecase.ListByUser(ctx, userFrom(ctx), toTaskFilter(request.GetFilter()))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	response := &pb.ListTasksResponse{Tasks: make([]*pb.Task, len(result))}
+	for i, task := range result {
+		response.Tasks[i] = toTaskMessage(task)
+	}
+
+	return response, nil
+}
+
+func (s *TaskServer) GetTask(ctx context.Context, request *pb.GetTaskRequest) (*pb.Task, error) {
+	result, err := s.taskUsecase.Get(ctx, request.GetId(), userFrom(ctx))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return toTaskMessage(result), nil
+}
+
+func (s *TaskServer) NextTask(ctx context.Context, _ *pb.NextTaskRequest) (*pb.Task, error) {
+	result, err := s.taskUsecase.Next(ctx, userFrom(ctx))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return toTaskMessage(result), nil
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, request *pb.UpdateTaskRequest) (*pb.Task, error) {
+	task := toTask(request.GetTask())
+	task.ID = request.GetId()
+	task.Version = request.GetVersion()
+
+	result, err := s.taskUsecase.Update(ctx, task, userFrom(ctx))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return toTaskMessage(result), nil
+}
+
+func (s *TaskServer) DeleteTask(ctx context.Context, request *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	if err := s.taskUsecase.Remove(ctx, request.GetId(), request.GetVersion(), userFrom(ctx)); err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	return &pb.DeleteTaskResponse{}, nil
+}
+
+// BatchTasks reports the outcome of every operation in its result, so the call
+// itself succeeds even when an atomic batch was rolled back.
+func (s *TaskServer) BatchTasks(ctx context.Context, request *pb.BatchTasksRequest) (*pb.BatchTasksResponse, error) {
+	user := userFrom(ctx)
+
+	operations := make([]domain.TaskOperation, len(request.GetOperations()))
+	for i, operation := range request.GetOperations() {
+		method, ok := operationMethods[operation.GetMethod()]
+		if !ok {
+			return nil, statusError(ctx, fmt.Errorf("%w: operations[%d] has no method", domain.ErrInvalidTaskBatch, i))
+		}
+
+		task := toTask(operation.GetTask())
+		if method == domain.TaskOperationCreate {
+			created, err := newTask(operation.GetTask(), user)
+			if err != nil {
+				return nil, statusError(ctx, fmt.Errorf("operations[%d]: %w", i, err))
+			}
+
+			task = created
+		}
+
+		task.ID = operation.GetId()
+		task.Version = operation.GetVersion()
+		operations[i] = domain.TaskOperation{Method: method, Task: task}
+	}
+
+	results, err := s.taskUsecase.Batch(ctx, operations, request.GetAtomic(), user)
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	response := &pb.BatchTasksResponse{Results: make([]*pb.TaskResult, len(results))}
+	for i, result := range results {
+		response.Results[i] = &pb.TaskResult{Error: toError(ctx, result.Err)}
+
+		if result.Err == nil && operations[i].Method != domain.TaskOperationDelete {
+			response.Results[i].Task = toTaskMessage(result.Task)
+		}
+	}
+
+	return response, nil
+}
+
+func (s *TaskServer) ExportTasks(request *pb.TaskFilter, stream pb.TaskService_ExportTasksServer) error {
+	ctx := stream.Context()
+
+	err := s.taskUsecase.Export(ctx, userFrom(ctx), toTaskFilter(request), func(task domain.Task) error {
+		return stream.Send(toTaskMessage(task))
+	})
+	if err != nil {
+		return statusError(ctx, err)
+	}
+
+	return nil
+}
+
+func (s *TaskServer) ImportTasks(ctx context.Context, request *pb.ImportTasksRequest) (*pb.ImportTasksResponse, error) {
+	rows := make([]domain.TaskImportRow, len(request.GetRows()))
+	for i, row := range request.GetRows() {
+		rows[i] = domain.TaskImportRow{
+			Line:            int(row.GetLine()),
+			TechnicianEmail: strings.ToLower(strings.TrimSpace(row.GetTechnicianEmail())),
+			Task:            toTask(row.GetTask()),
+		}
+	}
+
+	results, err := s.taskUsecase.Import(ctx, rows, request.GetDryRun(), userFrom(ctx))
+	if err != nil {
+		return nil, statusError(ctx, err)
+	}
+
+	response := &pb.ImportTasksResponse{Results: make([]*pb.ImportResult, len(results))}
+	for i, result := range results {
+		response.Results[i] = &pb.ImportResult{Line: int64(result.Line), Error: toError(ctx, result.Err)}
+
+		if result.Err == nil {
+			response.Results[i].Task = toTaskMessage(result.Task)
+		}
+	}
+
+	return response, nil
+}
+
+// WatchTasks polls for changes until the client goes away. Changes are sent at
+// least once: a change made in the second the watch starts from may be sent
+// even if it happened just before the call.
+func (s *TaskServer) WatchTasks(request *pb.WatchTasksRequest, stream pb.TaskService_WatchTasksServer) error {
+	ctx := stream.Context()
+	user := userFrom(ctx)
+
+	cursor := domain.TaskCursor{ChangedAt: time.Now().Truncate(time.Second)}
+	if request.GetSince() != nil {
+		cursor.ChangedAt = request.GetSince().AsTime()
+	}
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for {
+		changes, next, err := s.taskUsecase.Changes(ctx, user, cursor)
+		if err != nil {
+			return statusError(ctx, err)
+		}
+
+		for _, change := range changes {
+			err := stream.Send(&pb.TaskChange{
+				Type:      changeTypes[change.Type],
+				Task:      toTaskMessage(change.Task),
+				ChangedAt: timestamppb.New(change.ChangedAt),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Keep reading while there is a backlog, then wait for new changes.
+		if next != cursor {
+			cursor = next
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ticker.C:
+		}
+	}
+}
+
+func newTask(fields *pb.TaskFields, user domain.User) (domain.Task, error) {
+	values := toTask(fields)
+
+	task, err := domain.NewTask(values.Summary, values.Date, user.ID, values.Type, values.Priority)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	task.DueAt = values.DueAt
+	task.SiteID = values.SiteID
+	task.Duration = values.Duration
+	task.WindowStart = values.WindowStart
+	task.WindowEnd = values.WindowEnd
+
+	return task, nil
+}
+
+func toTask(fields *pb.TaskFields) domain.Task {
+	return domain.Task{
+		Summary:     fields.GetSummary(),
+		Date:        toTime(fields.GetDate()),
+		Duration:    time.Duration(fields.GetDurationMinutes()) * time.Minute,
+		WindowStart: toTime(fields.GetWindowStart()),
+		WindowEnd:   toTime(fields.GetWindowEnd()),
+		SiteID:      fields.GetSiteId(),
+		Type:        fields.GetType(),
+		Priority:    fields.GetPriority(),
+		Status:      fields.GetStatus(),
+		DueAt:       toTime(fields.GetDueAt()),
+	}
+}
+
+func toTaskFilter(filter *pb.TaskFilter) domain.TaskFilter {
+	return domain.TaskFilter{
+		SLA:        filter.GetSla(),
+		Priority:   filter.GetPriority(),
+		Sort:       filter.GetSort(),
+		CustomerID: filter.GetCustomerId(),
+		SiteID:     filter.GetSiteId(),
+	}
+}
+
+func toTaskMessage(task domain.Task) *pb.Task {
+	return &pb.Task{
+		Id:              task.ID,
+		Summary:         task.Summary,
+		Date:            toTimestamp(task.Date),
+		End:             toTimestamp(task.End()),
+		DurationMinutes: int64(task.Duration / time.Minute),
+		WindowStart:     toTimestamp(task.WindowStart),
+		WindowEnd:       toTimestamp(task.WindowEnd),
+		UserId:          task.UserID,
+		SiteId:          task.SiteID,
+		Type:            task.Type,
+		Priority:        task.Priority,
+		Status:          task.Status,
+		DueAt:           toTimestamp(task.DueAt),
+		Overdue:         task.IsOverdue(time.Now()),
+		Version:         task.Version,
+	}
+}
+
+func toTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	t := timestamp.AsTime()
+
+	return &t
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
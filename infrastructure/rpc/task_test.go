@@ -0,0 +1,285 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/ViniciusMartinss/field-team-management/application/usecase"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+var technicianClaims = map[string]interface{}{"user_id": float64(2), "role_id": float64(2)}
+
+func TestNewTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	taskUsecase := domain.NewMockTaskUsecase(ctrl)
+
+	type args struct {
+		taskUsecase   domain.TaskUsecase
+		watchInterval time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "Expect server to be created",
+			args:    args{taskUsecase: taskUsecase, watchInterval: time.Second},
+			wantErr: false,
+		},
+		{
+			name:    "Expect error when taskUsecase is nil",
+			args:    args{taskUsecase: nil, watchInterval: time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "Expect error when watch interval is not positive",
+			args:    args{taskUsecase: taskUsecase, watchInterval: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTask(tt.args.taskUsecase, tt.args.watchInterval)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTask() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr {
+				assert.Equal(t, tt.args.taskUsecase, got.taskUsecase)
+				assert.Equal(t, tt.args.watchInterval, got.watchInterval)
+			}
+		})
+	}
+}
+
+func newTaskClient(t *testing.T, setDependencies func(taskUsecase *domain.MockTaskUsecase)) (pb.TaskServiceClient, *TaskServer) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	authenticator.EXPECT().IsAccessTokenValid("token").Return(true, technicianClaims, nil).AnyTimes()
+
+	taskUsecase := domain.NewMockTaskUsecase(ctrl)
+	setDependencies(taskUsecase)
+
+	taskServer, err := NewTask(taskUsecase, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pb.NewTaskServiceClient(dial(t, authenticator, taskServer.Register)), taskServer
+}
+
+func TestTaskServer_CreateTask(t *testing.T) {
+	client, _ := newTaskClient(t, func(taskUsecase *domain.MockTaskUsecase) {})
+
+	_, err := client.CreateTask(withToken("token"), &pb.CreateTaskRequest{Task: &pb.TaskFields{Priority: "P2"}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid_task", reasonOf(err))
+	assert.Equal(t, "task fields are invalid: summary must not be empty", status.Convert(err).Message())
+}
+
+// The gRPC API has no binding layer, so the filter is validated by the use case
+// itself.
+func TestTaskServer_ListTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	authenticator := domain.NewMockAuthenticator(ctrl)
+	authenticator.EXPECT().IsAccessTokenValid("token").Return(true, technicianClaims, nil)
+
+	taskUsecase, err := usecase.NewTask(
+		domain.NewMockTaskCreator(ctrl),
+		domain.NewMockTaskRetriever(ctrl),
+		domain.NewMockTaskUpdater(ctrl),
+		domain.NewMockTaskRemover(ctrl),
+		domain.NewMockSummaryEncryptor(ctrl),
+		domain.NewMockTaskNotifier(ctrl),
+		domain.NewMockUserNotifier(ctrl),
+		domain.NewMockReminderScheduler(ctrl),
+		domain.NewMockSLAPolicyRetriever(ctrl),
+		domain.NewMockSiteRetriever(ctrl),
+		domain.NewMockChecklistItemRetriever(ctrl),
+		domain.NewMockTaskTransactor(ctrl),
+		domain.NewMockUserRetriever(ctrl),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskServer, err := NewTask(taskUsecase, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := pb.NewTaskServiceClient(dial(t, authenticator, taskServer.Register))
+
+	_, err = client.ListTasks(withToken("token"), &pb.ListTasksRequest{Filter: &pb.TaskFilter{Sla: "late"}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid_task", reasonOf(err))
+	assert.Equal(t, `task fields are invalid: sla filter "late" is not supported`, status.Convert(err).Message())
+}
+
+func TestTaskServer_GetTask(t *testing.T) {
+	date := time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		setDependencies func(taskUsecase *domain.MockTaskUsecase)
+		want            *pb.Task
+		wantCode        codes.Code
+		wantReason      string
+	}{
+		{
+			name: "Expect task to be returned",
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Get(gomock.Any(), int64(1), domain.User{ID: 2, RoleID: 2}).
+					Return(domain.Task{ID: 1, Summary: "Fix the pump", Date: &date, Duration: time.Hour, UserID: 2, Priority: "P2", Status: "open", Version: 3}, nil)
+			},
+			want: &pb.Task{
+				Id:              1,
+				Summary:         "Fix the pump",
+				Date:            timestamppb.New(date),
+				End:             timestamppb.New(date.Add(time.Hour)),
+				DurationMinutes: 60,
+				UserId:          2,
+				Priority:        "P2",
+				Status:          "open",
+				Version:         3,
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Expect NotFound with the reason of the REST API",
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Get(gomock.Any(), int64(1), gomock.Any()).Return(domain.Task{}, domain.ErrTasksNotFound)
+			},
+			wantCode:   codes.NotFound,
+			wantReason: "task_not_found",
+		},
+		{
+			name: "Expect Internal when the error is unknown",
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Get(gomock.Any(), int64(1), gomock.Any()).Return(domain.Task{}, errors.New("err"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := newTaskClient(t, tt.setDependencies)
+
+			got, err := client.GetTask(withToken("token"), &pb.GetTaskRequest{Id: 1})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantReason, reasonOf(err))
+			if tt.want != nil {
+				assert.Equal(t, tt.want.String(), got.String())
+			}
+		})
+	}
+}
+
+func TestTaskServer_BatchTasks(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         *pb.BatchTasksRequest
+		setDependencies func(taskUsecase *domain.MockTaskUsecase)
+		wantReasons     []string
+		wantCode        codes.Code
+	}{
+		{
+			name: "Expect result of every operation",
+			request: &pb.BatchTasksRequest{Operations: []*pb.TaskOperation{
+				{Method: pb.TaskOperation_METHOD_UPDATE, Id: 1, Version: 2, Task: &pb.TaskFields{Summary: "Fix the pump"}},
+				{Method: pb.TaskOperation_METHOD_DELETE, Id: 2, Version: 1},
+			}},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {
+				taskUsecase.EXPECT().Batch(gomock.Any(), []domain.TaskOperation{
+					{Method: domain.TaskOperationUpdate, Task: domain.Task{ID: 1, Summary: "Fix the pump", Version: 2}},
+					{Method: domain.TaskOperationDelete, Task: domain.Task{ID: 2, Version: 1}},
+				}, false, gomock.Any()).Return([]domain.TaskOperationResult{
+					{Task: domain.Task{ID: 1, Version: 3}},
+					{Err: domain.ErrTaskVersionConflict},
+				}, nil)
+			},
+			wantReasons: []string{"", "task_version_conflict"},
+			wantCode:    codes.OK,
+		},
+		{
+			name: "Expect InvalidArgument when an operation has no method",
+			request: &pb.BatchTasksRequest{Operations: []*pb.TaskOperation{
+				{Id: 1},
+			}},
+			setDependencies: func(taskUsecase *domain.MockTaskUsecase) {},
+			wantCode:        codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := newTaskClient(t, tt.setDependencies)
+
+			got, err := client.BatchTasks(withToken("token"), tt.request)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+
+			var reasons []string
+			for _, result := range got.GetResults() {
+				reasons = append(reasons, result.GetError().GetReason())
+			}
+			assert.Equal(t, tt.wantReasons, reasons)
+		})
+	}
+}
+
+func TestTaskServer_WatchTasks(t *testing.T) {
+	since := time.Date(2023, 12, 8, 13, 0, 0, 0, time.UTC)
+	first := domain.TaskChange{Type: domain.TaskChangeCreated, Task: domain.Task{ID: 1}, ChangedAt: since.Add(time.Second)}
+	second := domain.TaskChange{Type: domain.TaskChangeDeleted, Task: domain.Task{ID: 2}, ChangedAt: since.Add(2 * time.Second)}
+
+	client, taskServer := newTaskClient(t, func(taskUsecase *domain.MockTaskUsecase) {
+		gomock.InOrder(
+			taskUsecase.EXPECT().Changes(gomock.Any(), gomock.Any(), domain.TaskCursor{ChangedAt: since}).
+				Return([]domain.TaskChange{first}, first.Cursor(), nil),
+			taskUsecase.EXPECT().Changes(gomock.Any(), gomock.Any(), first.Cursor()).
+				Return([]domain.TaskChange{second}, second.Cursor(), nil),
+			taskUsecase.EXPECT().Changes(gomock.Any(), gomock.Any(), second.Cursor()).
+				Return(nil, second.Cursor(), nil).AnyTimes(),
+		)
+	})
+
+	ctx, cancel := context.WithTimeout(withToken("token"), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchTasks(ctx, &pb.WatchTasksRequest{Since: timestamppb.New(since)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []domain.TaskChange{first, second} {
+		got, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, changeTypes[want.Type], got.GetType())
+		assert.Equal(t, want.Task.ID, got.GetTask().GetId())
+		assert.Equal(t, want.ChangedAt, got.GetChangedAt().AsTime())
+	}
+
+	taskServer.Shutdown()
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+func New(name string, interval time.Duration, job Job) (*Scheduler, error) {
+	if name == "" {
+		return &Scheduler{}, errors.New("name must not be empty")
+	}
+
+	if interval <= 0 {
+		return &Scheduler{}, errors.New("interval must be positive")
+	}
+
+	if job == nil {
+		return &Scheduler{}, errors.New("job must not be nil")
+	}
+
+	return &Scheduler{
+		name:     name,
+		interval: interval,
+		job:      job,
+	}, nil
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	log.Printf("scheduler %s started, running every %s\n", s.name, s.interval)
+
+	for {
+		s.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Printf("scheduler %s stopped\n", s.name)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	if err := s.job(ctx); err != nil {
+		log.Printf("error running scheduler %s: %v", s.name, err) // Later: send to metrics/observability
+	}
+}
@@ -0,0 +1,100 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	job := func(ctx context.Context) error { return nil }
+
+	type args struct {
+		name     string
+		interval time.Duration
+		job      Job
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Expect error when initializing without name",
+			args: args{
+				name:     "",
+				interval: time.Minute,
+				job:      job,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without interval",
+			args: args{
+				name:     "reminders",
+				interval: 0,
+				job:      job,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect error when initializing without job",
+			args: args{
+				name:     "reminders",
+				interval: time.Minute,
+				job:      nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Expect success",
+			args: args{
+				name:     "reminders",
+				interval: time.Minute,
+				job:      job,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.args.name, tt.args.interval, tt.args.job)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduler_Start(t *testing.T) {
+	var runs int32
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s, err := New("test", 10*time.Millisecond, func(ctx context.Context) error {
+		if atomic.AddInt32(&runs, 1) == 3 {
+			cancel()
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.Start(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after context was cancelled")
+	}
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&runs))
+}
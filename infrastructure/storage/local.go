@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Local struct {
+	dir string
+}
+
+func NewLocal(dir string) (*Local, error) {
+	if dir == "" {
+		return &Local{}, errors.New("dir must not be empty")
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return &Local{}, err
+	}
+
+	return &Local{dir}, nil
+}
+
+func (l *Local) Put(_ context.Context, key string, data []byte) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (l *Local) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, domain.ErrBlobNotFound
+		}
+
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (l *Local) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (l *Local) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "..") || strings.HasPrefix(key, "/") {
+		return "", errors.New("invalid blob key")
+	}
+
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewLocal(t *testing.T) {
+	_, err := NewLocal("")
+	assert.Error(t, err)
+
+	got, err := NewLocal(t.TempDir())
+	assert.NoError(t, err)
+	assert.NotNil(t, got)
+}
+
+func TestLocal_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	l, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, l.Put(ctx, "tasks/1/photo", []byte("content")))
+
+	got, err := l.Get(ctx, "tasks/1/photo")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("content"), got)
+
+	assert.NoError(t, l.Delete(ctx, "tasks/1/photo"))
+	assert.NoError(t, l.Delete(ctx, "tasks/1/photo"))
+
+	_, err = l.Get(ctx, "tasks/1/photo")
+	assert.True(t, errors.Is(err, domain.ErrBlobNotFound))
+}
+
+func TestLocal_InvalidKey(t *testing.T) {
+	l, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"", "../outside", "/etc/passwd", "tasks/../../outside"} {
+		assert.Error(t, l.Put(context.Background(), key, []byte("content")), key)
+	}
+}
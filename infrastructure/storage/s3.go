@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3DateLayout    = "20060102"
+	s3TimeLayout    = "20060102T150405Z"
+	s3SignedHeaders = "host;x-amz-content-sha256;x-amz-date"
+)
+
+type S3 struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+func NewS3(endpoint, region, bucket, accessKey, secretKey string, client *http.Client) (*S3, error) {
+	if endpoint == "" {
+		return &S3{}, errors.New("endpoint must not be empty")
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return &S3{}, errors.New("endpoint must be an absolute URL")
+	}
+
+	if region == "" {
+		return &S3{}, errors.New("region must not be empty")
+	}
+
+	if bucket == "" {
+		return &S3{}, errors.New("bucket must not be empty")
+	}
+
+	if accessKey == "" || secretKey == "" {
+		return &S3{}, errors.New("credentials must not be empty")
+	}
+
+	if client == nil {
+		return &S3{}, errors.New("client must not be nil")
+	}
+
+	return &S3{
+		endpoint:  parsed,
+		region:    region,
+		bucket:    bucket,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    client,
+		now:       time.Now,
+	}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError(resp)
+	}
+
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, domain.ErrBlobNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, s.responseError(resp)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s.responseError(resp)
+	}
+}
+
+func (s *S3) do(ctx context.Context, method, key string, body []byte) (*http.Response, error) {
+	if key == "" {
+		return nil, errors.New("invalid blob key")
+	}
+
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	target := *s.endpoint
+	target.Path = strings.TrimSuffix(s.endpoint.Path, "/") + "/" + url.PathEscape(s.bucket) + "/" + strings.Join(segments, "/")
+	target.RawPath = target.Path
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	s.sign(req, body)
+
+	return s.client.Do(req)
+}
+
+func (s *S3) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format(s3TimeLayout)
+	scope := strings.Join([]string{now.Format(s3DateLayout), s.region, s3Service, "aws4_request"}, "/")
+
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		s3SignedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format(s3DateLayout))
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, scope, s3SignedHeaders, signature))
+}
+
+func (s *S3) responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	return fmt.Errorf("s3 %s %s: status %d: %s", resp.Request.Method, resp.Request.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type s3StandIn struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access/20231201/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	if r.Header.Get("x-amz-content-sha256") != sha256Hex(body) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		s.objects[r.URL.Path] = body
+	case http.MethodGet:
+		object, ok := s.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(object)
+	case http.MethodDelete:
+		delete(s.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestNewS3(t *testing.T) {
+	type args struct {
+		endpoint  string
+		region    string
+		bucket    string
+		accessKey string
+		secretKey string
+		client    *http.Client
+	}
+
+	valid := args{"http://localhost:9000", "us-east-1", "attachments", "access", "secret", http.DefaultClient}
+
+	tests := []struct {
+		name    string
+		args    func() args
+		wantErr bool
+	}{
+		{name: "Expect error when initializing without endpoint", args: func() args { a := valid; a.endpoint = ""; return a }, wantErr: true},
+		{name: "Expect error when endpoint is relative", args: func() args { a := valid; a.endpoint = "localhost:9000"; return a }, wantErr: true},
+		{name: "Expect error when initializing without region", args: func() args { a := valid; a.region = ""; return a }, wantErr: true},
+		{name: "Expect error when initializing without bucket", args: func() args { a := valid; a.bucket = ""; return a }, wantErr: true},
+		{name: "Expect error when initializing without credentials", args: func() args { a := valid; a.secretKey = ""; return a }, wantErr: true},
+		{name: "Expect error when initializing without client", args: func() args { a := valid; a.client = nil; return a }, wantErr: true},
+		{name: "Expect success", args: func() args { return valid }, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.args()
+
+			_, err := NewS3(a.endpoint, a.region, a.bucket, a.accessKey, a.secretKey, a.client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewS3() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestS3_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	standIn := &s3StandIn{objects: map[string][]byte{}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	s, err := NewS3(server.URL, "us-east-1", "attachments", "access", "secret", server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = func() time.Time { return time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC) }
+
+	assert.NoError(t, s.Put(ctx, "tasks/1/photo", []byte("content")))
+	assert.Contains(t, standIn.objects, "/attachments/tasks/1/photo")
+
+	got, err := s.Get(ctx, "tasks/1/photo")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("content"), got)
+
+	assert.NoError(t, s.Delete(ctx, "tasks/1/photo"))
+
+	_, err = s.Get(ctx, "tasks/1/photo")
+	assert.True(t, errors.Is(err, domain.ErrBlobNotFound))
+}
+
+func TestS3_Sign(t *testing.T) {
+	s, err := NewS3("http://localhost:9000", "us-east-1", "attachments", "access", "secret", http.DefaultClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = func() time.Time { return time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC) }
+
+	first, _ := http.NewRequest(http.MethodGet, "http://localhost:9000/attachments/tasks/1/photo", nil)
+	second, _ := http.NewRequest(http.MethodGet, "http://localhost:9000/attachments/tasks/1/photo", nil)
+	other, _ := http.NewRequest(http.MethodGet, "http://localhost:9000/attachments/tasks/2/photo", nil)
+
+	s.sign(first, nil)
+	s.sign(second, nil)
+	s.sign(other, nil)
+
+	assert.Equal(t, "20231201T100000Z", first.Header.Get("x-amz-date"))
+	assert.Equal(t, first.Header.Get("Authorization"), second.Header.Get("Authorization"))
+	assert.NotEqual(t, first.Header.Get("Authorization"), other.Header.Get("Authorization"))
+}
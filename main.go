@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"github.com/ViniciusMartinss/field-team-management/application/domain"
 	"github.com/ViniciusMartinss/field-team-management/application/usecase"
 	"github.com/ViniciusMartinss/field-team-management/configuration"
 	"github.com/ViniciusMartinss/field-team-management/infrastructure/api"
@@ -9,23 +11,80 @@ import (
 	"github.com/ViniciusMartinss/field-team-management/infrastructure/jwt"
 	"github.com/ViniciusMartinss/field-team-management/infrastructure/notifier"
 	"github.com/ViniciusMartinss/field-team-management/infrastructure/repository"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/rpc"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/scheduler"
+	"github.com/ViniciusMartinss/field-team-management/infrastructure/storage"
 	"github.com/gin-gonic/gin"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 )
 
 const (
-	queueEnvKey         = "QUEUE"
-	queueConnKey        = "QUEUE_CONN_STRING"
-	dbEnvKey            = "DATABASE"
-	dbConnKey           = "DATABASE_CONN_STRING"
-	encryptionSecretKey = "ENCRYPTION_KEY"
-	jwtSecretKey        = "JWT_KEY"
+	queueEnvKey            = "QUEUE"
+	queueConnKey           = "QUEUE_CONN_STRING"
+	dbEnvKey               = "DATABASE"
+	dbConnKey              = "DATABASE_CONN_STRING"
+	encryptionSecretKey    = "ENCRYPTION_KEY"
+	jwtSecretKey           = "JWT_KEY"
+	smtpHostKey            = "SMTP_HOST"
+	smtpPortKey            = "SMTP_PORT"
+	smtpUsernameKey        = "SMTP_USERNAME"
+	smtpPasswordKey        = "SMTP_PASSWORD"
+	smtpFromKey            = "SMTP_FROM"
+	smsProviderURLKey      = "SMS_PROVIDER_URL"
+	smsProviderTokenKey    = "SMS_PROVIDER_TOKEN"
+	reminderOffsetsKey     = "REMINDER_OFFSETS"
+	reminderIntervalKey    = "REMINDER_INTERVAL"
+	overdueIntervalKey     = "OVERDUE_INTERVAL"
+	recurrenceHorizonKey   = "RECURRENCE_HORIZON"
+	recurrenceIntervalKey  = "RECURRENCE_INTERVAL"
+	legacyDateLayoutKey    = "LEGACY_DATE_LAYOUT"
+	checkInRadiusKey       = "CHECK_IN_RADIUS_METERS"
+	storageDriverKey       = "STORAGE_DRIVER"
+	storageDirKey          = "STORAGE_DIR"
+	s3EndpointKey          = "S3_ENDPOINT"
+	s3RegionKey            = "S3_REGION"
+	s3BucketKey            = "S3_BUCKET"
+	s3AccessKeyKey         = "S3_ACCESS_KEY"
+	s3SecretKeyKey         = "S3_SECRET_KEY"
+	attachmentMaxBytesKey  = "ATTACHMENT_MAX_BYTES"
+	requestTimeoutKey      = "REQUEST_TIMEOUT"
+	shutdownTimeoutKey     = "SHUTDOWN_TIMEOUT"
+	idempotencyTTLKey      = "IDEMPOTENCY_TTL"
+	idempotencyMaxBytesKey = "IDEMPOTENCY_MAX_BYTES"
+	idempotencyPurgeKey    = "IDEMPOTENCY_PURGE_INTERVAL"
+	grpcPortKey            = "GRPC_PORT"
+	watchIntervalKey       = "WATCH_INTERVAL"
+	publicBaseURLKey       = "PUBLIC_BASE_URL"
 
 	databaseDriver = "mysql"
+
+	defaultReminderOffsets     = "24h,1h"
+	defaultReminderInterval    = "1m"
+	defaultOverdueInterval     = "5m"
+	defaultRecurrenceHorizon   = "720h"
+	defaultRecurrenceInterval  = "1h"
+	defaultLegacyDateLayout    = "false"
+	defaultCheckInRadius       = "200"
+	defaultStorageDriver       = "local"
+	defaultStorageDir          = "./data/attachments"
+	defaultS3Region            = "us-east-1"
+	defaultAttachmentMaxBytes  = "10485760"
+	defaultRequestTimeout      = "10s"
+	defaultShutdownTimeout     = "15s"
+	defaultIdempotencyTTL      = "24h"
+	defaultIdempotencyMaxBytes = "16777216"
+	defaultIdempotencyPurge    = "1h"
+	defaultGRPCPort            = "9090"
+	defaultWatchInterval       = "2s"
+	defaultPublicBaseURL       = "http://localhost:8080"
 )
 
 func main() {
@@ -38,6 +97,95 @@ func main() {
 	encryptionSecret := os.Getenv(encryptionSecretKey)
 	jwtSecret := os.Getenv(jwtSecretKey)
 
+	smtpHost := os.Getenv(smtpHostKey)
+	smtpPort := os.Getenv(smtpPortKey)
+	smtpUsername := os.Getenv(smtpUsernameKey)
+	smtpPassword := os.Getenv(smtpPasswordKey)
+	smtpFrom := os.Getenv(smtpFromKey)
+
+	smsProviderURL := os.Getenv(smsProviderURLKey)
+	smsProviderToken := os.Getenv(smsProviderTokenKey)
+
+	reminderOffsets, err := parseDurations(getEnv(reminderOffsetsKey, defaultReminderOffsets))
+	if err != nil {
+		panic(err)
+	}
+
+	reminderInterval, err := time.ParseDuration(getEnv(reminderIntervalKey, defaultReminderInterval))
+	if err != nil {
+		panic(err)
+	}
+
+	overdueInterval, err := time.ParseDuration(getEnv(overdueIntervalKey, defaultOverdueInterval))
+	if err != nil {
+		panic(err)
+	}
+
+	recurrenceHorizon, err := time.ParseDuration(getEnv(recurrenceHorizonKey, defaultRecurrenceHorizon))
+	if err != nil {
+		panic(err)
+	}
+
+	recurrenceInterval, err := time.ParseDuration(getEnv(recurrenceIntervalKey, defaultRecurrenceInterval))
+	if err != nil {
+		panic(err)
+	}
+
+	legacyDateLayout, err := strconv.ParseBool(getEnv(legacyDateLayoutKey, defaultLegacyDateLayout))
+	if err != nil {
+		panic(err)
+	}
+
+	checkInRadius, err := strconv.ParseFloat(getEnv(checkInRadiusKey, defaultCheckInRadius), 64)
+	if err != nil {
+		panic(err)
+	}
+
+	attachmentMaxBytes, err := strconv.ParseInt(getEnv(attachmentMaxBytesKey, defaultAttachmentMaxBytes), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+
+	requestTimeout, err := time.ParseDuration(getEnv(requestTimeoutKey, defaultRequestTimeout))
+	if err != nil {
+		panic(err)
+	}
+
+	shutdownTimeout, err := time.ParseDuration(getEnv(shutdownTimeoutKey, defaultShutdownTimeout))
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyTTL, err := time.ParseDuration(getEnv(idempotencyTTLKey, defaultIdempotencyTTL))
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyPurgeInterval, err := time.ParseDuration(getEnv(idempotencyPurgeKey, defaultIdempotencyPurge))
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyMaxBytes, err := strconv.ParseInt(getEnv(idempotencyMaxBytesKey, defaultIdempotencyMaxBytes), 10, 64)
+	if err != nil {
+		panic(err)
+	}
+
+	watchInterval, err := time.ParseDuration(getEnv(watchIntervalKey, defaultWatchInterval))
+	if err != nil {
+		panic(err)
+	}
+
+	blobStorage, err := newBlobStorage(getEnv(storageDriverKey, defaultStorageDriver))
+	if err != nil {
+		panic(err)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		panic(err)
+	}
+
 	rabbitmq := configuration.NewRabbitmq(queueName, queueConn)
 	brokerConn, err := rabbitmq.Connect()
 	if err != nil {
@@ -89,6 +237,135 @@ func main() {
 		panic(err)
 	}
 
+	notificationPreferenceRepository, err := repository.NewNotificationPreference(db)
+	if err != nil {
+		panic(err)
+	}
+
+	templater, err := notifier.NewTemplater()
+	if err != nil {
+		panic(err)
+	}
+
+	emailSender, err := notifier.NewEmail(smtpHost, smtpPort, smtpUsername, smtpPassword, smtpFrom)
+	if err != nil {
+		panic(err)
+	}
+
+	senders := map[string]domain.NotificationSender{
+		domain.EmailChannel: emailSender,
+	}
+
+	if smsProviderURL != "" {
+		smsProvider, err := notifier.NewHTTPSMSProvider(smsProviderURL, smsProviderToken, http.DefaultClient)
+		if err != nil {
+			panic(err)
+		}
+
+		smsSender, err := notifier.NewSMS(smsProvider)
+		if err != nil {
+			panic(err)
+		}
+
+		senders[domain.SMSChannel] = smsSender
+	}
+
+	notificationUsecase, err := usecase.NewNotification(
+		notificationPreferenceRepository,
+		notificationPreferenceRepository,
+		templater,
+		senders,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	reminderRepository, err := repository.NewReminder(db)
+	if err != nil {
+		panic(err)
+	}
+
+	reminderUsecase, err := usecase.NewReminder(
+		reminderRepository,
+		reminderRepository,
+		reminderRepository,
+		notificationUsecase,
+		reminderOffsets,
+		hostname,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	reminderScheduler, err := scheduler.New("reminders", reminderInterval, func(ctx context.Context) error {
+		sent, err := reminderUsecase.Dispatch(ctx)
+		if sent > 0 {
+			log.Printf("reminders sent: %d\n", sent)
+		}
+
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	slaPolicyRepository, err := repository.NewSLAPolicy(db)
+	if err != nil {
+		panic(err)
+	}
+
+	overdueUsecase, err := usecase.NewOverdue(taskRepository, taskNotifier)
+	if err != nil {
+		panic(err)
+	}
+
+	overdueScheduler, err := scheduler.New("overdue", overdueInterval, func(ctx context.Context) error {
+		flagged, err := overdueUsecase.Detect(ctx)
+		if flagged > 0 {
+			log.Printf("tasks flagged as overdue: %d\n", flagged)
+		}
+
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	customerRepository, err := repository.NewCustomer(db)
+	if err != nil {
+		panic(err)
+	}
+
+	siteRepository, err := repository.NewSite(db)
+	if err != nil {
+		panic(err)
+	}
+
+	customerUsecase, err := usecase.NewCustomer(customerRepository, customerRepository, customerRepository, customerRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	siteUsecase, err := usecase.NewSite(siteRepository, siteRepository, siteRepository, siteRepository, customerRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	checklistTemplateRepository, err := repository.NewChecklistTemplate(db)
+	if err != nil {
+		panic(err)
+	}
+
+	checklistItemRepository, err := repository.NewChecklistItem(db)
+	if err != nil {
+		panic(err)
+	}
+
+	checklistTemplateUsecase, err := usecase.NewChecklistTemplate(checklistTemplateRepository, checklistTemplateRepository, checklistTemplateRepository, checklistTemplateRepository)
+	if err != nil {
+		panic(err)
+	}
+
 	taskUsecase, err := usecase.NewTask(
 		taskRepository,
 		taskRepository,
@@ -96,11 +373,156 @@ func main() {
 		taskRepository,
 		encryptor,
 		taskNotifier,
+		notificationUsecase,
+		reminderUsecase,
+		slaPolicyRepository,
+		siteRepository,
+		checklistItemRepository,
+		taskRepository,
+		userRepository,
 	)
 	if err != nil {
 		panic(err)
 	}
 
+	visitRepository, err := repository.NewVisit(db)
+	if err != nil {
+		panic(err)
+	}
+
+	visitUsecase, err := usecase.NewVisit(visitRepository, visitRepository, visitRepository, taskRepository, taskRepository, siteRepository, taskRepository, checkInRadius)
+	if err != nil {
+		panic(err)
+	}
+
+	checklistUsecase, err := usecase.NewChecklist(checklistItemRepository, checklistItemRepository, checklistItemRepository, checklistTemplateRepository, taskRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	attachmentRepository, err := repository.NewAttachment(db)
+	if err != nil {
+		panic(err)
+	}
+
+	attachmentUsecase, err := usecase.NewAttachment(attachmentRepository, attachmentRepository, attachmentRepository, taskRepository, blobStorage, encryptor, attachmentMaxBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	signatureRepository, err := repository.NewSignature(db)
+	if err != nil {
+		panic(err)
+	}
+
+	signatureUsecase, err := usecase.NewSignature(signatureRepository, signatureRepository, taskRepository, taskRepository, checklistItemRepository, encryptor, taskRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	commentRepository, err := repository.NewComment(db)
+	if err != nil {
+		panic(err)
+	}
+
+	commentUsecase, err := usecase.NewComment(commentRepository, commentRepository, commentRepository, commentRepository, taskRepository, encryptor, taskNotifier)
+	if err != nil {
+		panic(err)
+	}
+
+	partRepository, err := repository.NewPart(db)
+	if err != nil {
+		panic(err)
+	}
+
+	partUsecase, err := usecase.NewPart(partRepository, partRepository, partRepository, partRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	inventoryRepository, err := repository.NewInventory(db)
+	if err != nil {
+		panic(err)
+	}
+
+	inventoryUsecase, err := usecase.NewInventory(inventoryRepository, inventoryRepository, inventoryRepository, inventoryRepository, partRepository, taskRepository, taskNotifier)
+	if err != nil {
+		panic(err)
+	}
+
+	timeEntryRepository, err := repository.NewTimeEntry(db)
+	if err != nil {
+		panic(err)
+	}
+
+	timeEntryUsecase, err := usecase.NewTimeEntry(timeEntryRepository, timeEntryRepository, timeEntryRepository, taskRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	seriesRepository, err := repository.NewSeries(db)
+	if err != nil {
+		panic(err)
+	}
+
+	seriesUsecase, err := usecase.NewTaskSeries(
+		seriesRepository,
+		seriesRepository,
+		seriesRepository,
+		seriesRepository,
+		taskRepository,
+		taskRepository,
+		encryptor,
+		reminderUsecase,
+		siteRepository,
+		taskRepository,
+		recurrenceHorizon,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	recurrenceScheduler, err := scheduler.New("recurrence", recurrenceInterval, func(ctx context.Context) error {
+		generated, err := seriesUsecase.Generate(ctx)
+		if generated > 0 {
+			log.Printf("recurring tasks generated: %d\n", generated)
+		}
+
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyRepository, err := repository.NewIdempotency(db)
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyUsecase, err := usecase.NewIdempotency(
+		idempotencyRepository,
+		idempotencyRepository,
+		idempotencyRepository,
+		idempotencyRepository,
+		encryptor,
+		idempotencyTTL,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyScheduler, err := scheduler.New("idempotency", idempotencyPurgeInterval, func(ctx context.Context) error {
+		purged, err := idempotencyUsecase.Purge(ctx)
+		if purged > 0 {
+			log.Printf("expired idempotency keys purged: %d\n", purged)
+		}
+
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	authenticator, err := jwt.New(jwtSecret)
 	if err != nil {
 		panic(err)
@@ -111,7 +533,39 @@ func main() {
 		panic(err)
 	}
 
+	userUsecase, err := usecase.NewUser(authenticator, userRepository, userRepository)
+	if err != nil {
+		panic(err)
+	}
+
+	calendarTokenRepository, err := repository.NewCalendarToken(db)
+	if err != nil {
+		panic(err)
+	}
+
+	calendarUsecase, err := usecase.NewCalendar(calendarTokenRepository, calendarTokenRepository, calendarTokenRepository, taskRepository, userRepository, encryptor)
+	if err != nil {
+		panic(err)
+	}
+
 	r := gin.Default()
+	r.Use(api.RequestID())
+	r.Use(api.TraceParent())
+	r.Use(api.Deadline(requestTimeout))
+	r.Use(api.LegacyDates(legacyDateLayout))
+
+	requestValidator, err := api.RequestValidator()
+	if err != nil {
+		panic(err)
+	}
+	r.Use(requestValidator)
+	r.Use(api.Idempotency(authenticator, idempotencyUsecase, idempotencyMaxBytes))
+
+	openAPIRouter, err := api.NewOpenAPI(r)
+	if err != nil {
+		panic(err)
+	}
+	openAPIRouter.CreateRouter()
 
 	taskRouter, err := api.NewTask(r, authenticator, taskUsecase)
 	if err != nil {
@@ -125,9 +579,126 @@ func main() {
 	}
 	authRouter.CreateRouter()
 
+	notificationRouter, err := api.NewNotification(r, authenticator, notificationUsecase)
+	if err != nil {
+		panic(err)
+	}
+	notificationRouter.CreateRouter()
+
+	seriesRouter, err := api.NewSeries(r, authenticator, seriesUsecase)
+	if err != nil {
+		panic(err)
+	}
+	seriesRouter.CreateRouter()
+
+	userRouter, err := api.NewUser(r, authenticator, userUsecase)
+	if err != nil {
+		panic(err)
+	}
+	userRouter.CreateRouter()
+
+	calendarRouter, err := api.NewCalendar(r, authenticator, calendarUsecase, getEnv(publicBaseURLKey, defaultPublicBaseURL))
+	if err != nil {
+		panic(err)
+	}
+	calendarRouter.CreateRouter()
+
+	customerRouter, err := api.NewCustomer(r, authenticator, customerUsecase)
+	if err != nil {
+		panic(err)
+	}
+	customerRouter.CreateRouter()
+
+	siteRouter, err := api.NewSite(r, authenticator, siteUsecase)
+	if err != nil {
+		panic(err)
+	}
+	siteRouter.CreateRouter()
+
+	visitRouter, err := api.NewVisit(r, authenticator, visitUsecase)
+	if err != nil {
+		panic(err)
+	}
+	visitRouter.CreateRouter()
+
+	timeEntryRouter, err := api.NewTimeEntry(r, authenticator, timeEntryUsecase)
+	if err != nil {
+		panic(err)
+	}
+	timeEntryRouter.CreateRouter()
+
+	checklistTemplateRouter, err := api.NewChecklistTemplate(r, authenticator, checklistTemplateUsecase)
+	if err != nil {
+		panic(err)
+	}
+	checklistTemplateRouter.CreateRouter()
+
+	checklistRouter, err := api.NewChecklist(r, authenticator, checklistUsecase)
+	if err != nil {
+		panic(err)
+	}
+	checklistRouter.CreateRouter()
+
+	attachmentRouter, err := api.NewAttachment(r, authenticator, attachmentUsecase, attachmentMaxBytes)
+	if err != nil {
+		panic(err)
+	}
+	attachmentRouter.CreateRouter()
+
+	signatureRouter, err := api.NewSignature(r, authenticator, signatureUsecase)
+	if err != nil {
+		panic(err)
+	}
+	signatureRouter.CreateRouter()
+
+	commentRouter, err := api.NewComment(r, authenticator, commentUsecase)
+	if err != nil {
+		panic(err)
+	}
+	commentRouter.CreateRouter()
+
+	partRouter, err := api.NewPart(r, authenticator, partUsecase)
+	if err != nil {
+		panic(err)
+	}
+	partRouter.CreateRouter()
+
+	inventoryRouter, err := api.NewInventory(r, authenticator, inventoryUsecase)
+	if err != nil {
+		panic(err)
+	}
+	inventoryRouter.CreateRouter()
+
+	grpcServer, err := rpc.NewServer(authenticator, requestTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	taskService, err := rpc.NewTask(taskUsecase, watchInterval)
+	if err != nil {
+		panic(err)
+	}
+	taskService.Register(grpcServer)
+
+	authService, err := rpc.NewAuth(authUsecase)
+	if err != nil {
+		panic(err)
+	}
+	authService.Register(grpcServer)
+
+	grpcListener, err := net.Listen("tcp", ":"+getEnv(grpcPortKey, defaultGRPCPort))
+	if err != nil {
+		panic(err)
+	}
+
+	requestCtx, cancelRequests := context.WithCancel(context.Background())
+
 	server := &http.Server{
 		Addr:    ":8080",
 		Handler: r,
+		BaseContext: func(net.Listener) context.Context {
+			return requestCtx
+		},
 	}
 
 	go func() {
@@ -136,6 +707,12 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatalf("error to start gRPC server on: %s\n", err)
+		}
+	}()
+
 	ctx, stop := signal.NotifyContext(
 		context.Background(),
 		os.Interrupt,
@@ -143,13 +720,78 @@ func main() {
 		syscall.SIGTERM,
 	)
 
+	go reminderScheduler.Start(ctx)
+	go overdueScheduler.Start(ctx)
+	go recurrenceScheduler.Start(ctx)
+	go idempotencyScheduler.Start(ctx)
+
 	<-ctx.Done()
 	log.Printf("server shutting down")
 
-	err = server.Shutdown(ctx)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	taskService.Shutdown()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	err = server.Shutdown(shutdownCtx)
+
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		grpcServer.Stop()
+	}
+
+	cancelRequests()
 	if err != nil {
 		log.Fatalf("error to gracefully shut down, reason: %s\n", err.Error())
 	}
 
 	stop()
 }
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+func parseDurations(value string) ([]time.Duration, error) {
+	var result []time.Duration
+
+	for _, v := range strings.Split(value, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, d)
+	}
+
+	return result, nil
+}
+
+func newBlobStorage(driver string) (domain.BlobStorage, error) {
+	switch driver {
+	case "local":
+		return storage.NewLocal(getEnv(storageDirKey, defaultStorageDir))
+	case "s3":
+		return storage.NewS3(
+			os.Getenv(s3EndpointKey),
+			getEnv(s3RegionKey, defaultS3Region),
+			os.Getenv(s3BucketKey),
+			os.Getenv(s3AccessKeyKey),
+			os.Getenv(s3SecretKeyKey),
+			http.DefaultClient,
+		)
+	default:
+		return nil, fmt.Errorf("storage driver %q is not supported", driver)
+	}
+}
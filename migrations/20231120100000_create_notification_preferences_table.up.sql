@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id    INT NOT NULL PRIMARY KEY,
+    channels   VARCHAR(255) NOT NULL DEFAULT 'email',
+    locale     VARCHAR(16) NOT NULL DEFAULT 'en',
+    phone      VARCHAR(32),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
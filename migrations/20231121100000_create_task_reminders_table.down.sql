@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_reminders;
\ No newline at end of file
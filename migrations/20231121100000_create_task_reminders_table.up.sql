@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS task_reminders (
+    id             INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id        INT NOT NULL,
+    offset_seconds INT NOT NULL,
+    remind_at      DATETIME NOT NULL,
+    claimed_by     VARCHAR(255),
+    claimed_at     DATETIME,
+    sent_at        DATETIME,
+    attempts       INT NOT NULL DEFAULT 0,
+    created_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE (task_id, offset_seconds, remind_at),
+    INDEX (remind_at, sent_at, claimed_by),
+    FOREIGN KEY (task_id) REFERENCES tasks (id)
+);
@@ -0,0 +1 @@
+ALTER TABLE tasks DROP INDEX status, DROP COLUMN overdue_at, DROP COLUMN due_at, DROP COLUMN status, DROP COLUMN type;
\ No newline at end of file
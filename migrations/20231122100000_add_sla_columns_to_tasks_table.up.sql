@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    ADD COLUMN type       VARCHAR(64) NOT NULL DEFAULT 'standard' AFTER user_id,
+    ADD COLUMN status     VARCHAR(32) NOT NULL DEFAULT 'open' AFTER type,
+    ADD COLUMN due_at     DATETIME AFTER status,
+    ADD COLUMN overdue_at DATETIME AFTER due_at,
+    ADD INDEX (status, due_at);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS sla_policies (
+    task_type          VARCHAR(64) NOT NULL PRIMARY KEY,
+    resolution_minutes INT NOT NULL,
+    at_risk_minutes    INT NOT NULL DEFAULT 0,
+    created_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at         TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
@@ -0,0 +1 @@
+INSERT INTO sla_policies (task_type, resolution_minutes, at_risk_minutes) VALUES ("standard", 2880, 240), ("emergency", 240, 60), ("maintenance", 10080, 1440);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP FOREIGN KEY tasks_ibfk_2, DROP INDEX tasks_series_occurrence, DROP COLUMN occurrence_at, DROP COLUMN series_id;
+DROP TABLE IF EXISTS task_series;
\ No newline at end of file
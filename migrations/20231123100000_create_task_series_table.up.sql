@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS task_series (
+    id              INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    summary         VARCHAR(2500) NOT NULL,
+    rrule           VARCHAR(255) NOT NULL,
+    start           DATETIME NOT NULL,
+    user_id         INT NOT NULL,
+    type            VARCHAR(64) NOT NULL DEFAULT 'standard',
+    generated_until DATETIME,
+    deleted         BOOLEAN DEFAULT FALSE,
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+ALTER TABLE tasks
+    ADD COLUMN series_id     INT AFTER overdue_at,
+    ADD COLUMN occurrence_at DATETIME AFTER series_id,
+    ADD UNIQUE INDEX tasks_series_occurrence (series_id, occurrence_at),
+    ADD FOREIGN KEY (series_id) REFERENCES task_series (id);
@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    DROP INDEX tasks_user_date,
+    DROP COLUMN window_end,
+    DROP COLUMN window_start,
+    DROP COLUMN duration_minutes,
+    MODIFY COLUMN `date` DATE;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    MODIFY COLUMN `date` DATETIME,
+    ADD COLUMN duration_minutes INT NOT NULL DEFAULT 0 AFTER `date`,
+    ADD COLUMN window_start     DATETIME AFTER duration_minutes,
+    ADD COLUMN window_end       DATETIME AFTER window_start,
+    ADD INDEX tasks_user_date (user_id, `date`);
@@ -0,0 +1,5 @@
+ALTER TABLE task_series
+    DROP COLUMN timezone;
+
+ALTER TABLE users
+    DROP COLUMN timezone;
\ No newline at end of file
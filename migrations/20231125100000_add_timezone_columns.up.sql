@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' AFTER role_id;
+
+ALTER TABLE task_series
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' AFTER type;
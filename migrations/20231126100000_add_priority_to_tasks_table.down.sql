@@ -0,0 +1,3 @@
+ALTER TABLE tasks
+    DROP INDEX tasks_user_status_priority,
+    DROP COLUMN priority;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE tasks
+    ADD COLUMN priority CHAR(2) NOT NULL DEFAULT 'P3' AFTER type,
+    ADD INDEX tasks_user_status_priority (user_id, status, priority, due_at);
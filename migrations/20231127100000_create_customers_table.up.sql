@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS customers (
+    id         INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL DEFAULT '',
+    phone      VARCHAR(32) NOT NULL DEFAULT '',
+    deleted    BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP FOREIGN KEY tasks_ibfk_3, DROP INDEX tasks_site, DROP COLUMN site_id;
+DROP TABLE IF EXISTS sites;
\ No newline at end of file
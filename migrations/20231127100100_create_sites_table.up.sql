@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS sites (
+    id          INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    customer_id INT NOT NULL,
+    name        VARCHAR(255) NOT NULL,
+    address     VARCHAR(500) NOT NULL,
+    latitude    DECIMAL(9, 6),
+    longitude   DECIMAL(9, 6),
+    deleted     BOOLEAN DEFAULT FALSE,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (customer_id) REFERENCES customers (id)
+);
+
+ALTER TABLE tasks
+    ADD COLUMN site_id INT AFTER user_id,
+    ADD INDEX tasks_site (site_id),
+    ADD FOREIGN KEY (site_id) REFERENCES sites (id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_visits;
\ No newline at end of file
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS task_visits (
+    id                     INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id                INT NOT NULL,
+    user_id                INT NOT NULL,
+    check_in_at            DATETIME NOT NULL,
+    check_in_device_at     DATETIME NOT NULL,
+    check_in_latitude      DECIMAL(9, 6) NOT NULL,
+    check_in_longitude     DECIMAL(9, 6) NOT NULL,
+    check_in_distance      DOUBLE,
+    check_in_out_of_range  BOOLEAN NOT NULL DEFAULT FALSE,
+    check_out_at           DATETIME,
+    check_out_device_at    DATETIME,
+    check_out_latitude     DECIMAL(9, 6),
+    check_out_longitude    DECIMAL(9, 6),
+    check_out_distance     DOUBLE,
+    check_out_out_of_range BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at             TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at             TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX task_visits_task (task_id, check_out_at),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
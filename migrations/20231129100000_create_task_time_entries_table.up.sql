@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS task_time_entries (
+    id          INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id     INT NOT NULL,
+    user_id     INT NOT NULL,
+    started_at  DATETIME NOT NULL,
+    ended_at    DATETIME,
+    note        VARCHAR(500) NOT NULL DEFAULT '',
+    approved_by INT,
+    approved_at DATETIME,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX task_time_entries_user (user_id, started_at),
+    INDEX task_time_entries_task (task_id, started_at),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (approved_by) REFERENCES users (id)
+);
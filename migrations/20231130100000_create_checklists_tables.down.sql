@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_checklist_items;
+DROP TABLE IF EXISTS checklist_template_items;
+DROP TABLE IF EXISTS checklist_templates;
\ No newline at end of file
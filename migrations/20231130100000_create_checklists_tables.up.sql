@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    id         INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    deleted    BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS checklist_template_items (
+    id          INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    template_id INT NOT NULL,
+    position    INT NOT NULL,
+    label       VARCHAR(255) NOT NULL,
+    required    BOOLEAN NOT NULL DEFAULT FALSE,
+    INDEX checklist_template_items_template (template_id, position),
+    FOREIGN KEY (template_id) REFERENCES checklist_templates (id)
+);
+
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+    id          INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id     INT NOT NULL,
+    template_id INT NOT NULL,
+    position    INT NOT NULL,
+    label       VARCHAR(255) NOT NULL,
+    required    BOOLEAN NOT NULL DEFAULT FALSE,
+    checked_by  INT,
+    checked_at  DATETIME,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX task_checklist_items_task (task_id, template_id, position),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (template_id) REFERENCES checklist_templates (id),
+    FOREIGN KEY (checked_by) REFERENCES users (id)
+);
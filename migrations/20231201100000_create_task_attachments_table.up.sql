@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id           INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id      INT NOT NULL,
+    user_id      INT NOT NULL,
+    name         VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size         BIGINT NOT NULL,
+    storage_key  VARCHAR(255) NOT NULL,
+    deleted      BOOLEAN DEFAULT FALSE,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX task_attachments_task (task_id, deleted),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
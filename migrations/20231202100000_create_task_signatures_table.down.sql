@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_signatures;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS task_signatures (
+    id           INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id      INT NOT NULL,
+    signer_name  VARCHAR(255) NOT NULL,
+    format       VARCHAR(16) NOT NULL,
+    content      MEDIUMBLOB NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    task_hash    CHAR(64) NOT NULL,
+    signed_by    INT NOT NULL,
+    signed_at    DATETIME NOT NULL,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY task_signatures_task (task_id),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (signed_by) REFERENCES users (id)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS task_comments (
+    id         INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id    INT NOT NULL,
+    user_id    INT NOT NULL,
+    body       TEXT NOT NULL,
+    internal   BOOLEAN NOT NULL DEFAULT FALSE,
+    edited_at  DATETIME,
+    deleted    BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX task_comments_task (task_id, deleted, created_at),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_parts;
+DROP TABLE IF EXISTS part_stock;
+DROP TABLE IF EXISTS parts;
\ No newline at end of file
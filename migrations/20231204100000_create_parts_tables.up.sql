@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS parts (
+    id         INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    sku        VARCHAR(64) NOT NULL,
+    name       VARCHAR(255) NOT NULL,
+    unit       VARCHAR(16) NOT NULL DEFAULT 'unit',
+    deleted    BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY parts_sku (sku)
+);
+
+CREATE TABLE IF NOT EXISTS part_stock (
+    user_id      INT NOT NULL,
+    part_id      INT NOT NULL,
+    quantity     INT NOT NULL DEFAULT 0,
+    min_quantity INT NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, part_id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (part_id) REFERENCES parts (id)
+);
+
+CREATE TABLE IF NOT EXISTS task_parts (
+    id          INT AUTO_INCREMENT NOT NULL PRIMARY KEY,
+    task_id     INT NOT NULL,
+    part_id     INT NOT NULL,
+    user_id     INT NOT NULL,
+    quantity    INT NOT NULL,
+    consumed_at DATETIME NOT NULL,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX task_parts_task (task_id),
+    INDEX task_parts_consumed (consumed_at),
+    FOREIGN KEY (task_id) REFERENCES tasks (id),
+    FOREIGN KEY (part_id) REFERENCES parts (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
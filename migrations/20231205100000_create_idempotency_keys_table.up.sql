@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id         INT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint     CHAR(64) NOT NULL,
+    status_code     INT NOT NULL DEFAULT 0,
+    content_type    VARCHAR(255) NOT NULL DEFAULT '',
+    body            MEDIUMTEXT NOT NULL,
+    expires_at      DATETIME NOT NULL,
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, idempotency_key),
+    INDEX idempotency_keys_expires (expires_at),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
@@ -0,0 +1 @@
+ALTER TABLE tasks DROP COLUMN version;
\ No newline at end of file
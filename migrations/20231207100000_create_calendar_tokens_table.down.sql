@@ -0,0 +1 @@
+DROP TABLE IF EXISTS calendar_tokens;
\ No newline at end of file
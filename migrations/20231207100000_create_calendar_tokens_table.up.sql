@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+    user_id    INT NOT NULL PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    created_at DATETIME NOT NULL,
+    UNIQUE INDEX calendar_tokens_hash (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
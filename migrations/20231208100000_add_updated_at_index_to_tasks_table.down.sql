@@ -0,0 +1 @@
+DROP INDEX tasks_updated_at ON tasks;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX tasks_updated_at ON tasks (updated_at, id);
@@ -0,0 +1 @@
+ALTER TABLE task_series DROP FOREIGN KEY task_series_ibfk_2, DROP COLUMN site_id, DROP COLUMN priority, DROP COLUMN duration_minutes, DROP COLUMN window_minutes;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE task_series
+    ADD COLUMN priority         CHAR(2) NOT NULL DEFAULT 'P3' AFTER type,
+    ADD COLUMN site_id          INT AFTER priority,
+    ADD COLUMN duration_minutes INT NOT NULL DEFAULT 0 AFTER site_id,
+    ADD COLUMN window_minutes   INT NOT NULL DEFAULT 0 AFTER duration_minutes,
+    ADD FOREIGN KEY (site_id) REFERENCES sites (id);
\ No newline at end of file
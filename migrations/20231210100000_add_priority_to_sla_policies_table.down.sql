@@ -0,0 +1,2 @@
+DELETE FROM sla_policies WHERE priority <> '' OR task_type = '';
+ALTER TABLE sla_policies DROP PRIMARY KEY, DROP COLUMN priority, MODIFY COLUMN task_type VARCHAR(64) NOT NULL, ADD PRIMARY KEY (task_type);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE sla_policies
+    ADD COLUMN priority CHAR(2) NOT NULL DEFAULT '' AFTER task_type,
+    MODIFY COLUMN task_type VARCHAR(64) NOT NULL DEFAULT '',
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (task_type, priority);
\ No newline at end of file
@@ -0,0 +1 @@
+INSERT INTO sla_policies (task_type, priority, resolution_minutes, at_risk_minutes) VALUES ("", "P1", 240, 60), ("", "P2", 1440, 180);
\ No newline at end of file